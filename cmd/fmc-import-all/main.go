@@ -0,0 +1,47 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// fmc-import-all prints the terraform import commands for all objects of an FMC which can be imported by
+// their ID. The FMC is selected with the FMC_URL, FMC_USERNAME and FMC_PASSWORD environment variables, the
+// printed resource names are derived from the object names. The objects of the default domain of the user are
+// listed, as the import identifiers do not select a domain and the resources are imported into the default
+// domain of the provider.
+//
+//	go run ./cmd/fmc-import-all > import.sh
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/importall"
+)
+
+func main() {
+	insecure := flag.Bool("insecure", true, "Allow insecure HTTPS client")
+	flag.Parse()
+
+	client, err := fmc.NewClient(os.Getenv("FMC_URL"), os.Getenv("FMC_USERNAME"), os.Getenv("FMC_PASSWORD"), fmc.Insecure(*insecure))
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	if err := importall.Commands(&client, importall.Resources, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
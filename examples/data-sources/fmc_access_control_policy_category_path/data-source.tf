@@ -0,0 +1,4 @@
+data "fmc_access_control_policy_category_path" "example" {
+  access_control_policy_name = "POLICY1"
+  name                       = "Category1"
+}
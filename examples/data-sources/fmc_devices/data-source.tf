@@ -0,0 +1,2 @@
+data "fmc_devices" "example" {
+}
@@ -0,0 +1,3 @@
+data "fmc_icmpv4_object" "example" {
+  id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+}
@@ -0,0 +1,7 @@
+data "fmc_network_drift" "example" {
+  id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  desired = jsonencode({
+    name  = "NET1"
+    value = "10.1.2.0/24"
+  })
+}
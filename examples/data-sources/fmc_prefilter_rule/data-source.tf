@@ -0,0 +1,4 @@
+data "fmc_prefilter_rule" "example" {
+  id                  = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  prefilter_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+}
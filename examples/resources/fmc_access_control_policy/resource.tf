@@ -7,3 +7,11 @@ resource "fmc_access_control_policy" "example" {
   default_action_send_events_to_fmc = true
   default_action_send_syslog        = true
 }
+
+output "access_control_policy" {
+  value = {
+    id                = fmc_access_control_policy.example.id
+    default_action_id = fmc_access_control_policy.example.default_action_id
+    base_policy_id    = fmc_access_control_policy.example.base_policy_id
+  }
+}
@@ -1 +1 @@
-terraform import fmc_access_control_policy_category.example "76d24097-41c4-4558-a4d0-a8c07ac08470"
+terraform import fmc_access_control_policy_category.example "76d24097-41c4-4558-a4d0-a8c07ac08470,76d24097-41c4-4558-a4d0-a8c07ac08470"
@@ -2,3 +2,9 @@ resource "fmc_access_control_policy_category" "example" {
   access_control_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
   name                     = "Category1"
 }
+
+output "access_control_policy_category" {
+  value = {
+    id = fmc_access_control_policy_category.example.id
+  }
+}
@@ -0,0 +1,10 @@
+resource "fmc_bulk_import" "example" {
+  content = file("${path.module}/objects.csv")
+}
+
+output "bulk_import" {
+  value = {
+    id            = fmc_bulk_import.example.id
+    created_count = fmc_bulk_import.example.created_count
+  }
+}
@@ -0,0 +1,14 @@
+resource "fmc_certificate_enrollment" "example" {
+  name                = "ENROLLMENT1"
+  description         = "My certificate enrollment"
+  enrollment_type     = "SCEP"
+  scep_enrollment_url = "http://10.1.1.10/certsrv/mscep/mscep.dll"
+  scep_retry_period   = 1
+  scep_retry_count    = 10
+}
+
+output "certificate_enrollment" {
+  value = {
+    id = fmc_certificate_enrollment.example.id
+  }
+}
@@ -0,0 +1 @@
+terraform import fmc_device_physical_interface.example "76d24097-41c4-4558-a4d0-a8c07ac08470,GigabitEthernet0/1"
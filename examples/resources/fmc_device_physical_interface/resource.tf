@@ -0,0 +1,15 @@
+resource "fmc_device_physical_interface" "example" {
+  device_id    = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  name         = "GigabitEthernet0/1"
+  logical_name = "outside"
+  description  = "My interface"
+  enabled      = true
+  mode         = "NONE"
+  mtu          = 9000
+}
+
+output "device_physical_interface" {
+  value = {
+    id = fmc_device_physical_interface.example.id
+  }
+}
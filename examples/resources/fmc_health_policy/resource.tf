@@ -0,0 +1,24 @@
+resource "fmc_health_policy" "example" {
+  name        = "HEALTH_POLICY1"
+  description = "My health policy"
+  modules = [
+    {
+      name               = "CPU"
+      enabled            = true
+      warning_threshold  = 80
+      critical_threshold = 90
+      settings = [
+        {
+          name  = "interfaces"
+          value = "GigabitEthernet0/0"
+        }
+      ]
+    }
+  ]
+}
+
+output "health_policy" {
+  value = {
+    id = fmc_health_policy.example.id
+  }
+}
@@ -3,4 +3,17 @@ resource "fmc_host" "example" {
   description = "My host object"
   ip          = "10.1.1.1"
   overridable = true
+  tags = [
+    {
+      name  = "environment"
+      value = "production"
+    }
+  ]
+}
+
+output "host" {
+  value = {
+    id   = fmc_host.example.id
+    type = fmc_host.example.type
+  }
 }
@@ -0,0 +1,13 @@
+resource "fmc_icmpv4_object" "example" {
+  name        = "ICMP1"
+  description = "My ICMPv4 object"
+  icmp_type   = "DESTINATION_UNREACHABLE"
+  code        = 0
+  overridable = true
+}
+
+output "icmpv4_object" {
+  value = {
+    id = fmc_icmpv4_object.example.id
+  }
+}
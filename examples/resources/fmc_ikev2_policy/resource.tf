@@ -0,0 +1,15 @@
+resource "fmc_ikev2_policy" "example" {
+  name                     = "IKEV2_POLICY1"
+  description              = "My IKEv2 policy"
+  priority                 = 10
+  lifetime                 = 86400
+  encryption_algorithms    = ["AES-GCM-256"]
+  integrity_algorithms     = ["SHA512"]
+  prf_integrity_algorithms = ["SHA512"]
+}
+
+output "ikev2_policy" {
+  value = {
+    id = fmc_ikev2_policy.example.id
+  }
+}
@@ -4,3 +4,9 @@ resource "fmc_network" "example" {
   prefix      = "10.1.2.0/24"
   overridable = true
 }
+
+output "network" {
+  value = {
+    id = fmc_network.example.id
+  }
+}
@@ -0,0 +1,10 @@
+resource "fmc_network_bulk" "example" {
+  items = [
+    {
+      name        = "NET1"
+      description = "My network object"
+      prefix      = "10.1.2.0/24"
+      overridable = true
+    }
+  ]
+}
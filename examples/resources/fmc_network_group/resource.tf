@@ -0,0 +1,16 @@
+resource "fmc_network_group" "example" {
+  name        = "NETGRP1"
+  description = "My network group"
+  overridable = true
+  objects = [
+    {
+      id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+    }
+  ]
+}
+
+output "network_group" {
+  value = {
+    id = fmc_network_group.example.id
+  }
+}
@@ -0,0 +1,14 @@
+resource "fmc_prefilter_policy" "example" {
+  name                              = "PREFILTER1"
+  description                       = "My prefilter policy"
+  default_action                    = "ANALYZE_TUNNELS"
+  default_action_log_begin          = true
+  default_action_send_events_to_fmc = true
+}
+
+output "prefilter_policy" {
+  value = {
+    id                = fmc_prefilter_policy.example.id
+    default_action_id = fmc_prefilter_policy.example.default_action_id
+  }
+}
@@ -0,0 +1 @@
+terraform import fmc_prefilter_rule.example "76d24097-41c4-4558-a4d0-a8c07ac08470,76d24097-41c4-4558-a4d0-a8c07ac08470"
@@ -0,0 +1,28 @@
+resource "fmc_prefilter_rule" "example" {
+  prefilter_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  name                = "RULE1"
+  rule_type           = "PREFILTER"
+  action              = "FASTPATH"
+  enabled             = true
+  log_begin           = true
+  log_end             = true
+  send_events_to_fmc  = true
+  source_network_objects = [
+    {
+      id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+      type = "Network"
+    }
+  ]
+  destination_network_objects = [
+    {
+      id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+      type = "Network"
+    }
+  ]
+}
+
+output "prefilter_rule" {
+  value = {
+    id = fmc_prefilter_rule.example.id
+  }
+}
@@ -0,0 +1,15 @@
+resource "fmc_scheduled_task" "example" {
+  name                  = "BACKUP1"
+  description           = "My scheduled task"
+  job_type              = "BACKUP"
+  recurrence_frequency  = "WEEKLY"
+  recurrence_interval   = 1
+  recurrence_start_time = "02:30"
+  recurrence_weekdays   = ["MON"]
+}
+
+output "scheduled_task" {
+  value = {
+    id = fmc_scheduled_task.example.id
+  }
+}
@@ -0,0 +1,20 @@
+resource "fmc_time_range" "example" {
+  name            = "TR1"
+  description     = "My time range object"
+  start_date_time = "2025-01-01T08:00"
+  end_date_time   = "2025-12-31T17:00"
+  recurrences = [
+    {
+      recurrence_type  = "DAILY_INTERVAL"
+      daily_start_time = "08:00"
+      daily_end_time   = "17:00"
+      daily_days       = ["MON"]
+    }
+  ]
+}
+
+output "time_range" {
+  value = {
+    id = fmc_time_range.example.id
+  }
+}
@@ -0,0 +1,16 @@
+resource "fmc_variable_set" "example" {
+  name        = "VARSET1"
+  description = "My variable set"
+  variables = [
+    {
+      name       = "HOME_NET"
+      network_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+    }
+  ]
+}
+
+output "variable_set" {
+  value = {
+    id = fmc_variable_set.example.id
+  }
+}
@@ -0,0 +1 @@
+terraform import fmc_vpn_s2s.example "76d24097-41c4-4558-a4d0-a8c07ac08470"
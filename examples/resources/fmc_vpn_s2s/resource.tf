@@ -0,0 +1,13 @@
+resource "fmc_vpn_s2s" "example" {
+  name             = "MyVPN1"
+  network_topology = "POINT_TO_POINT"
+  route_based      = false
+  ikev1            = false
+  ikev2            = true
+}
+
+output "vpn_s2s" {
+  value = {
+    id = fmc_vpn_s2s.example.id
+  }
+}
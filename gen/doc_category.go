@@ -33,13 +33,27 @@ const (
 )
 
 type YamlConfig struct {
-	Name        string `yaml:"name"`
-	DocCategory string `yaml:"doc_category"`
+	Name                  string   `yaml:"name"`
+	DocCategory           string   `yaml:"doc_category"`
+	NoResource            bool     `yaml:"no_resource"`
+	Overridable           bool     `yaml:"overridable"`
+	PreviousResourceNames []string `yaml:"previous_resource_names"`
+	DataSourcePath        bool     `yaml:"data_source_path"`
+	DataSourceUsage       bool     `yaml:"data_source_usage"`
+	DataSourceCount       bool     `yaml:"data_source_count"`
+	DataSourceDiff        bool     `yaml:"data_source_diff"`
+	DataSourceDrift       bool     `yaml:"data_source_drift"`
+	Bulk                  bool     `yaml:"bulk"`
 }
 
-var docPaths = []string{"./docs/data-sources/", "./docs/resources/"}
+const resourceDocPath = "./docs/resources/"
 
-var extraDocs = map[string]string{}
+var docPaths = []string{"./docs/data-sources/", resourceDocPath}
+
+// Documentation of resources which are not generated from a definition
+var extraDocs = map[string]string{
+	"bulk_import": "Objects",
+}
 
 func SnakeCase(s string) string {
 	var g []string
@@ -71,9 +85,34 @@ func main() {
 		configs[i] = config
 	}
 
+	// Add the override, path, usage, count, diff and drift data sources
+	for _, config := range configs {
+		if config.Overridable {
+			configs = append(configs, YamlConfig{Name: config.Name + " Override", DocCategory: config.DocCategory, NoResource: true})
+		}
+		if config.DataSourcePath {
+			configs = append(configs, YamlConfig{Name: config.Name + " Path", DocCategory: config.DocCategory, NoResource: true})
+		}
+		if config.DataSourceUsage {
+			configs = append(configs, YamlConfig{Name: config.Name + " Usage", DocCategory: config.DocCategory, NoResource: true})
+		}
+		if config.DataSourceCount {
+			configs = append(configs, YamlConfig{Name: config.Name + " Count", DocCategory: config.DocCategory, NoResource: true})
+		}
+		if config.DataSourceDiff {
+			configs = append(configs, YamlConfig{Name: config.Name + " Diff", DocCategory: config.DocCategory, NoResource: true})
+		}
+		if config.DataSourceDrift {
+			configs = append(configs, YamlConfig{Name: config.Name + " Drift", DocCategory: config.DocCategory, NoResource: true})
+		}
+	}
+
 	// Update doc category
 	for i := range configs {
 		for _, path := range docPaths {
+			if path == resourceDocPath && configs[i].NoResource {
+				continue
+			}
 			filename := path + SnakeCase(configs[i].Name) + ".md"
 			content, err := os.ReadFile(filename)
 			if err != nil {
@@ -88,6 +127,16 @@ func main() {
 		}
 	}
 
+	// Update doc category of deprecated and bulk resources
+	for _, config := range configs {
+		for _, previous := range config.PreviousResourceNames {
+			extraDocs[SnakeCase(previous)] = config.DocCategory
+		}
+		if config.Bulk {
+			extraDocs[SnakeCase(config.Name)+"_bulk"] = config.DocCategory
+		}
+	}
+
 	// Update extra doc categories
 	for doc, cat := range extraDocs {
 		for _, path := range docPaths {
@@ -22,13 +22,23 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
 	"log"
+	"net/netip"
 	"os"
 	"path"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 	"unicode"
 
@@ -39,15 +49,28 @@ const (
 	definitionsPath   = "./gen/definitions/"
 	providerTemplate  = "./gen/templates/provider.go"
 	providerLocation  = "./internal/provider/provider.go"
+	errorsTemplate    = "./gen/templates/errors.go"
+	errorsLocation    = "./internal/provider/fmcerrors/errors.go"
+	importAllTemplate = "./gen/templates/importall.go"
+	importAllLocation = "./internal/provider/importall/importall.go"
+	functionsTemplate = "./gen/templates/functions.go"
+	functionsLocation = "./internal/provider/functions.go"
 	changelogTemplate = "./gen/templates/changelog.md.tmpl"
 	changelogLocation = "./templates/guides/changelog.md.tmpl"
 	changelogOriginal = "./CHANGELOG.md"
 )
 
 type t struct {
-	path   string
-	prefix string
-	suffix string
+	path        string
+	prefix      string
+	suffix      string
+	resource    bool
+	test        bool
+	variabilize bool
+	diff        bool
+	drift       bool
+	split       bool
+	bulk        bool
 }
 
 var templates = []t{
@@ -65,16 +88,46 @@ var templates = []t{
 		path:   "./gen/templates/data_source_test.go",
 		prefix: "./internal/provider/data_source_fmc_",
 		suffix: "_test.go",
+		test:   true,
 	},
 	{
-		path:   "./gen/templates/resource.go",
-		prefix: "./internal/provider/resource_fmc_",
-		suffix: ".go",
+		path:   "./gen/templates/data_source_diff.go",
+		prefix: "./internal/provider/data_source_fmc_",
+		suffix: "_diff.go",
+		diff:   true,
 	},
 	{
-		path:   "./gen/templates/resource_test.go",
-		prefix: "./internal/provider/resource_fmc_",
-		suffix: "_test.go",
+		path:   "./gen/templates/data_source_drift.go",
+		prefix: "./internal/provider/data_source_fmc_",
+		suffix: "_drift.go",
+		drift:  true,
+	},
+	{
+		path:     "./gen/templates/resource.go",
+		prefix:   "./internal/provider/resource_fmc_",
+		suffix:   ".go",
+		resource: true,
+	},
+	{
+		path:     "./gen/templates/resource_bulk.go",
+		prefix:   "./internal/provider/resource_fmc_",
+		suffix:   "_bulk.go",
+		resource: true,
+		bulk:     true,
+	},
+	{
+		path:     "./gen/templates/resource_schema.go",
+		prefix:   "./internal/provider/resource_fmc_",
+		suffix:   "_schema.go",
+		resource: true,
+		split:    true,
+	},
+	{
+		path:     "./gen/templates/resource_test.go",
+		prefix:   "./internal/provider/resource_fmc_",
+		suffix:   "_test.go",
+		resource: true,
+		test:     true,
 	},
 	{
 		path:   "./gen/templates/data-source.tf",
@@ -82,67 +135,246 @@ var templates = []t{
 		suffix: "/data-source.tf",
 	},
 	{
-		path:   "./gen/templates/resource.tf",
-		prefix: "./examples/resources/fmc_",
-		suffix: "/resource.tf",
+		path:   "./gen/templates/data-source-diff.tf",
+		prefix: "./examples/data-sources/fmc_",
+		suffix: "_diff/data-source.tf",
+		diff:   true,
+	},
+	{
+		path:   "./gen/templates/data-source-drift.tf",
+		prefix: "./examples/data-sources/fmc_",
+		suffix: "_drift/data-source.tf",
+		drift:  true,
+	},
+	{
+		path:     "./gen/templates/resource.tf",
+		prefix:   "./examples/resources/fmc_",
+		suffix:   "/resource.tf",
+		resource: true,
+	},
+	{
+		path:     "./gen/templates/resource-bulk.tf",
+		prefix:   "./examples/resources/fmc_",
+		suffix:   "_bulk/resource.tf",
+		resource: true,
+		bulk:     true,
+	},
+	{
+		path:     "./gen/templates/import.sh",
+		prefix:   "./examples/resources/fmc_",
+		suffix:   "/import.sh",
+		resource: true,
+	},
+	{
+		path:        "./gen/templates/variables.tf",
+		prefix:      "./examples/resources/fmc_",
+		suffix:      "/variables.tf",
+		resource:    true,
+		variabilize: true,
 	},
 	{
-		path:   "./gen/templates/import.sh",
-		prefix: "./examples/resources/fmc_",
-		suffix: "/import.sh",
+		path:        "./gen/templates/terraform.tfvars.example",
+		prefix:      "./examples/resources/fmc_",
+		suffix:      "/terraform.tfvars.example",
+		resource:    true,
+		variabilize: true,
 	},
 }
 
+// Return true if the template is rendered for the definition
+func (tmpl t) rendered(config YamlConfig) bool {
+	return !((tmpl.resource && config.NoResource) || (tmpl.test && config.ExcludeTest) || (tmpl.variabilize && !config.ExampleVariabilize) || (tmpl.diff && !config.DataSourceDiff) || (tmpl.drift && !config.DataSourceDrift) || (tmpl.split && !config.SplitFiles) || (tmpl.bulk && !config.Bulk))
+}
+
 type YamlConfig struct {
-	Name                string                `yaml:"name"`
-	RestEndpoint        string                `yaml:"rest_endpoint"`
-	PutCreate           bool                  `yaml:"put_create"`
-	NoUpdate            bool                  `yaml:"no_update"`
-	NoDelete            bool                  `yaml:"no_delete"`
-	DataSourceNameQuery bool                  `yaml:"data_source_name_query"`
-	MinimumVersion      string                `yaml:"minimum_version"`
-	DsDescription       string                `yaml:"ds_description"`
-	ResDescription      string                `yaml:"res_description"`
-	DocCategory         string                `yaml:"doc_category"`
-	ExcludeTest         bool                  `yaml:"exclude_test"`
-	SkipMinimumTest     bool                  `yaml:"skip_minimum_test"`
-	Attributes          []YamlConfigAttribute `yaml:"attributes"`
-	TestTags            []string              `yaml:"test_tags"`
-	TestPrerequisites   string                `yaml:"test_prerequisites"`
+	Name                   string                `yaml:"name"`
+	RestEndpoint           string                `yaml:"rest_endpoint"`
+	PutCreate              bool                  `yaml:"put_create"`
+	TwoPhaseCreate         bool                  `yaml:"two_phase_create"`
+	IgnoreWarnings         bool                  `yaml:"ignore_warnings"`
+	SurfaceWarnings        bool                  `yaml:"surface_warnings"`
+	ChangeComment          bool                  `yaml:"change_comment"`
+	SplitFiles             bool                  `yaml:"split_files"`
+	ContentType            string                `yaml:"content_type"`
+	NoUpdate               bool                  `yaml:"no_update"`
+	NoDelete               bool                  `yaml:"no_delete"`
+	DeleteEndpoint         string                `yaml:"delete_endpoint"`
+	SoftDelete             string                `yaml:"soft_delete"`
+	ChildEndpoints         []string              `yaml:"child_endpoints"`
+	NaturalKey             []string              `yaml:"natural_key"`
+	ReadEndpoints          []YamlReadEndpoint    `yaml:"read_endpoints"`
+	EnrichRead             []YamlEnrichRead      `yaml:"enrich_read"`
+	ReadExpanded           bool                  `yaml:"read_expanded"`
+	PartialRead            bool                  `yaml:"partial_read"`
+	SkipReadAfterCreate    bool                  `yaml:"skip_read_after_create"`
+	CreateDataPath         []string              `yaml:"create_data_path"`
+	TrackByName            bool                  `yaml:"track_by_name"`
+	PreChangeSnapshot      bool                  `yaml:"pre_change_snapshot"`
+	AutoCreateParent       YamlAutoCreateParent  `yaml:"auto_create_parent"`
+	PostApplyCheck         YamlPostApplyCheck    `yaml:"post_apply_check"`
+	MoveEndpoint           YamlMoveEndpoint      `yaml:"move_endpoint"`
+	PathSegments           []YamlPathSegment     `yaml:"-"`
+	DataSourceNameQuery    bool                  `yaml:"data_source_name_query"`
+	DataSourceNoId         bool                  `yaml:"data_source_no_id"`
+	DataSourceAllPages     bool                  `yaml:"data_source_all_pages"`
+	DataSourceLastModified bool                  `yaml:"data_source_last_modified"`
+	DataSourcePath         bool                  `yaml:"data_source_path"`
+	DataSourceUsage        bool                  `yaml:"data_source_usage"`
+	DataSourceCount        bool                  `yaml:"data_source_count"`
+	DataSourceDiff         bool                  `yaml:"data_source_diff"`
+	DataSourceDrift        bool                  `yaml:"data_source_drift"`
+	Bulk                   bool                  `yaml:"bulk"`
+	HasTags                bool                  `yaml:"has_tags"`
+	CheckReservedNames     bool                  `yaml:"check_reserved_names"`
+	ReservedNames          []string              `yaml:"reserved_names"`
+	NoResource             bool                  `yaml:"no_resource"`
+	PreviousResourceNames  []string              `yaml:"previous_resource_names"`
+	Getters                bool                  `yaml:"getters"`
+	Overridable            bool                  `yaml:"overridable"`
+	MinimumVersion         string                `yaml:"minimum_version"`
+	DsDescription          string                `yaml:"ds_description"`
+	ResDescription         string                `yaml:"res_description"`
+	DocCategory            string                `yaml:"doc_category"`
+	ExampleVariabilize     bool                  `yaml:"example_variabilize"`
+	Experimental           bool                  `yaml:"experimental"`
+	ExcludeTest            bool                  `yaml:"exclude_test"`
+	SkipMinimumTest        bool                  `yaml:"skip_minimum_test"`
+	TestDisappears         bool                  `yaml:"test_disappears"`
+	Attributes             []YamlConfigAttribute `yaml:"attributes"`
+	TestTags               []string              `yaml:"test_tags"`
+	TestPrerequisites      string                `yaml:"test_prerequisites"`
+	RelatedResources       []YamlRelatedResource `yaml:"related_resources"`
+}
+
+type YamlPathSegment struct {
+	Endpoint      string
+	NameAttribute string
+	IdAttribute   string
+}
+
+type YamlAutoCreateParent struct {
+	Endpoint      string `yaml:"endpoint"`
+	Body          string `yaml:"body"`
+	Reference     string `yaml:"-"`
+	NameAttribute string `yaml:"-"`
+	FlagAttribute string `yaml:"-"`
+}
+
+type YamlPostApplyCheck struct {
+	Path    string `yaml:"path"`
+	Field   string `yaml:"field"`
+	Value   string `yaml:"value"`
+	Timeout int64  `yaml:"timeout"`
+}
+
+type YamlMoveEndpoint struct {
+	Path      string `yaml:"path"`
+	Attribute string `yaml:"attribute"`
+	Parameter string `yaml:"-"`
+}
+
+type YamlRelatedResource struct {
+	Resource  string            `yaml:"resource"`
+	Attribute string            `yaml:"attribute"`
+	Checks    map[string]string `yaml:"checks"`
+	Endpoint  string            `yaml:"-"`
+}
+
+type YamlReadEndpoint struct {
+	Path         string   `yaml:"path"`
+	Attributes   []string `yaml:"attributes"`
+	IgnoreErrors bool     `yaml:"ignore_errors"`
+}
+
+type YamlEnrichRead struct {
+	Attribute   string `yaml:"attribute"`
+	IdAttribute string `yaml:"id_attribute"`
+	Endpoint    string `yaml:"endpoint"`
+	Field       string `yaml:"field"`
+	ListPath    string `yaml:"-"`
+	IdPath      string `yaml:"-"`
+	Path        string `yaml:"-"`
 }
 
 type YamlConfigAttribute struct {
-	ModelName        string                `yaml:"model_name"`
-	TfName           string                `yaml:"tf_name"`
-	Type             string                `yaml:"type"`
-	DataPath         []string              `yaml:"data_path"`
-	Id               bool                  `yaml:"id"`
-	ResourceId       bool                  `yaml:"resource_id"`
-	Reference        bool                  `yaml:"reference"`
-	RequiresReplace  bool                  `yaml:"requires_replace"`
-	Mandatory        bool                  `yaml:"mandatory"`
-	WriteOnly        bool                  `yaml:"write_only"`
-	WriteChangesOnly bool                  `yaml:"write_changes_only"`
-	ExcludeTest      bool                  `yaml:"exclude_test"`
-	ExcludeExample   bool                  `yaml:"exclude_example"`
-	Description      string                `yaml:"description"`
-	Example          string                `yaml:"example"`
-	EnumValues       []string              `yaml:"enum_values"`
-	MinList          int64                 `yaml:"min_list"`
-	MaxList          int64                 `yaml:"max_list"`
-	MinInt           int64                 `yaml:"min_int"`
-	MaxInt           int64                 `yaml:"max_int"`
-	MinFloat         float64               `yaml:"min_float"`
-	MaxFloat         float64               `yaml:"max_float"`
-	StringPatterns   []string              `yaml:"string_patterns"`
-	StringMinLength  int64                 `yaml:"string_min_length"`
-	StringMaxLength  int64                 `yaml:"string_max_length"`
-	DefaultValue     string                `yaml:"default_value"`
-	Value            string                `yaml:"value"`
-	TestValue        string                `yaml:"test_value"`
-	MinimumTestValue string                `yaml:"minimum_test_value"`
-	TestTags         []string              `yaml:"test_tags"`
-	Attributes       []YamlConfigAttribute `yaml:"attributes"`
+	ModelName           string                `yaml:"model_name"`
+	TfName              string                `yaml:"tf_name"`
+	Type                string                `yaml:"type"`
+	ElementType         string                `yaml:"element_type"`
+	DataPath            []string              `yaml:"data_path"`
+	Id                  bool                  `yaml:"id"`
+	ResourceId          bool                  `yaml:"resource_id"`
+	Reference           bool                  `yaml:"reference"`
+	RequiresReplace     bool                  `yaml:"requires_replace"`
+	Mandatory           bool                  `yaml:"mandatory"`
+	WriteOnly           bool                  `yaml:"write_only"`
+	Multipart           string                `yaml:"multipart"`
+	QueryParameter      bool                  `yaml:"query_parameter"`
+	WriteChangesOnly    bool                  `yaml:"write_changes_only"`
+	ExplicitNull        bool                  `yaml:"explicit_null"`
+	ComputedMetadata    bool                  `yaml:"computed_metadata"`
+	Computed            bool                  `yaml:"computed"`
+	PreserveConfigOrder bool                  `yaml:"preserve_config_order"`
+	ScalarOrList        bool                  `yaml:"scalar_or_list"`
+	RecreateOnChange    bool                  `yaml:"recreate_on_change"`
+	TypedEnum           bool                  `yaml:"typed_enum"`
+	Placement           bool                  `yaml:"placement"`
+	AutoAssigned        bool                  `yaml:"auto_assigned"`
+	MapKeyed            bool                  `yaml:"map_keyed"`
+	DeltaUpdate         bool                  `yaml:"delta_update"`
+	NestingLimit        int64                 `yaml:"nesting_limit"`
+	PreventCycles       bool                  `yaml:"prevent_cycles"`
+	GroupType           string                `yaml:"group_type"`
+	WriteOrder          int                   `yaml:"write_order"`
+	UniqueValues        bool                  `yaml:"unique_values"`
+	TriState            bool                  `yaml:"tri_state"`
+	LogRedactPattern    string                `yaml:"log_redact_pattern"`
+	AcceptLegacyName    string                `yaml:"accept_legacy_name"`
+	Discriminator       bool                  `yaml:"discriminator"`
+	DiscriminatorValues []string              `yaml:"discriminator_values"`
+	RequiredForValues   bool                  `yaml:"required_for_values"`
+	Implies             []string              `yaml:"implies"`
+	ExcludeTest         bool                  `yaml:"exclude_test"`
+	ExcludeExample      bool                  `yaml:"exclude_example"`
+	Description         string                `yaml:"description"`
+	Example             string                `yaml:"example"`
+	EnumValues          []string              `yaml:"enum_values"`
+	EnumIntegers        []int64               `yaml:"enum_integers"`
+	Format              string                `yaml:"format"`
+	MinList             int64                 `yaml:"min_list"`
+	MaxList             int64                 `yaml:"max_list"`
+	MinInt              int64                 `yaml:"min_int"`
+	MaxInt              int64                 `yaml:"max_int"`
+	WarnThreshold       int64                 `yaml:"warn_threshold"`
+	MinAttribute        string                `yaml:"min_attribute"`
+	MinFloat            float64               `yaml:"min_float"`
+	MaxFloat            float64               `yaml:"max_float"`
+	Scale               float64               `yaml:"scale"`
+	StringPatterns      []string              `yaml:"string_patterns"`
+	StringMinLength     int64                 `yaml:"string_min_length"`
+	StringMaxLength     int64                 `yaml:"string_max_length"`
+	WithinCidr          string                `yaml:"within_cidr"`
+	JsonSchema          string                `yaml:"json_schema"`
+	WithinCidrAttribute string                `yaml:"within_cidr_attribute"`
+	AfterAttribute      string                `yaml:"after_attribute"`
+	LookupEndpoint      string                `yaml:"lookup_endpoint"`
+	LookupName          string                `yaml:"lookup_name"`
+	ExistsEndpoint      string                `yaml:"exists_endpoint"`
+	ExistsField         string                `yaml:"exists_field"`
+	DefaultValue        string                `yaml:"default_value"`
+	DefaultList         []string              `yaml:"default_list"`
+	Value               string                `yaml:"value"`
+	ComposedValue       string                `yaml:"composed_value"`
+	ReadEndpoint        string                `yaml:"-"`
+	IsLookupName        bool                  `yaml:"-"`
+	ParentReference     bool                  `yaml:"-"`
+	ParentAttribute     bool                  `yaml:"-"`
+	EndpointParameter   bool                  `yaml:"-"`
+	CheckReservedNames  bool                  `yaml:"-"`
+	TestValue           string                `yaml:"test_value"`
+	MinimumTestValue    string                `yaml:"minimum_test_value"`
+	TestTags            []string              `yaml:"test_tags"`
+	Attributes          []YamlConfigAttribute `yaml:"attributes"`
 }
 
 // Templating helper function to convert TF name to GO name
@@ -218,6 +450,60 @@ func HasReference(attributes []YamlConfigAttribute) bool {
 	return false
 }
 
+// Templating helper function to return true if changing an attribute recreates the object within the update
+func HasRecreateOnChange(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.RecreateOnChange {
+			return true
+		}
+	}
+	return false
+}
+
+// Templating helper function to return true if a placeholder of the REST endpoint is resolved from attributes
+func HasEndpointParameter(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.EndpointParameter {
+			return true
+		}
+	}
+	return false
+}
+
+// Templating helper function to return true if an attribute is sent as query parameter of the create request
+func HasQueryParameter(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.QueryParameter || attr.Placement {
+			return true
+		}
+	}
+	return false
+}
+
+// Templating helper function to return true if an attribute places the object into a category or section
+func HasPlacement(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.Placement {
+			return true
+		}
+	}
+	return false
+}
+
+// Templating helper function to return the distinct log_redact_pattern values of all attributes and their
+// nested attributes
+func LogRedactPatterns(attributes []YamlConfigAttribute) []string {
+	var patterns []string
+	for _, attr := range attributes {
+		for _, pattern := range append([]string{attr.LogRedactPattern}, LogRedactPatterns(attr.Attributes)...) {
+			if pattern != "" && !contains(patterns, pattern) {
+				patterns = append(patterns, pattern)
+			}
+		}
+	}
+	return patterns
+}
+
 // Templating helper function to return true if reference included in attributes
 func HasResourceId(attributes []YamlConfigAttribute) bool {
 	for _, attr := range attributes {
@@ -233,182 +519,2246 @@ func HasResourceId(attributes []YamlConfigAttribute) bool {
 	return false
 }
 
-// Map of templating functions
-var functions = template.FuncMap{
-	"toGoName":      ToGoName,
-	"camelCase":     CamelCase,
-	"snakeCase":     SnakeCase,
-	"sprintf":       fmt.Sprintf,
-	"toLower":       strings.ToLower,
-	"path":          BuildPath,
-	"hasId":         HasId,
-	"hasReference":  HasReference,
-	"hasResourceId": HasResourceId,
+// Templating helper function to return true if a top-level attribute is computed by FMC
+func HasComputed(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.Computed {
+			return true
+		}
+	}
+	return false
 }
 
-func augmentAttribute(attr *YamlConfigAttribute) {
-	if attr.TfName == "" {
-		var words []string
-		l := 0
-		for s := attr.ModelName; s != ""; s = s[l:] {
-			l = strings.IndexFunc(s[1:], unicode.IsUpper) + 1
-			if l <= 0 {
-				l = len(s)
-			}
-			words = append(words, strings.ToLower(s[:l]))
+// Templating helper function to return true if a composed value is included in attributes
+func HasComposedValue(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.ComposedValue != "" {
+			return true
 		}
-		attr.TfName = strings.Join(words, "_")
 	}
-	if attr.Type == "List" || attr.Type == "Set" {
-		for a := range attr.Attributes {
-			augmentAttribute(&attr.Attributes[a])
+	return false
+}
+
+// Templating helper function to return true if an attribute requires other attributes to be configured
+func HasImplies(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if len(attr.Implies) > 0 {
+			return true
 		}
 	}
+	return false
 }
 
-func augmentConfig(config *YamlConfig) {
-	for ia := range config.Attributes {
-		augmentAttribute(&config.Attributes[ia])
+// Templating helper function to return true if the nesting depth of the members of a group is checked
+func HasNestingLimit(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.NestingLimit != 0 {
+			return true
+		}
 	}
-	if config.DsDescription == "" {
-		config.DsDescription = fmt.Sprintf("This data source can read the %s.", config.Name)
+	return false
+}
+
+// Templating helper function to return true if the members of a group are checked for membership cycles
+func HasPreventCycles(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.PreventCycles {
+			return true
+		}
 	}
-	if config.ResDescription == "" {
-		name := strings.ToLower(config.Name)
-		if strings.HasPrefix(name, "a") || strings.HasPrefix(name, "e") || strings.HasPrefix(name, "i") || strings.HasPrefix(name, "o") || strings.HasPrefix(name, "u") {
-			config.ResDescription = fmt.Sprintf("This resource can manage an %s.", config.Name)
-		} else {
-			config.ResDescription = fmt.Sprintf("This resource can manage a %s.", config.Name)
+	return false
+}
+
+// Templating helper function to return the JSON paths of the response read by the attributes, the fields of
+// list elements are selected with "#"
+func PartialReadPaths(attributes []YamlConfigAttribute) []string {
+	paths := []string{"id"}
+	for _, attr := range attributes {
+		if attr.ModelName == "" || attr.Value != "" || attr.WriteOnly {
+			continue
+		}
+		if attr.Type != "List" && attr.Type != "Set" {
+			paths = append(paths, attributePath(attr))
+			continue
+		}
+		for _, child := range PartialReadPaths(attr.Attributes)[1:] {
+			paths = append(paths, attributePath(attr)+".#."+child)
 		}
 	}
+	return paths
 }
 
-func getTemplateSection(content, name string) string {
-	scanner := bufio.NewScanner(strings.NewReader(content))
-	result := ""
-	foundSection := false
-	beginRegex := regexp.MustCompile(`\/\/template:begin\s` + name + `$`)
-	endRegex := regexp.MustCompile(`\/\/template:end\s` + name + `$`)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if !foundSection {
-			match := beginRegex.MatchString(line)
-			if match {
-				foundSection = true
-				result += line + "\n"
-			}
-		} else {
-			result += line + "\n"
-			match := endRegex.MatchString(line)
-			if match {
-				foundSection = false
+// Templating helper function to return true if the value of an attribute is checked to exist on FMC
+func HasExistsEndpoint(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.ExistsEndpoint != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Templating helper function to return true if an attribute of a list element is resolved by name
+func HasLookup(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		for _, child := range attr.Attributes {
+			if child.LookupEndpoint != "" {
+				return true
 			}
 		}
 	}
-	return result
+	return false
 }
 
-func renderTemplate(templatePath, outputPath string, config interface{}) {
-	file, err := os.Open(templatePath)
-	if err != nil {
-		log.Fatalf("Error opening template: %v", err)
+// Templating helper function to return true if any attribute is write-only, data sources omit these
+func HasWriteOnly(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.WriteOnly || HasWriteOnly(attr.Attributes) {
+			return true
+		}
 	}
-	defer file.Close()
+	return false
+}
 
-	// skip first line with 'build-ignore' directive for go files
-	scanner := bufio.NewScanner(file)
-	if strings.HasSuffix(templatePath, ".go") {
-		scanner.Scan()
+// Templating helper function to return the id attribute holding the keys of the elements of a list which
+// is represented as an object keyed by id, an empty attribute is returned for other attributes
+func MapKey(attr YamlConfigAttribute) YamlConfigAttribute {
+	if !attr.MapKeyed {
+		return YamlConfigAttribute{}
 	}
-	var temp string
-	for scanner.Scan() {
-		temp = temp + scanner.Text() + "\n"
+	for _, child := range attr.Attributes {
+		if child.Id {
+			return child
+		}
 	}
+	return YamlConfigAttribute{}
+}
 
-	template, err := template.New(path.Base(templatePath)).Funcs(functions).Parse(temp)
-	if err != nil {
-		log.Fatalf("Error parsing template: %v", err)
+// Templating helper function to return the computed top-level attributes exposed by the output of the
+// resource example in addition to the ID, at most two to keep the example short
+func ExampleOutputs(attributes []YamlConfigAttribute) []YamlConfigAttribute {
+	var outputs []YamlConfigAttribute
+	for _, attr := range attributes {
+		if len(outputs) == 2 {
+			break
+		}
+		if attr.ExcludeExample || attr.Value != "" {
+			continue
+		}
+		if attr.ResourceId || attr.ComposedValue != "" || attr.ReadEndpoint != "" || attr.Computed {
+			outputs = append(outputs, attr)
+		}
 	}
+	return outputs
+}
 
-	output := new(bytes.Buffer)
-	err = template.Execute(output, config)
-	if err != nil {
-		log.Fatalf("Error executing template: %v", err)
+// Templating helper function to return the top-level attributes of the resource example which are set
+// from variables if the example is variabilized, lists and sets remain inline
+func ExampleVariables(attributes []YamlConfigAttribute) []YamlConfigAttribute {
+	var variables []YamlConfigAttribute
+	for _, attr := range attributes {
+		if attr.ExcludeTest || attr.ExcludeExample || attr.Value != "" || attr.ResourceId || attr.ComposedValue != "" {
+			continue
+		}
+		if attr.Type == "List" || attr.Type == "Set" || attr.Type == "Map" {
+			continue
+		}
+		variables = append(variables, attr)
 	}
+	return variables
+}
 
-	outputFile := filepath.Join(outputPath)
-	existingFile, err := os.Open(outputPath)
-	if err != nil {
-		os.MkdirAll(filepath.Dir(outputFile), 0755)
-	} else if strings.HasSuffix(templatePath, ".go") {
-		existingScanner := bufio.NewScanner(existingFile)
-		var newContent string
-		currentSectionName := ""
-		beginRegex := regexp.MustCompile(`\/\/template:begin\s(.*?)$`)
-		endRegex := regexp.MustCompile(`\/\/template:end\s(.*?)$`)
-		for existingScanner.Scan() {
-			line := existingScanner.Text()
-			if currentSectionName == "" {
-				matches := beginRegex.FindStringSubmatch(line)
-				if len(matches) > 1 && matches[1] != "" {
-					currentSectionName = matches[1]
-				} else {
-					newContent += line + "\n"
-				}
-			} else {
-				matches := endRegex.FindStringSubmatch(line)
-				if len(matches) > 1 && matches[1] == currentSectionName {
-					currentSectionName = ""
-					newSection := getTemplateSection(string(output.Bytes()), matches[1])
-					newContent += newSection
-				}
-			}
+// Templating helper function to return the length of the longest name (tf_name) of the attributes, used
+// to align assignments in files which are not formatted by terraform fmt
+func TfNameWidth(attributes []YamlConfigAttribute) int {
+	width := 0
+	for _, attr := range attributes {
+		if len(attr.TfName) > width {
+			width = len(attr.TfName)
 		}
-		output = bytes.NewBufferString(newContent)
-	}
-	// write to output file
-	f, err := os.Create(outputFile)
-	if err != nil {
-		log.Fatalf("Error creating output file: %v", err)
 	}
-	f.Write(output.Bytes())
+	return width
 }
 
-func main() {
-	providerConfig := make([]string, 0)
-
-	files, _ := os.ReadDir(definitionsPath)
-	configs := make([]YamlConfig, len(files))
+// Templating helper function to return the Terraform type constraint of a variable holding an attribute
+func TfVariableType(t string) string {
+	switch t {
+	case "Int64", "Float64":
+		return "number"
+	case "Bool":
+		return "bool"
+	case "StringList":
+		return "list(string)"
+	}
+	return "string"
+}
 
-	// Load configs
-	for i, filename := range files {
-		yamlFile, err := os.ReadFile(filepath.Join(definitionsPath, filename.Name()))
-		if err != nil {
-			log.Fatalf("Error reading file: %v", err)
+// Templating helper function to return the Terraform type of an attribute, nested attributes are rendered
+// as object type with the attributes sorted by name like Terraform does, e.g. "list(object({id=string}))"
+func TfType(attr YamlConfigAttribute) string {
+	switch attr.Type {
+	case "Int64", "Float64":
+		return "number"
+	case "Bool":
+		return "bool"
+	case "StringList":
+		return "list(string)"
+	case "List", "Set", "Map":
+		element := "string"
+		if attr.ElementType != "" {
+			element = TfType(YamlConfigAttribute{Type: attr.ElementType})
 		}
-
-		config := YamlConfig{}
-		err = yaml.Unmarshal(yamlFile, &config)
-		if err != nil {
-			log.Fatalf("Error parsing yaml: %v", err)
+		if len(attr.Attributes) > 0 {
+			attributes := make([]string, 0, len(attr.Attributes))
+			for _, child := range attr.Attributes {
+				attributes = append(attributes, child.TfName+"="+TfType(child))
+			}
+			sort.Strings(attributes)
+			element = "object({" + strings.Join(attributes, ",") + "})"
 		}
-		configs[i] = config
+		return strings.ToLower(attr.Type) + "(" + element + ")"
 	}
+	return "string"
+}
 
-	for i := range configs {
-		// Augment config
-		augmentConfig(&configs[i])
-
-		// Iterate over templates and render files
-		for _, t := range templates {
-			renderTemplate(t.path, t.prefix+SnakeCase(configs[i].Name)+t.suffix, configs[i])
+// Templating helper function to return the discriminator attribute selecting the valid attributes of an
+// object, an empty attribute is returned if there is none
+func Discriminator(attributes []YamlConfigAttribute) YamlConfigAttribute {
+	for _, attr := range attributes {
+		if attr.Discriminator {
+			return attr
 		}
-		providerConfig = append(providerConfig, configs[i].Name)
 	}
+	return YamlConfigAttribute{}
+}
 
-	// render provider.go
+// Templating helper function to return the attributes in the order they are written to the request body,
+// attributes with a write_order first in ascending order followed by the others in the order of the definition
+func WriteOrder(attributes []YamlConfigAttribute) []YamlConfigAttribute {
+	ordered := make([]YamlConfigAttribute, len(attributes))
+	copy(ordered, attributes)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].WriteOrder == 0 || ordered[j].WriteOrder == 0 {
+			return ordered[j].WriteOrder == 0 && ordered[i].WriteOrder != 0
+		}
+		return ordered[i].WriteOrder < ordered[j].WriteOrder
+	})
+	return ordered
+}
+
+// Templating helper function to return the list attribute whose members are updated with a delta instead of
+// configuring the whole object, an empty attribute is returned if there is none
+func DeltaUpdate(attributes []YamlConfigAttribute) YamlConfigAttribute {
+	for _, attr := range attributes {
+		if attr.DeltaUpdate {
+			return attr
+		}
+	}
+	return YamlConfigAttribute{}
+}
+
+// Templating helper function to return the planned action ("Noop", "Update" or "Replace") when applying
+// the full test configuration ("config_all") on top of the minimum test configuration ("config_minimum")
+func TestUpdateAction(attributes []YamlConfigAttribute) string {
+	action := "Noop"
+	for _, attr := range attributes {
+		if attr.Value != "" || attr.ResourceId || attr.ComposedValue != "" || attr.ExcludeTest || attr.Reference || len(attr.TestTags) > 0 {
+			continue
+		}
+		inMinimum := attr.Id || attr.Mandatory || attr.MinimumTestValue != ""
+		value := attr.Example
+		if attr.TestValue != "" {
+			value = attr.TestValue
+		}
+		changed := false
+		if attr.Type == "List" || attr.Type == "Set" {
+			if !inMinimum {
+				changed = true
+			} else if nested := TestUpdateAction(attr.Attributes); nested != "Noop" {
+				if nested == "Replace" {
+					return nested
+				}
+				changed = true
+			}
+		} else if inMinimum {
+			changed = attr.MinimumTestValue != "" && attr.MinimumTestValue != value
+		} else {
+			changed = value != attr.DefaultValue
+		}
+		if changed {
+			if attr.RequiresReplace {
+				return "Replace"
+			}
+			action = "Update"
+		}
+	}
+	return action
+}
+
+// Templating helper function to return the attributes with the given names (tf_name), e.g. the natural key
+func AttributesByName(attributes []YamlConfigAttribute, names []string) []YamlConfigAttribute {
+	var result []YamlConfigAttribute
+	for _, name := range names {
+		for _, attr := range attributes {
+			if attr.TfName == name {
+				result = append(result, attr)
+			}
+		}
+	}
+	return result
+}
+
+var composedRegex = regexp.MustCompile(`\{(\w+)\}`)
+
+// Placeholders of a REST endpoint resolved from attribute values, unlike the uppercase {DOMAIN_UUID}
+var endpointParameterRegex = regexp.MustCompile(`\{([a-z]\w*)\}`)
+
+// Versions accepted as minimum_version, compared by their numeric components in the acceptance tests
+var minimumVersionRegex = regexp.MustCompile(`^\d+(\.\d+)*$`)
+
+// Templating helper function to return the attributes referenced by a composed value
+func ComposedInputs(attributes []YamlConfigAttribute, s string) []YamlConfigAttribute {
+	var inputs []YamlConfigAttribute
+	for _, m := range composedRegex.FindAllStringSubmatch(s, -1) {
+		for _, attr := range attributes {
+			if attr.TfName == m[1] {
+				inputs = append(inputs, attr)
+			}
+		}
+	}
+	return inputs
+}
+
+// Templating helper function to convert a composed value to a format string
+func ComposedFormat(s string) string {
+	return composedRegex.ReplaceAllString(strings.ReplaceAll(s, "%", "%%"), "%v")
+}
+
+// Templating helper function to pass the name of a model type and its attributes to a template
+func ModelType(name string, attributes []YamlConfigAttribute) map[string]any {
+	return map[string]any{"Type": name, "Attributes": attributes}
+}
+
+// Map of templating functions
+var functions = template.FuncMap{
+	"toGoName":             ToGoName,
+	"camelCase":            CamelCase,
+	"snakeCase":            SnakeCase,
+	"sprintf":              fmt.Sprintf,
+	"toLower":              strings.ToLower,
+	"path":                 BuildPath,
+	"hasId":                HasId,
+	"hasReference":         HasReference,
+	"hasEndpointParameter": HasEndpointParameter,
+	"hasRecreateOnChange":  HasRecreateOnChange,
+	"hasQueryParameter":    HasQueryParameter,
+	"hasPlacement":         HasPlacement,
+	"logRedactPatterns":    LogRedactPatterns,
+	"hasResourceId":        HasResourceId,
+	"hasComputed":          HasComputed,
+	"hasComposedValue":     HasComposedValue,
+	"hasImplies":           HasImplies,
+	"hasNestingLimit":      HasNestingLimit,
+	"hasPreventCycles":     HasPreventCycles,
+	"hasExistsEndpoint":    HasExistsEndpoint,
+	"partialReadPaths":     PartialReadPaths,
+	"hasLookup":            HasLookup,
+	"hasWriteOnly":         HasWriteOnly,
+	"mapKey":               MapKey,
+	"exampleOutputs":       ExampleOutputs,
+	"exampleVariables":     ExampleVariables,
+	"tfVariableType":       TfVariableType,
+	"tfType":               TfType,
+	"tfNameWidth":          TfNameWidth,
+	"discriminator":        Discriminator,
+	"deltaUpdate":          DeltaUpdate,
+	"writeOrder":           WriteOrder,
+	"composedInputs":       ComposedInputs,
+	"composedFormat":       ComposedFormat,
+	"modelType":            ModelType,
+	"attributesByName":     AttributesByName,
+	"testUpdateAction":     TestUpdateAction,
+	"contains":             contains,
+	"importResources":      importResources,
+}
+
+func augmentAttribute(attr *YamlConfigAttribute) {
+	if attr.TfName == "" {
+		var words []string
+		l := 0
+		for s := attr.ModelName; s != ""; s = s[l:] {
+			l = strings.IndexFunc(s[1:], unicode.IsUpper) + 1
+			if l <= 0 {
+				l = len(s)
+			}
+			words = append(words, strings.ToLower(s[:l]))
+		}
+		attr.TfName = strings.Join(words, "_")
+	}
+	if attr.ExistsEndpoint != "" && attr.ExistsField == "" {
+		attr.ExistsField = "name"
+	}
+	if attr.ComputedMetadata || attr.Computed {
+		// Server-assigned metadata can not be configured and is therefore not part of tests and examples
+		attr.ExcludeTest = true
+	}
+	if attr.Type == "List" || attr.Type == "Set" {
+		for a := range attr.Attributes {
+			augmentAttribute(&attr.Attributes[a])
+		}
+		for _, child := range attr.Attributes {
+			if child.LookupName == "" {
+				continue
+			}
+			// The name of a referenced object is only used to look up its ID and not sent to FMC
+			for a := range attr.Attributes {
+				if attr.Attributes[a].TfName == child.LookupName {
+					attr.Attributes[a].IsLookupName = true
+				}
+			}
+		}
+	}
+}
+
+func augmentConfig(config *YamlConfig) {
+	hasOverridable, hasTags := false, false
+	for _, attr := range config.Attributes {
+		if attr.ModelName == "overridable" {
+			hasOverridable = true
+		}
+		if attr.ModelName == "tags" {
+			hasTags = true
+		}
+	}
+	if config.Overridable && !hasOverridable {
+		config.Attributes = append(config.Attributes, YamlConfigAttribute{
+			ModelName:   "overridable",
+			Type:        "Bool",
+			Description: "Whether the object values can be overridden.",
+			Example:     "true",
+		})
+	}
+	if config.HasTags && !hasTags {
+		// Tags are matched by their name when reading the object, independent of the order returned by FMC
+		config.Attributes = append(config.Attributes, YamlConfigAttribute{
+			ModelName:   "tags",
+			Type:        "List",
+			Description: "Tags of the object, each tag is identified by its name.",
+			Attributes: []YamlConfigAttribute{
+				{ModelName: "name", Type: "String", Id: true, Mandatory: true, Description: "Name of the tag.", Example: "environment"},
+				{ModelName: "value", Type: "String", Description: "Value of the tag.", Example: "production"},
+			},
+		})
+	}
+	if config.PostApplyCheck.Field != "" && config.PostApplyCheck.Timeout == 0 {
+		config.PostApplyCheck.Timeout = 300
+	}
+	if config.MoveEndpoint.Attribute != "" {
+		// The new position is sent as query parameter named after the position attribute
+		for _, attr := range config.Attributes {
+			if attr.TfName == config.MoveEndpoint.Attribute {
+				config.MoveEndpoint.Parameter = attr.ModelName
+			}
+		}
+	}
+	if config.AutoCreateParent.Endpoint != "" {
+		for ia := range config.Attributes {
+			attr := &config.Attributes[ia]
+			if !attr.Reference {
+				continue
+			}
+			// The parent can alternatively be referenced by name, which is looked up or created on demand
+			attr.ParentReference = true
+			parent := strings.TrimSuffix(attr.TfName, "_id")
+			config.AutoCreateParent.Reference = attr.TfName
+			config.AutoCreateParent.NameAttribute = parent + "_name"
+			config.AutoCreateParent.FlagAttribute = "create_" + parent
+		}
+		if config.AutoCreateParent.Reference != "" {
+			config.Attributes = append(config.Attributes, YamlConfigAttribute{
+				TfName:          config.AutoCreateParent.NameAttribute,
+				Type:            "String",
+				WriteOnly:       true,
+				RequiresReplace: true,
+				ExcludeTest:     true,
+				ExcludeExample:  true,
+				ParentAttribute: true,
+				Description:     fmt.Sprintf("The name of the parent object, which is looked up if `%s` is not configured.", config.AutoCreateParent.Reference),
+			}, YamlConfigAttribute{
+				TfName:          config.AutoCreateParent.FlagAttribute,
+				Type:            "Bool",
+				WriteOnly:       true,
+				ExcludeTest:     true,
+				ExcludeExample:  true,
+				ParentAttribute: true,
+				Description:     fmt.Sprintf("Create the parent object named `%s` if it does not exist. A parent created this way is deleted together with this object, a pre-existing parent is never deleted.", config.AutoCreateParent.NameAttribute),
+			})
+		}
+	}
+	for ia := range config.Attributes {
+		augmentAttribute(&config.Attributes[ia])
+	}
+	if config.CheckReservedNames || len(config.ReservedNames) > 0 {
+		// The names reserved by FMC, extended by the reserved names of the definition, are rejected at plan time
+		for ia := range config.Attributes {
+			if config.Attributes[ia].TfName == "name" {
+				config.Attributes[ia].CheckReservedNames = true
+			}
+		}
+	}
+	for _, m := range endpointParameterRegex.FindAllStringSubmatch(config.RestEndpoint, -1) {
+		for ia := range config.Attributes {
+			if config.Attributes[ia].TfName == m[1] {
+				config.Attributes[ia].EndpointParameter = true
+			}
+		}
+	}
+	for _, ep := range config.ReadEndpoints {
+		for ia := range config.Attributes {
+			if contains(ep.Attributes, config.Attributes[ia].TfName) {
+				// Attributes of additional read endpoints are read-only and their values depend on the FMC
+				config.Attributes[ia].ReadEndpoint = ep.Path
+				config.Attributes[ia].ExcludeTest = true
+			}
+		}
+	}
+	for ie := range config.EnrichRead {
+		// Resolve the attributes to the JSON paths of the response, relative to the list elements if any
+		er := &config.EnrichRead[ie]
+		if er.Field == "" {
+			er.Field = "name"
+		}
+		list, target, id := enrichAttributes(config.Attributes, *er)
+		if list != nil {
+			er.ListPath = attributePath(*list)
+		}
+		if id != nil {
+			er.IdPath = attributePath(*id)
+		}
+		if target != nil {
+			er.Path = attributePath(*target)
+			if list == nil {
+				// Enriched attributes are read-only and their values depend on the referenced objects
+				target.ReadEndpoint = er.Endpoint
+				target.ExcludeTest = true
+			}
+		}
+	}
+	for ia := range config.Attributes {
+		attr := &config.Attributes[ia]
+		if attr.ComposedValue == "" {
+			continue
+		}
+		if attr.Type != "String" {
+			log.Fatalf("%s: composed attribute '%s' must be of type String", config.Name, attr.TfName)
+		}
+		// Derive the example from the examples of the referenced attributes
+		example := attr.ComposedValue
+		for _, m := range composedRegex.FindAllStringSubmatch(attr.ComposedValue, -1) {
+			inputs := ComposedInputs(config.Attributes, m[0])
+			if len(inputs) == 0 || (inputs[0].Type != "String" && inputs[0].Type != "Int64" && inputs[0].Type != "Bool") {
+				log.Fatalf("%s: composed attribute '%s' references unknown or non-scalar attribute '%s'", config.Name, attr.TfName, m[1])
+			}
+			example = strings.Replace(example, m[0], inputs[0].Example, 1)
+		}
+		if attr.Example == "" {
+			attr.Example = example
+		}
+	}
+	if config.DsDescription == "" {
+		config.DsDescription = fmt.Sprintf("This data source can read the %s.", config.Name)
+	}
+	if config.ResDescription == "" {
+		name := strings.ToLower(config.Name)
+		if strings.HasPrefix(name, "a") || strings.HasPrefix(name, "e") || strings.HasPrefix(name, "i") || strings.HasPrefix(name, "o") || strings.HasPrefix(name, "u") {
+			config.ResDescription = fmt.Sprintf("This resource can manage an %s.", config.Name)
+		} else {
+			config.ResDescription = fmt.Sprintf("This resource can manage a %s.", config.Name)
+		}
+	}
+}
+
+// Keywords of a JSON schema supported by helpers.ValidateJSONSchema, including annotations without effect
+var jsonSchemaKeywords = []string{"type", "enum", "const", "properties", "required", "additionalProperties", "items", "minItems", "maxItems", "minLength", "maxLength", "pattern", "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "$schema", "$id", "$comment", "title", "description", "default", "examples"}
+
+// Return the first keyword of a JSON schema or its nested schemas which is not supported, e.g. oneOf or $ref
+func unsupportedJsonSchemaKeyword(schema interface{}) string {
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	keywords := make([]string, 0, len(s))
+	for keyword := range s {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	for _, keyword := range keywords {
+		if !contains(jsonSchemaKeywords, keyword) {
+			return keyword
+		}
+	}
+	nested := []interface{}{s["additionalProperties"], s["items"]}
+	if properties, ok := s["properties"].(map[string]interface{}); ok {
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			nested = append(nested, properties[name])
+		}
+	}
+	for _, n := range nested {
+		if keyword := unsupportedJsonSchemaKeyword(n); keyword != "" {
+			return keyword
+		}
+	}
+	return ""
+}
+
+var expressionRegex = regexp.MustCompile(`^[a-zA-Z_][\w-]*(\.[\w-]+|\[\d+\])+$`)
+
+// Check if a test value is a valid HCL value for the attribute type
+func validateTestValue(attr YamlConfigAttribute, value string) error {
+	// References to other resources, data sources or variables are allowed for any type
+	if expressionRegex.MatchString(value) {
+		return nil
+	}
+	switch attr.Type {
+	case "String":
+		if strings.HasPrefix(value, "[") || strings.HasPrefix(value, "{") {
+			return fmt.Errorf("value '%s' is not a string", value)
+		}
+	case "Int64":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("value '%s' is not an integer", value)
+		}
+	case "Float64":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("value '%s' is not a number", value)
+		}
+	case "Bool":
+		if value != "true" && value != "false" {
+			return fmt.Errorf("value '%s' is not a boolean", value)
+		}
+	case "StringList":
+		if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+			return fmt.Errorf("value '%s' is not a list", value)
+		}
+	case "List", "Set":
+		return fmt.Errorf("test values are not supported for type %s", attr.Type)
+	}
+	return nil
+}
+
+func validateAttributes(attributes []YamlConfigAttribute) error {
+	for _, attr := range attributes {
+		if attr.TestValue != "" {
+			if err := validateTestValue(attr, attr.TestValue); err != nil {
+				return fmt.Errorf("attribute '%s': invalid test_value for type %s: %w", attr.TfName, attr.Type, err)
+			}
+		}
+		if attr.MinimumTestValue != "" {
+			if err := validateTestValue(attr, attr.MinimumTestValue); err != nil {
+				return fmt.Errorf("attribute '%s': invalid minimum_test_value for type %s: %w", attr.TfName, attr.Type, err)
+			}
+		}
+		if attr.WriteOrder < 0 {
+			return fmt.Errorf("attribute '%s': write_order must be a positive number", attr.TfName)
+		}
+		if (attr.Format == "time_of_day" || attr.Format == "date_time") && attr.Type != "String" {
+			return fmt.Errorf("attribute '%s': format %s is only supported for type String", attr.TfName, attr.Format)
+		}
+		if attr.Format == "weekday" && attr.Type != "String" && attr.Type != "StringList" {
+			return fmt.Errorf("attribute '%s': format weekday is only supported for types String and StringList", attr.TfName)
+		}
+		if attr.ComputedMetadata && (attr.Id || attr.Mandatory || attr.DefaultValue != "") {
+			return fmt.Errorf("attribute '%s': computed_metadata can not be combined with id, mandatory or default_value", attr.TfName)
+		}
+		if len(attr.DefaultList) > 0 && (attr.Type != "StringList" || attr.Mandatory || attr.DefaultValue != "") {
+			return fmt.Errorf("attribute '%s': default_list is only supported for optional attributes of type StringList", attr.TfName)
+		}
+		if attr.ScalarOrList && attr.Type != "StringList" {
+			return fmt.Errorf("attribute '%s': scalar_or_list is only supported for type StringList", attr.TfName)
+		}
+		if attr.PreserveConfigOrder && attr.Type != "StringList" {
+			return fmt.Errorf("attribute '%s': preserve_config_order is only supported for type StringList, elements of lists are already matched by their key", attr.TfName)
+		}
+		if len(attr.EnumValues) > 0 && attr.Type != "String" && attr.Type != "StringList" {
+			return fmt.Errorf("attribute '%s': enum_values is only supported for types String and StringList", attr.TfName)
+		}
+		if attr.UniqueValues && attr.Type != "StringList" {
+			return fmt.Errorf("attribute '%s': unique_values is only supported for type StringList", attr.TfName)
+		}
+		if attr.TriState && (attr.Type != "Bool" || attr.Mandatory || attr.DefaultValue != "" || attr.Value != "") {
+			return fmt.Errorf("attribute '%s': tri_state is only supported for optional attributes of type Bool without default_value", attr.TfName)
+		}
+		if attr.Multipart != "" && attr.Multipart != "file" && attr.Multipart != "field" {
+			return fmt.Errorf("attribute '%s': multipart must be either \"file\" or \"field\"", attr.TfName)
+		}
+		for _, child := range attr.Attributes {
+			if child.Multipart != "" {
+				return fmt.Errorf("attribute '%s': multipart is only supported for top-level attributes", child.TfName)
+			}
+			if child.QueryParameter {
+				return fmt.Errorf("attribute '%s': query_parameter is only supported for top-level attributes", child.TfName)
+			}
+		}
+		if attr.QueryParameter && ((attr.Type != "String" && attr.Type != "Int64") || !attr.WriteOnly || attr.Value != "" || attr.Reference || attr.Id || len(attr.DataPath) > 0) {
+			return fmt.Errorf("attribute '%s': query_parameter is only supported for write_only attributes of types String and Int64 without data_path", attr.TfName)
+		}
+		if attr.LogRedactPattern != "" {
+			if attr.Type != "String" && attr.Type != "StringList" {
+				return fmt.Errorf("attribute '%s': log_redact_pattern is only supported for types String and StringList", attr.TfName)
+			}
+			if _, err := regexp.Compile(attr.LogRedactPattern); err != nil {
+				return fmt.Errorf("attribute '%s': invalid log_redact_pattern: %w", attr.TfName, err)
+			}
+		}
+		if len(attr.EnumIntegers) > 0 && (attr.Type != "String" || len(attr.EnumIntegers) != len(attr.EnumValues)) {
+			return fmt.Errorf("attribute '%s': enum_integers requires type String and one integer per enum value", attr.TfName)
+		}
+		if attr.WarnThreshold != 0 && (attr.Type != "Int64" || attr.MaxInt == 0 || attr.WarnThreshold < 1 || attr.WarnThreshold > 99) {
+			return fmt.Errorf("attribute '%s': warn_threshold is only supported for type Int64 with max_int and must be a percentage between 1 and 99", attr.TfName)
+		}
+		if attr.Scale != 0 && attr.Type != "Int64" && attr.Type != "Float64" {
+			return fmt.Errorf("attribute '%s': scale is only supported for types Int64 and Float64", attr.TfName)
+		}
+		if attr.Scale < 0 {
+			return fmt.Errorf("attribute '%s': scale must be a positive number", attr.TfName)
+		}
+		if attr.MinAttribute != "" {
+			siblings := AttributesByName(attributes, []string{attr.MinAttribute})
+			if attr.Type != "Int64" || len(siblings) != 1 || siblings[0].Type != "Int64" || attr.MinAttribute == attr.TfName {
+				return fmt.Errorf("attribute '%s': min_attribute must refer to another attribute of type Int64 on the same level by tf_name", attr.TfName)
+			}
+		}
+		if (attr.WithinCidr != "" || attr.WithinCidrAttribute != "") && (attr.Type != "String" || len(attr.EnumValues) > 0 || attr.Format != "") {
+			return fmt.Errorf("attribute '%s': within_cidr and within_cidr_attribute are only supported for type String without enum_values or format", attr.TfName)
+		}
+		if attr.JsonSchema != "" {
+			var schema map[string]interface{}
+			if attr.Type != "String" || len(attr.EnumValues) > 0 || attr.Format != "" {
+				return fmt.Errorf("attribute '%s': json_schema is only supported for type String without enum_values or format", attr.TfName)
+			}
+			if err := json.Unmarshal([]byte(attr.JsonSchema), &schema); err != nil {
+				return fmt.Errorf("attribute '%s': json_schema must be a JSON object: %v", attr.TfName, err)
+			}
+			if keyword := unsupportedJsonSchemaKeyword(schema); keyword != "" {
+				return fmt.Errorf("attribute '%s': json_schema keyword '%s' is not supported", attr.TfName, keyword)
+			}
+		}
+		if attr.WithinCidr != "" && attr.WithinCidrAttribute != "" {
+			return fmt.Errorf("attribute '%s': within_cidr and within_cidr_attribute are mutually exclusive", attr.TfName)
+		}
+		if attr.WithinCidr != "" {
+			if _, err := netip.ParsePrefix(attr.WithinCidr); err != nil {
+				return fmt.Errorf("attribute '%s': within_cidr must be a prefix in CIDR notation: %v", attr.TfName, err)
+			}
+		}
+		if attr.AfterAttribute != "" {
+			siblings := AttributesByName(attributes, []string{attr.AfterAttribute})
+			if (attr.Format != "time_of_day" && attr.Format != "date_time") || len(siblings) != 1 || siblings[0].Format != attr.Format || attr.AfterAttribute == attr.TfName {
+				return fmt.Errorf("attribute '%s': after_attribute is only supported for format time_of_day or date_time and must refer to another attribute with the same format on the same level by tf_name", attr.TfName)
+			}
+		}
+		if attr.WithinCidrAttribute != "" {
+			siblings := AttributesByName(attributes, []string{attr.WithinCidrAttribute})
+			if len(siblings) != 1 || siblings[0].Type != "String" || attr.WithinCidrAttribute == attr.TfName {
+				return fmt.Errorf("attribute '%s': within_cidr_attribute must refer to another attribute of type String on the same level by tf_name", attr.TfName)
+			}
+		}
+		if attr.LookupEndpoint != "" || attr.LookupName != "" {
+			siblings := AttributesByName(attributes, []string{attr.LookupName})
+			if attr.Type != "String" || attr.Mandatory || attr.Id || attr.Value != "" || attr.DefaultValue != "" || attr.LookupEndpoint == "" {
+				return fmt.Errorf("attribute '%s': lookup_endpoint is only supported for optional attributes of type String without default_value", attr.TfName)
+			}
+			if len(siblings) != 1 || siblings[0].Type != "String" || siblings[0].Mandatory || !siblings[0].WriteOnly || attr.LookupName == attr.TfName {
+				return fmt.Errorf("attribute '%s': lookup_name must refer to another optional write_only attribute of type String on the same level by tf_name", attr.TfName)
+			}
+		}
+		if attr.Type == "Map" && !contains([]string{"String", "Int64", "Bool"}, attr.ElementType) {
+			return fmt.Errorf("attribute '%s': attributes of type Map require an element_type of String, Int64 or Bool", attr.TfName)
+		}
+		if attr.Type == "Map" && (len(attr.Attributes) > 0 || attr.Id || attr.Reference || attr.Value != "" || attr.DefaultValue != "" || attr.QueryParameter) {
+			return fmt.Errorf("attribute '%s': attributes of type Map hold values of the element_type, they can not have nested attributes or be an id, reference, query_parameter, value or default_value", attr.TfName)
+		}
+		if attr.ElementType != "" && attr.Type != "Map" {
+			return fmt.Errorf("attribute '%s': element_type is only supported for type Map", attr.TfName)
+		}
+		if err := validateAttributes(attr.Attributes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Return the JSON path of an attribute relative to its parent
+func attributePath(attr YamlConfigAttribute) string {
+	return strings.Join(append(append([]string{}, attr.DataPath...), attr.ModelName), ".")
+}
+
+// Return the list attribute, if any, the enriched attribute and the ID attribute of an enrich_read entry,
+// attributes which do not exist are returned as nil
+func enrichAttributes(attributes []YamlConfigAttribute, er YamlEnrichRead) (*YamlConfigAttribute, *YamlConfigAttribute, *YamlConfigAttribute) {
+	var list, target, id *YamlConfigAttribute
+	targetName, idName := er.Attribute, er.IdAttribute
+	if listName, name, ok := strings.Cut(er.Attribute, "."); ok {
+		targetName = name
+		idName, ok = strings.CutPrefix(er.IdAttribute, listName+".")
+		if !ok {
+			idName = ""
+		}
+		elements := attributes
+		attributes = nil
+		for ia := range elements {
+			if elements[ia].TfName == listName && (elements[ia].Type == "List" || elements[ia].Type == "Set") {
+				list = &elements[ia]
+				attributes = elements[ia].Attributes
+			}
+		}
+	}
+	for ia := range attributes {
+		if attributes[ia].TfName == targetName {
+			target = &attributes[ia]
+		}
+		if attributes[ia].TfName == idName {
+			id = &attributes[ia]
+		}
+	}
+	return list, target, id
+}
+
+// Return warnings for a top-level id attribute which deviates from the conventional "id" field of the
+// response. This might be intended, but often is a mistake breaking the capture of the ID on create.
+func idWarnings(attributes []YamlConfigAttribute) []string {
+	var warnings []string
+	for _, attr := range attributes {
+		if attr.Id && (len(attr.DataPath) > 0 || attr.ModelName != "id") {
+			warnings = append(warnings, fmt.Sprintf("attribute '%s': id attribute is read from '%s' instead of 'id'", attr.TfName, attributePath(attr)))
+		}
+	}
+	return warnings
+}
+
+// Check the definition for errors which would otherwise result in broken generated code
+func validateConfig(config YamlConfig) error {
+	for _, ep := range config.ReadEndpoints {
+		for _, name := range ep.Attributes {
+			found := false
+			for _, attr := range config.Attributes {
+				if attr.TfName == name {
+					found = true
+					if attr.Id || attr.Reference || attr.Mandatory || attr.ResourceId || attr.Value != "" {
+						return fmt.Errorf("read_endpoints: attribute '%s' can not be an id, reference, mandatory, resource_id or value attribute", name)
+					}
+				}
+			}
+			if !found {
+				return fmt.Errorf("read_endpoints: attribute '%s' of endpoint '%s' must refer to a top-level attribute by tf_name", name, ep.Path)
+			}
+		}
+	}
+	for _, er := range config.EnrichRead {
+		if er.Endpoint == "" || strings.Contains(er.Endpoint, "%v") {
+			return fmt.Errorf("enrich_read: attribute '%s' requires an endpoint without parameters", er.Attribute)
+		}
+		list, target, id := enrichAttributes(config.Attributes, er)
+		if target == nil || target.Type != "String" {
+			return fmt.Errorf("enrich_read: attribute '%s' must refer to a String attribute by tf_name, either top-level or '<list>.<attribute>'", er.Attribute)
+		}
+		if id == nil || (id.Type != "String" && id.Type != "Int64") {
+			return fmt.Errorf("enrich_read: id_attribute '%s' must refer to a String or Int64 attribute by tf_name, of the same list as attribute '%s'", er.IdAttribute, er.Attribute)
+		}
+		if list != nil && !target.ComputedMetadata {
+			return fmt.Errorf("enrich_read: attribute '%s' of list elements must be computed_metadata", er.Attribute)
+		}
+		if list == nil && (target.Reference || target.Mandatory || target.ResourceId || target.Value != "" || len(target.DefaultValue) > 0) {
+			return fmt.Errorf("enrich_read: attribute '%s' can not be a reference, mandatory, resource_id, value or default_value attribute", er.Attribute)
+		}
+	}
+	// Elements of lists may be identified by several id attributes of any type, the object itself has a
+	// single ID captured as String
+	ids := 0
+	for _, attr := range config.Attributes {
+		if attr.Id {
+			ids++
+			if ids > 1 {
+				return fmt.Errorf("attribute '%s': only a single top-level attribute can be the id of the object", attr.TfName)
+			}
+			if attr.Type != "String" {
+				return fmt.Errorf("attribute '%s': the id of the object must be of type String", attr.TfName)
+			}
+		}
+	}
+	for _, attr := range config.Attributes {
+		if attr.ComputedMetadata {
+			return fmt.Errorf("attribute '%s': computed_metadata is only supported for attributes of list elements", attr.TfName)
+		}
+		if attr.LookupEndpoint != "" {
+			return fmt.Errorf("attribute '%s': lookup_endpoint is only supported for attributes of top-level list elements", attr.TfName)
+		}
+	}
+	for _, attr := range config.Attributes {
+		if attr.AcceptLegacyName == "" {
+			continue
+		}
+		if !contains([]string{"String", "Int64", "Float64", "Bool"}, attr.Type) || attr.Mandatory || attr.Id || attr.Reference || attr.ResourceId || attr.Value != "" || attr.ComposedValue != "" || attr.WriteChangesOnly || attr.DefaultValue != "" || attr.Scale != 0 || len(attr.EnumIntegers) > 0 {
+			return fmt.Errorf("attribute '%s': accept_legacy_name is only supported for optional attributes of type String, Int64, Float64 or Bool without default_value, scale or enum_integers", attr.TfName)
+		}
+		for _, other := range config.Attributes {
+			if other.TfName == attr.AcceptLegacyName || (other.AcceptLegacyName == attr.AcceptLegacyName && other.TfName != attr.TfName) {
+				return fmt.Errorf("attribute '%s': accept_legacy_name '%s' collides with attribute '%s'", attr.TfName, attr.AcceptLegacyName, other.TfName)
+			}
+		}
+	}
+	for _, attr := range config.Attributes {
+		if attr.ExplicitNull && (!contains([]string{"String", "Int64", "Float64", "Bool", "StringList"}, attr.Type) || attr.Mandatory || attr.Id || attr.Reference || attr.ResourceId || attr.Value != "" || attr.ComposedValue != "" || attr.DefaultValue != "" || len(attr.DefaultList) > 0) {
+			return fmt.Errorf("attribute '%s': explicit_null is only supported for optional attributes of type String, Int64, Float64, Bool or StringList without default_value or default_list", attr.TfName)
+		}
+	}
+	for _, attr := range config.Attributes {
+		if !attr.MapKeyed {
+			continue
+		}
+		if (attr.Type != "List" && attr.Type != "Set") || attr.ModelName == "" {
+			return fmt.Errorf("attribute '%s': map_keyed is only supported for attributes of type List or Set with a model_name", attr.TfName)
+		}
+		if MapKey(attr).Type != "String" {
+			return fmt.Errorf("attribute '%s': map_keyed requires an id attribute of type String holding the keys of the elements", attr.TfName)
+		}
+	}
+	deltas := 0
+	for _, attr := range config.Attributes {
+		if !attr.DeltaUpdate {
+			continue
+		}
+		deltas++
+		if (attr.Type != "List" && attr.Type != "Set") || attr.ModelName == "" || attr.MapKeyed || !HasId(attr.Attributes) {
+			return fmt.Errorf("attribute '%s': delta_update is only supported for attributes of type List or Set with a model_name and an id attribute of the elements", attr.TfName)
+		}
+		if config.NoUpdate || len(config.NaturalKey) > 0 {
+			return fmt.Errorf("attribute '%s': delta_update can not be combined with no_update or natural_key", attr.TfName)
+		}
+	}
+	if deltas > 1 {
+		return fmt.Errorf("only a single attribute can use delta_update")
+	}
+	for _, attr := range config.Attributes {
+		if attr.NestingLimit == 0 && !attr.PreventCycles {
+			continue
+		}
+		if typ := AttributesByName(attr.Attributes, []string{"type"}); attr.GroupType == "" || len(typ) != 1 || typ[0].Type != "String" || typ[0].ModelName != "type" || len(typ[0].DataPath) > 0 {
+			return fmt.Errorf("attribute '%s': nesting_limit and prevent_cycles require a group_type and a type attribute of the elements with model_name 'type'", attr.TfName)
+		}
+	}
+	nestingLimits := 0
+	for _, attr := range config.Attributes {
+		if attr.NestingLimit == 0 {
+			continue
+		}
+		nestingLimits++
+		if attr.Type != "List" && attr.Type != "Set" || attr.ModelName == "" || attr.MapKeyed {
+			return fmt.Errorf("attribute '%s': nesting_limit is only supported for attributes of type List or Set with a model_name", attr.TfName)
+		}
+		if id := AttributesByName(attr.Attributes, []string{"id"}); len(id) != 1 || !id[0].Id || id[0].Type != "String" || id[0].ModelName != "id" || len(id[0].DataPath) > 0 {
+			return fmt.Errorf("attribute '%s': nesting_limit requires an id attribute of the elements with model_name 'id'", attr.TfName)
+		}
+		if attr.NestingLimit < 1 || config.NoResource || strings.Contains(config.RestEndpoint, "%v") || endpointParameterRegex.MatchString(config.RestEndpoint) {
+			return fmt.Errorf("attribute '%s': nesting_limit must be positive and requires a resource without parent objects or endpoint parameters", attr.TfName)
+		}
+	}
+	if nestingLimits > 1 {
+		return fmt.Errorf("only a single attribute can use nesting_limit")
+	}
+	for _, attr := range config.Attributes {
+		if !attr.PreventCycles {
+			continue
+		}
+		if attr.Type != "List" && attr.Type != "Set" || attr.ModelName == "" || attr.MapKeyed {
+			return fmt.Errorf("attribute '%s': prevent_cycles is only supported for attributes of type List or Set with a model_name", attr.TfName)
+		}
+		if id := AttributesByName(attr.Attributes, []string{"id"}); len(id) != 1 || !id[0].Id || id[0].Type != "String" || id[0].ModelName != "id" || len(id[0].DataPath) > 0 {
+			return fmt.Errorf("attribute '%s': prevent_cycles requires an id attribute of the elements with model_name 'id'", attr.TfName)
+		}
+		if config.NoResource || strings.Contains(config.RestEndpoint, "%v") || endpointParameterRegex.MatchString(config.RestEndpoint) {
+			return fmt.Errorf("attribute '%s': prevent_cycles requires a resource without parent objects or endpoint parameters", attr.TfName)
+		}
+	}
+	references := 0
+	for _, attr := range config.Attributes {
+		if attr.Reference {
+			references++
+		}
+	}
+	for _, attr := range config.Attributes {
+		if attr.ExistsEndpoint == "" {
+			continue
+		}
+		if attr.Type != "String" || attr.Reference || attr.ResourceId || attr.Value != "" || attr.ComposedValue != "" || attr.ReadEndpoint != "" || config.NoResource {
+			return fmt.Errorf("attribute '%s': exists_endpoint is only supported for configurable top-level attributes of type String", attr.TfName)
+		}
+		if strings.Count(attr.ExistsEndpoint, "%v") != references || endpointParameterRegex.MatchString(attr.ExistsEndpoint) {
+			return fmt.Errorf("attribute '%s': exists_endpoint must have a \"%%v\" placeholder for each reference attribute and no other placeholders", attr.TfName)
+		}
+	}
+	discriminators := 0
+	for _, attr := range config.Attributes {
+		if attr.Discriminator {
+			discriminators++
+			if attr.Type != "String" || len(attr.EnumValues) == 0 || len(attr.DiscriminatorValues) > 0 {
+				return fmt.Errorf("attribute '%s': discriminator must be of type String with enum_values", attr.TfName)
+			}
+		}
+	}
+	if discriminators > 1 {
+		return fmt.Errorf("only a single attribute can be a discriminator")
+	}
+	for _, attr := range config.Attributes {
+		if attr.RequiredForValues && len(attr.DiscriminatorValues) == 0 {
+			return fmt.Errorf("attribute '%s': required_for_values requires discriminator_values", attr.TfName)
+		}
+		if len(attr.DiscriminatorValues) == 0 {
+			continue
+		}
+		if discriminators == 0 || attr.Mandatory || attr.Id || attr.Reference || attr.Value != "" {
+			return fmt.Errorf("attribute '%s': discriminator_values requires a discriminator attribute and an optional attribute", attr.TfName)
+		}
+		for _, value := range attr.DiscriminatorValues {
+			if !contains(Discriminator(config.Attributes).EnumValues, value) {
+				return fmt.Errorf("attribute '%s': discriminator value '%s' is not one of the enum_values of the discriminator", attr.TfName, value)
+			}
+		}
+	}
+	for _, attr := range config.Attributes {
+		for _, name := range attr.Implies {
+			implied := AttributesByName(config.Attributes, []string{name})
+			if len(implied) == 0 || name == attr.TfName || implied[0].Value != "" || implied[0].Id {
+				return fmt.Errorf("attribute '%s': implied attribute '%s' is not another configurable top-level attribute", attr.TfName, name)
+			}
+			if attr.Mandatory || attr.Value != "" || attr.Id || attr.Reference || implied[0].Mandatory || implied[0].Reference {
+				return fmt.Errorf("attribute '%s': implies is only supported between optional attributes", attr.TfName)
+			}
+		}
+	}
+	var checkNested func(attributes []YamlConfigAttribute) error
+	checkNested = func(attributes []YamlConfigAttribute) error {
+		for _, attr := range attributes {
+			if len(attr.Implies) > 0 {
+				return fmt.Errorf("attribute '%s': implies is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.AcceptLegacyName != "" {
+				return fmt.Errorf("attribute '%s': accept_legacy_name is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.ExplicitNull {
+				return fmt.Errorf("attribute '%s': explicit_null is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.MapKeyed {
+				return fmt.Errorf("attribute '%s': map_keyed is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.DeltaUpdate {
+				return fmt.Errorf("attribute '%s': delta_update is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.RecreateOnChange {
+				return fmt.Errorf("attribute '%s': recreate_on_change is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.TypedEnum {
+				return fmt.Errorf("attribute '%s': typed_enum is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.Placement {
+				return fmt.Errorf("attribute '%s': placement is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.AutoAssigned {
+				return fmt.Errorf("attribute '%s': auto_assigned is only supported for top-level attributes", attr.TfName)
+			}
+			if attr.Computed {
+				return fmt.Errorf("attribute '%s': computed is only supported for top-level attributes, use computed_metadata for attributes of list elements", attr.TfName)
+			}
+			for _, child := range attr.Attributes {
+				if child.LookupEndpoint != "" {
+					return fmt.Errorf("attribute '%s': lookup_endpoint is only supported for attributes of top-level list elements", child.TfName)
+				}
+			}
+			if attr.Discriminator || len(attr.DiscriminatorValues) > 0 {
+				return fmt.Errorf("attribute '%s': discriminator and discriminator_values are only supported for top-level attributes", attr.TfName)
+			}
+			if err := checkNested(attr.Attributes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, attr := range config.Attributes {
+		if err := checkNested(attr.Attributes); err != nil {
+			return err
+		}
+	}
+	// Maps are supported as top-level attributes and as attributes of top-level list elements
+	var checkMaps func(attributes []YamlConfigAttribute) error
+	checkMaps = func(attributes []YamlConfigAttribute) error {
+		for _, attr := range attributes {
+			if attr.Type == "Map" {
+				return fmt.Errorf("attribute '%s': attributes of type Map are only supported for top-level attributes and attributes of top-level list elements", attr.TfName)
+			}
+			if err := checkMaps(attr.Attributes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, attr := range config.Attributes {
+		for _, child := range attr.Attributes {
+			if err := checkMaps(child.Attributes); err != nil {
+				return err
+			}
+		}
+	}
+	for _, attr := range config.Attributes {
+		if attr.Placement && (attr.Type != "String" || attr.ModelName == "" || attr.WriteOnly || attr.QueryParameter || attr.Reference || attr.Mandatory || attr.DefaultValue != "" || attr.Value != "" || attr.Id) {
+			return fmt.Errorf("attribute '%s': placement is only supported for configurable attributes of type String with a model_name, which are not write_only, query_parameter, reference, mandatory or have a default_value", attr.TfName)
+		}
+	}
+	for _, attr := range config.Attributes {
+		if attr.AutoAssigned && ((attr.Type != "String" && attr.Type != "Int64") || attr.Mandatory || attr.Reference || attr.WriteOnly || attr.QueryParameter || attr.Placement || attr.ResourceId || attr.Id || attr.DefaultValue != "" || attr.Value != "") {
+			return fmt.Errorf("attribute '%s': auto_assigned is only supported for optional attributes of types String and Int64 without default_value, which are not write_only, query_parameter, placement or reference", attr.TfName)
+		}
+		if attr.Computed && (!contains([]string{"String", "Int64", "Float64", "Bool"}, attr.Type) || attr.Mandatory || attr.Reference || attr.ResourceId || attr.Value != "" || attr.ComposedValue != "" || attr.DefaultValue != "" || attr.WriteOnly || attr.WriteChangesOnly || attr.ExplicitNull || attr.QueryParameter || attr.Placement || attr.AutoAssigned || attr.RequiresReplace) {
+			return fmt.Errorf("attribute '%s': computed is only supported for attributes of types String, Int64, Float64 and Bool, which are not mandatory, reference, resource_id, value, composed_value, default_value, write_only, write_changes_only, explicit_null, query_parameter, placement, auto_assigned or requires_replace", attr.TfName)
+		}
+	}
+	for _, attr := range config.Attributes {
+		if attr.TypedEnum && ((attr.Type != "String" && attr.Type != "StringList") || len(attr.EnumValues) == 0 || attr.Value != "") {
+			return fmt.Errorf("attribute '%s': typed_enum is only supported for attributes of type String or StringList with enum_values", attr.TfName)
+		}
+	}
+	for _, attr := range config.Attributes {
+		if !attr.RecreateOnChange {
+			continue
+		}
+		if attr.Type == "List" || attr.Type == "Set" || attr.RequiresReplace || attr.Reference || attr.Value != "" || attr.WriteOnly || attr.EndpointParameter {
+			return fmt.Errorf("attribute '%s': recreate_on_change is only supported for configurable attributes which are not a List, Set, reference or requires_replace", attr.TfName)
+		}
+		if config.NoUpdate || config.NoDelete || config.PutCreate || len(config.NaturalKey) > 0 || config.DeleteEndpoint != "" || config.SoftDelete != "" || len(config.ChildEndpoints) > 0 || config.AutoCreateParent.Endpoint != "" {
+			return fmt.Errorf("attribute '%s': recreate_on_change can not be combined with no_update, no_delete, put_create, natural_key, delete_endpoint, soft_delete, child_endpoints or auto_create_parent", attr.TfName)
+		}
+	}
+	if len(config.PreviousResourceNames) > 0 && config.NoResource {
+		return fmt.Errorf("previous_resource_names: can not be combined with no_resource")
+	}
+	if config.DataSourceUsage && (!strings.Contains(config.RestEndpoint, "/domain/{DOMAIN_UUID}/") || strings.Contains(config.RestEndpoint, "%v")) {
+		return fmt.Errorf("data_source_usage: only supported for objects below '/domain/{DOMAIN_UUID}/' without parent objects")
+	}
+	for _, m := range endpointParameterRegex.FindAllStringSubmatch(config.RestEndpoint, -1) {
+		found := false
+		for _, attr := range config.Attributes {
+			if attr.TfName == m[1] && attr.EndpointParameter {
+				found = true
+				if attr.Type != "String" || !attr.Mandatory || !attr.RequiresReplace || attr.Reference || attr.Value != "" || attr.WriteOnly {
+					return fmt.Errorf("rest_endpoint: the attribute '%s' of placeholder '%s' must be a mandatory String attribute with requires_replace", attr.TfName, m[0])
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("rest_endpoint: no attribute found for placeholder '%s'", m[0])
+		}
+		if config.DataSourcePath || config.DataSourceUsage || config.DataSourceCount || config.DataSourceDiff || config.DataSourceDrift || config.Overridable || config.TestDisappears {
+			return fmt.Errorf("rest_endpoint: placeholders can not be combined with data_source_path, data_source_usage, data_source_count, data_source_diff, data_source_drift, overridable or test_disappears")
+		}
+	}
+	if config.CheckReservedNames || len(config.ReservedNames) > 0 {
+		found := false
+		for _, attr := range config.Attributes {
+			if attr.CheckReservedNames && attr.Type == "String" && attr.Value == "" && len(attr.EnumValues) == 0 && attr.Format == "" {
+				found = true
+			}
+		}
+		if !found || config.NoResource {
+			return fmt.Errorf("reserved_names: requires a resource with a String name attribute")
+		}
+		for _, name := range config.ReservedNames {
+			if strings.TrimSpace(name) == "" {
+				return fmt.Errorf("reserved_names: names must not be empty")
+			}
+		}
+	}
+	if config.DataSourceAllPages {
+		items := false
+		for _, attr := range config.Attributes {
+			if attr.ModelName == "items" && attr.Type == "List" && len(attr.DataPath) == 0 {
+				items = true
+			}
+		}
+		if !config.DataSourceNoId || !items || strings.Contains(config.RestEndpoint, "limit=") || strings.Contains(config.RestEndpoint, "offset=") {
+			return fmt.Errorf("data_source_all_pages: requires data_source_no_id, a top-level List attribute with model_name 'items' and a REST endpoint without limit or offset")
+		}
+	}
+	if config.DataSourceCount && (config.NoResource || config.DataSourceNoId || strings.Contains(config.RestEndpoint, "?")) {
+		return fmt.Errorf("data_source_count: only supported for REST endpoints listing objects without query parameters")
+	}
+	if (config.DataSourceDiff || config.DataSourceDrift) && (config.DataSourceNoId || strings.Contains(config.RestEndpoint, "?") || strings.Contains(config.RestEndpoint, "%v")) {
+		return fmt.Errorf("data_source_diff, data_source_drift: only supported for objects read by ID without parent objects or query parameters")
+	}
+	if config.Bulk {
+		// The items of a bulk resource are single objects matched by name, options resolving other objects,
+		// changing how an object is written or referring to attributes by root path are not supported
+		var unsupported func(attributes []YamlConfigAttribute) bool
+		unsupported = func(attributes []YamlConfigAttribute) bool {
+			for _, attr := range attributes {
+				if attr.Reference || attr.ResourceId || attr.EndpointParameter || attr.WriteOnly || attr.Multipart != "" || attr.QueryParameter || attr.Computed || attr.ComputedMetadata || attr.AutoAssigned || attr.RecreateOnChange || attr.Placement || attr.DeltaUpdate || attr.WriteChangesOnly || attr.ComposedValue != "" || attr.AcceptLegacyName != "" || attr.AfterAttribute != "" || attr.MinAttribute != "" || attr.WithinCidrAttribute != "" || attr.LookupEndpoint != "" || attr.ExistsEndpoint != "" || attr.NestingLimit != 0 || attr.PreventCycles || attr.Discriminator || len(attr.Implies) > 0 || unsupported(attr.Attributes) {
+					return true
+				}
+			}
+			return false
+		}
+		name := false
+		for _, attr := range config.Attributes {
+			if attr.TfName == "name" && attr.Type == "String" && attr.Mandatory && attr.Value == "" {
+				name = true
+			}
+		}
+		if !name || config.NoResource || config.NoUpdate || config.NoDelete || config.DataSourceNoId || strings.Contains(config.RestEndpoint, "?") || strings.Contains(config.RestEndpoint, "%v") {
+			return fmt.Errorf("bulk: requires a resource with a mandatory String name attribute, read by ID without parent objects or query parameters")
+		}
+		if config.PutCreate || config.TwoPhaseCreate || config.ContentType != "" || config.DeleteEndpoint != "" || config.SoftDelete != "" || len(config.ChildEndpoints) > 0 || len(config.NaturalKey) > 0 || len(config.ReadEndpoints) > 0 || len(config.EnrichRead) > 0 || len(config.CreateDataPath) > 0 || config.TrackByName || config.AutoCreateParent.Endpoint != "" || config.PostApplyCheck.Field != "" || config.MoveEndpoint != (YamlMoveEndpoint{}) || unsupported(config.Attributes) {
+			return fmt.Errorf("bulk: can not be combined with options changing how a single object is created, read or deleted, or with attributes resolving other objects")
+		}
+	}
+	if config.SoftDelete != "" {
+		found := false
+		for _, attr := range config.Attributes {
+			if attr.TfName == config.SoftDelete && attr.Type == "Bool" && attr.Value == "" && !attr.WriteOnly {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("soft_delete: no top-level attribute of type Bool found with name '%s'", config.SoftDelete)
+		}
+		if config.NoDelete || config.NoUpdate || config.NoResource || config.DeleteEndpoint != "" || len(config.NaturalKey) > 0 || len(config.ChildEndpoints) > 0 || config.AutoCreateParent.Endpoint != "" || config.TestDisappears {
+			return fmt.Errorf("soft_delete: can not be combined with no_delete, no_update, no_resource, delete_endpoint, natural_key, child_endpoints, auto_create_parent or test_disappears")
+		}
+	}
+	if config.DeleteEndpoint != "" {
+		references := 0
+		for _, attr := range config.Attributes {
+			if attr.Reference {
+				references++
+			}
+		}
+		if strings.Count(config.DeleteEndpoint, "%v") != references+1 {
+			return fmt.Errorf("delete_endpoint: requires a %%v placeholder for each reference attribute followed by one for the ID of the object")
+		}
+		if config.NoDelete || len(config.NaturalKey) > 0 {
+			return fmt.Errorf("delete_endpoint: can not be combined with no_delete or natural_key")
+		}
+	}
+	if config.MoveEndpoint != (YamlMoveEndpoint{}) {
+		found := false
+		for _, attr := range config.Attributes {
+			if attr.TfName == config.MoveEndpoint.Attribute && attr.Type == "Int64" && attr.QueryParameter && !attr.RequiresReplace {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("move_endpoint: no top-level query_parameter attribute of type Int64 without requires_replace found with name '%s'", config.MoveEndpoint.Attribute)
+		}
+		if !strings.HasPrefix(config.MoveEndpoint.Path, "/") {
+			return fmt.Errorf("move_endpoint: path must be relative to the object and start with '/'")
+		}
+		if config.NoUpdate || len(config.NaturalKey) > 0 || DeltaUpdate(config.Attributes).TfName != "" {
+			return fmt.Errorf("move_endpoint: can not be combined with no_update, natural_key or delta_update")
+		}
+	}
+	if config.PartialRead {
+		var mapKeyed func(attributes []YamlConfigAttribute) bool
+		mapKeyed = func(attributes []YamlConfigAttribute) bool {
+			for _, attr := range attributes {
+				if attr.MapKeyed || mapKeyed(attr.Attributes) {
+					return true
+				}
+			}
+			return false
+		}
+		if config.NoResource || mapKeyed(config.Attributes) {
+			return fmt.Errorf("partial_read: can not be combined with no_resource or map_keyed attributes")
+		}
+	}
+	if len(config.CreateDataPath) > 0 && (config.NoResource || len(config.NaturalKey) > 0) {
+		return fmt.Errorf("create_data_path: can not be combined with no_resource or natural_key")
+	}
+	if config.PostApplyCheck != (YamlPostApplyCheck{}) {
+		if config.PostApplyCheck.Field == "" || config.PostApplyCheck.Value == "" {
+			return fmt.Errorf("post_apply_check: field and value are required")
+		}
+		if config.PostApplyCheck.Path != "" && !strings.HasPrefix(config.PostApplyCheck.Path, "/") {
+			return fmt.Errorf("post_apply_check: path must be relative to the object and start with '/'")
+		}
+		if config.PostApplyCheck.Timeout < 0 {
+			return fmt.Errorf("post_apply_check: timeout must be a positive number of seconds")
+		}
+		if config.NoResource || len(config.NaturalKey) > 0 {
+			return fmt.Errorf("post_apply_check: can not be combined with no_resource or natural_key")
+		}
+	}
+	if config.AutoCreateParent.Endpoint != "" {
+		references := 0
+		for _, attr := range config.Attributes {
+			if attr.Reference {
+				references++
+				if attr.Type != "String" {
+					return fmt.Errorf("auto_create_parent: the reference attribute '%s' must be of type String", attr.TfName)
+				}
+			}
+		}
+		if references != 1 {
+			return fmt.Errorf("auto_create_parent: requires exactly one reference attribute, found %d", references)
+		}
+		if strings.Contains(config.AutoCreateParent.Endpoint, "%v") {
+			return fmt.Errorf("auto_create_parent: the endpoint of the parent can not have parent objects itself")
+		}
+		if config.AutoCreateParent.Body != "" && !json.Valid([]byte(config.AutoCreateParent.Body)) {
+			return fmt.Errorf("auto_create_parent: body must be a JSON object")
+		}
+		if config.PutCreate || config.NoDelete || len(config.NaturalKey) > 0 {
+			return fmt.Errorf("auto_create_parent: can not be combined with put_create, no_delete or natural_key")
+		}
+	}
+	if config.PreChangeSnapshot && (config.NoResource || (config.NoUpdate && config.NoDelete && config.SoftDelete == "")) {
+		return fmt.Errorf("pre_change_snapshot: requires a resource which updates or deletes the object")
+	}
+	if config.TestDisappears && (config.ExcludeTest || config.NoResource || config.NoDelete || config.PutCreate || len(config.NaturalKey) > 0) {
+		return fmt.Errorf("test_disappears: can not be combined with exclude_test, no_resource, no_delete, put_create or natural_key")
+	}
+	if config.SkipReadAfterCreate {
+		if len(config.ReadEndpoints) > 0 || len(config.EnrichRead) > 0 || len(config.NaturalKey) > 0 {
+			return fmt.Errorf("skip_read_after_create: can not be combined with read_endpoints, enrich_read or natural_key")
+		}
+		for _, attr := range config.Attributes {
+			if HasResourceId(attr.Attributes) {
+				return fmt.Errorf("skip_read_after_create: resource_id attribute below '%s' can not be taken from the create response, only top-level resource_id attributes are supported", attr.TfName)
+			}
+		}
+	}
+	if config.ExampleVariabilize && config.NoResource {
+		return fmt.Errorf("example_variabilize: can not be combined with no_resource")
+	}
+	if config.IgnoreWarnings && config.PutCreate {
+		return fmt.Errorf("ignore_warnings: can not be combined with put_create")
+	}
+	if config.SurfaceWarnings && config.NoResource {
+		return fmt.Errorf("surface_warnings: can not be combined with no_resource")
+	}
+	if config.ChangeComment && (config.NoResource || config.ContentType != "" || config.MoveEndpoint.Path != "") {
+		return fmt.Errorf("change_comment: can not be combined with no_resource, content_type or move_endpoint")
+	}
+	if config.SplitFiles && config.NoResource {
+		return fmt.Errorf("split_files: can not be combined with no_resource")
+	}
+	if config.TrackByName {
+		names := AttributesByName(config.Attributes, []string{"name"})
+		if len(names) != 1 || names[0].Type != "String" || !names[0].Mandatory || names[0].ModelName != "name" || len(names[0].DataPath) > 0 {
+			return fmt.Errorf("track_by_name: requires a mandatory top-level String attribute 'name'")
+		}
+		if len(config.NaturalKey) > 0 || config.NoResource {
+			return fmt.Errorf("track_by_name: can not be combined with natural_key or no_resource")
+		}
+	}
+	if config.MinimumVersion != "" && !minimumVersionRegex.MatchString(config.MinimumVersion) {
+		return fmt.Errorf("minimum_version: '%s' is not a version like \"7.4\" or \"7.4.1\"", config.MinimumVersion)
+	}
+	if config.ContentType != "" && config.ContentType != "multipart" {
+		return fmt.Errorf("content_type: must be \"multipart\" if set")
+	}
+	if config.ContentType == "multipart" {
+		if !config.NoUpdate || config.PutCreate || config.TwoPhaseCreate || config.IgnoreWarnings || len(config.NaturalKey) > 0 || config.AutoCreateParent.Endpoint != "" {
+			return fmt.Errorf("content_type: multipart requires no_update and can not be combined with put_create, two_phase_create, ignore_warnings, natural_key or auto_create_parent")
+		}
+		files := 0
+		for _, attr := range config.Attributes {
+			if attr.Type == "List" || attr.Type == "Set" || len(attr.DataPath) > 0 || attr.Scale != 0 || len(attr.EnumIntegers) > 0 || len(attr.DiscriminatorValues) > 0 {
+				return fmt.Errorf("attribute '%s': multipart forms only support top-level attributes of scalar types and StringList without data_path, scale, enum_integers or discriminator_values", attr.TfName)
+			}
+			if attr.Multipart != "file" {
+				continue
+			}
+			files++
+			if attr.Type != "String" || !attr.WriteOnly || !attr.RequiresReplace || attr.Value != "" || attr.Reference {
+				return fmt.Errorf("attribute '%s': multipart file attributes must be of type String with write_only and requires_replace", attr.TfName)
+			}
+		}
+		if files == 0 {
+			return fmt.Errorf("content_type: multipart requires at least one attribute with multipart \"file\"")
+		}
+	} else {
+		for _, attr := range config.Attributes {
+			if attr.Multipart != "" {
+				return fmt.Errorf("attribute '%s': multipart requires content_type multipart", attr.TfName)
+			}
+		}
+	}
+	if HasPlacement(config.Attributes) && (len(config.NaturalKey) > 0 || config.MoveEndpoint.Path != "" || config.SkipReadAfterCreate || DeltaUpdate(config.Attributes).TfName != "") {
+		return fmt.Errorf("placement: can not be combined with natural_key, move_endpoint, skip_read_after_create or delta_update")
+	}
+	if HasQueryParameter(config.Attributes) && (config.PutCreate || config.TwoPhaseCreate || config.ContentType != "") {
+		return fmt.Errorf("query_parameter: can not be combined with put_create, two_phase_create or content_type")
+	}
+	if config.TwoPhaseCreate && (config.PutCreate || config.NoDelete || len(config.NaturalKey) > 0 || config.NoUpdate) {
+		return fmt.Errorf("two_phase_create: can not be combined with put_create, no_update, no_delete or natural_key")
+	}
+	if len(config.NaturalKey) > 0 {
+		keyAttributes := AttributesByName(config.Attributes, config.NaturalKey)
+		if len(keyAttributes) != len(config.NaturalKey) {
+			return fmt.Errorf("natural_key: all keys must refer to top-level attributes by tf_name")
+		}
+		matchable := false
+		for _, attr := range keyAttributes {
+			if attr.Type != "String" {
+				return fmt.Errorf("natural_key: attribute '%s' must be of type String", attr.TfName)
+			}
+			if !attr.Reference {
+				matchable = true
+			}
+		}
+		if !matchable {
+			return fmt.Errorf("natural_key: at least one key must not be a reference")
+		}
+	}
+	return validateAttributes(config.Attributes)
+}
+
+func getTemplateSection(content, name string) string {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	result := ""
+	foundSection := false
+	beginRegex := regexp.MustCompile(`\/\/template:begin\s` + name + `$`)
+	endRegex := regexp.MustCompile(`\/\/template:end\s` + name + `$`)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !foundSection {
+			match := beginRegex.MatchString(line)
+			if match {
+				foundSection = true
+				result += line + "\n"
+			}
+		} else {
+			result += line + "\n"
+			match := endRegex.MatchString(line)
+			if match {
+				foundSection = false
+			}
+		}
+	}
+	return result
+}
+
+// Render a template to memory
+func executeTemplate(templatePath string, config interface{}) (*bytes.Buffer, error) {
+	file, err := os.Open(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening template: %v", err)
+	}
+	defer file.Close()
+
+	// skip first line with 'build-ignore' directive for go files
+	scanner := bufio.NewScanner(file)
+	if strings.HasSuffix(templatePath, ".go") {
+		scanner.Scan()
+	}
+	var temp string
+	for scanner.Scan() {
+		temp = temp + scanner.Text() + "\n"
+	}
+
+	template, err := template.New(path.Base(templatePath)).Funcs(functions).Parse(temp)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing template: %v", err)
+	}
+
+	// partials define the parts shared by several templates
+	partials, _ := filepath.Glob(filepath.Join(filepath.Dir(templatePath), "partials", "*.tmpl"))
+	for _, partial := range partials {
+		content, err := os.ReadFile(partial)
+		if err != nil {
+			return nil, fmt.Errorf("Error opening partial: %v", err)
+		}
+		if _, err := template.New(path.Base(partial)).Parse(string(content)); err != nil {
+			return nil, fmt.Errorf("Error parsing partial '%s': %v", partial, err)
+		}
+	}
+
+	output := new(bytes.Buffer)
+	err = template.Execute(output, config)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing template: %v", err)
+	}
+	return output, nil
+}
+
+// Render a Go template to memory and check that the result is syntactically valid Go code
+func validateTemplate(templatePath string, config interface{}) error {
+	output, err := executeTemplate(templatePath, config)
+	if err != nil {
+		return err
+	}
+	_, err = parser.ParseFile(token.NewFileSet(), path.Base(templatePath), output.Bytes(), parser.AllErrors)
+	return err
+}
+
+// Render the templates of all definitions with a bounded number of workers, or only validate the Go templates,
+// and return the errors of every definition in the order of the definitions
+func renderDefinitions(configs []YamlConfig, names []string, validate bool) [][]error {
+	errs := make([][]error, len(configs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = renderDefinition(configs[i], names[i], validate)
+			}
+		}()
+	}
+	for i := range configs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return errs
+}
+
+// Render the templates of a definition, rendering stops at the first error, while validating reports the
+// errors of all Go templates
+func renderDefinition(config YamlConfig, name string, validate bool) []error {
+	var errs []error
+	for _, t := range templates {
+		outputPath := t.prefix + SnakeCase(config.Name) + t.suffix
+		// a schema file left over from turning off split_files would redeclare the schema
+		if t.split && !config.SplitFiles && !validate {
+			os.Remove(outputPath)
+		}
+		if !t.rendered(config) {
+			continue
+		}
+		if validate {
+			if strings.HasSuffix(t.path, ".go") {
+				if err := validateTemplate(t.path, config); err != nil {
+					errs = append(errs, fmt.Errorf("Error validating template '%s' for definition '%s': %v", t.path, name, err))
+				}
+			}
+			continue
+		}
+		if err := writeTemplate(t.path, outputPath, config); err != nil {
+			return []error{fmt.Errorf("Error rendering template '%s' for definition '%s': %v", t.path, name, err)}
+		}
+	}
+	return errs
+}
+
+func renderTemplate(templatePath, outputPath string, config interface{}) {
+	if err := writeTemplate(templatePath, outputPath, config); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Render a template to the output file, only the template sections of an existing Go file are replaced
+func writeTemplate(templatePath, outputPath string, config interface{}) error {
+	output, err := executeTemplate(templatePath, config)
+	if err != nil {
+		return err
+	}
+
+	outputFile := filepath.Join(outputPath)
+	existingFile, err := os.Open(outputPath)
+	if err == nil {
+		defer existingFile.Close()
+	}
+	if err != nil {
+		os.MkdirAll(filepath.Dir(outputFile), 0755)
+	} else if strings.HasSuffix(templatePath, ".go") {
+		existingScanner := bufio.NewScanner(existingFile)
+		var newContent string
+		currentSectionName := ""
+		beginRegex := regexp.MustCompile(`\/\/template:begin\s(.*?)$`)
+		endRegex := regexp.MustCompile(`\/\/template:end\s(.*?)$`)
+		for existingScanner.Scan() {
+			line := existingScanner.Text()
+			if currentSectionName == "" {
+				matches := beginRegex.FindStringSubmatch(line)
+				if len(matches) > 1 && matches[1] != "" {
+					currentSectionName = matches[1]
+				} else {
+					newContent += line + "\n"
+				}
+			} else {
+				matches := endRegex.FindStringSubmatch(line)
+				if len(matches) > 1 && matches[1] == currentSectionName {
+					currentSectionName = ""
+					newSection := getTemplateSection(string(output.Bytes()), matches[1])
+					newContent += newSection
+				}
+			}
+		}
+		output = bytes.NewBufferString(newContent)
+	}
+	// write to output file
+	if err := os.WriteFile(outputFile, output.Bytes(), 0666); err != nil {
+		return fmt.Errorf("Error creating output file: %v", err)
+	}
+	return nil
+}
+
+// Return the resources whose objects are listed by the import-all tool, these are the resources without
+// parent objects whose import identifier is the ID of the object. Resources adopting existing objects
+// (put_create or natural_key) and resources of parent objects, which are imported by the ID only and can not
+// be read without their parent, are not imported in bulk.
+func importResources(configs []YamlConfig) []YamlConfig {
+	var resources []YamlConfig
+	for _, config := range configs {
+		if config.NoResource || config.PutCreate || len(config.NaturalKey) > 0 || strings.Contains(config.RestEndpoint, "%v") || strings.Contains(config.RestEndpoint, "?") || endpointParameterRegex.MatchString(config.RestEndpoint) {
+			continue
+		}
+		resources = append(resources, config)
+	}
+	return resources
+}
+
+// Resolve the REST endpoints of the related resources of a definition, which are created by the test
+// prerequisites and checked together with the object in a generated acceptance test
+func relatedResources(config YamlConfig, configs []YamlConfig) ([]YamlRelatedResource, error) {
+	related := make([]YamlRelatedResource, 0, len(config.RelatedResources))
+	if len(config.RelatedResources) > 0 && (config.NoResource || config.ExcludeTest) {
+		return nil, fmt.Errorf("related_resources: can not be combined with no_resource or exclude_test")
+	}
+	for _, r := range config.RelatedResources {
+		var target *YamlConfig
+		for i := range configs {
+			if configs[i].Name == r.Resource {
+				target = &configs[i]
+			}
+		}
+		if target == nil || target.NoResource || strings.Contains(target.RestEndpoint, "%v") {
+			return nil, fmt.Errorf("related_resources: no resource without parent objects found with name '%s'", r.Resource)
+		}
+		if !strings.Contains(config.TestPrerequisites, fmt.Sprintf(`resource "fmc_%s" "test"`, SnakeCase(r.Resource))) {
+			return nil, fmt.Errorf("related_resources: test_prerequisites must create resource 'fmc_%s.test'", SnakeCase(r.Resource))
+		}
+		root := strings.Split(r.Attribute, ".")[0]
+		found := false
+		for _, attr := range config.Attributes {
+			if attr.TfName == root || (attr.TfName == "" && SnakeCase(attr.ModelName) == root) {
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("related_resources: attribute '%s' not found", r.Attribute)
+		}
+		r.Endpoint = target.RestEndpoint
+		related = append(related, r)
+	}
+	return related, nil
+}
+
+// Derive the definition of the data source reading the override of an overridable object for a device. The
+// override contains the values of all attributes of the object apart from its name, description and
+// overridable flag.
+func overrideConfig(config YamlConfig) YamlConfig {
+	name := strings.ToLower(config.Name)
+	override := YamlConfig{
+		Name:           config.Name + " Override",
+		RestEndpoint:   config.RestEndpoint + "/%v?overrideTargetId=%v",
+		NoResource:     true,
+		DataSourceNoId: true,
+		ExcludeTest:    true,
+		DocCategory:    config.DocCategory,
+		DsDescription:  fmt.Sprintf("This data source can read the override of a %s object for a device.", name),
+		Attributes: []YamlConfigAttribute{
+			{
+				TfName:      SnakeCase(config.Name) + "_id",
+				Type:        "String",
+				Reference:   true,
+				Description: fmt.Sprintf("The ID of the %s object.", name),
+				Example:     "76d24097-41c4-4558-a4d0-a8c07ac08470",
+			},
+			{
+				TfName:      "target_id",
+				Type:        "String",
+				Reference:   true,
+				Description: "The ID of the device the override is defined for.",
+				Example:     "76d24097-41c4-4558-a4d0-a8c07ac08471",
+			},
+		},
+	}
+	for _, attr := range config.Attributes {
+		if contains([]string{"name", "description", "overridable"}, attr.ModelName) || attr.Value != "" || attr.Reference || attr.ComposedValue != "" {
+			continue
+		}
+		override.Attributes = append(override.Attributes, attr)
+	}
+	return override
+}
+
+// Ensure previous resource names do not collide with the name of any other resource
+func validatePreviousResourceNames(configs []YamlConfig) error {
+	names := make(map[string]string)
+	for _, config := range configs {
+		names[SnakeCase(config.Name)] = config.Name
+	}
+	for _, config := range configs {
+		for _, previous := range config.PreviousResourceNames {
+			if name, ok := names[SnakeCase(previous)]; ok {
+				return fmt.Errorf("previous_resource_names: '%s' of definition '%s' collides with definition '%s'", previous, config.Name, name)
+			}
+			names[SnakeCase(previous)] = config.Name
+		}
+	}
+	return nil
+}
+
+// Derive the definitions of the override data sources of all overridable objects
+func overrideConfigs(configs []YamlConfig) []YamlConfig {
+	overrides := make([]YamlConfig, 0)
+	for _, config := range configs {
+		if config.Overridable {
+			overrides = append(overrides, overrideConfig(config))
+		}
+	}
+	return overrides
+}
+
+// Derive the definition of the data source resolving the ID of an object from the names of the objects
+// along its path, the parent of each level is the resource referenced by its last reference attribute
+func pathConfig(config YamlConfig, configs []YamlConfig) (YamlConfig, error) {
+	names := make(map[string]string)
+	byName := make(map[string]YamlConfig)
+	for _, c := range configs {
+		names[SnakeCase(c.Name)] = c.Name
+		byName[c.Name] = c
+	}
+
+	levels := []YamlConfig{config}
+	visited := map[string]bool{config.Name: true}
+	for current := config; strings.Contains(current.RestEndpoint, "%v"); {
+		var last YamlConfigAttribute
+		for _, attr := range current.Attributes {
+			if attr.Reference {
+				last = attr
+			}
+		}
+		parent, ok := byName[referencedResource(last, names)]
+		if last.TfName == "" || !ok {
+			return YamlConfig{}, fmt.Errorf("data_source_path: no definition found for the parent of '%s'", current.Name)
+		}
+		if visited[parent.Name] {
+			return YamlConfig{}, fmt.Errorf("data_source_path: the parents of '%s' form a cycle through '%s'", config.Name, parent.Name)
+		}
+		visited[parent.Name] = true
+		levels = append([]YamlConfig{parent}, levels...)
+		current = parent
+	}
+	if len(levels) < 2 {
+		return YamlConfig{}, fmt.Errorf("data_source_path: '%s' has no parent objects", config.Name)
+	}
+
+	path := YamlConfig{
+		Name:           config.Name + " Path",
+		RestEndpoint:   config.RestEndpoint,
+		NoResource:     true,
+		DataSourceNoId: true,
+		ExcludeTest:    true,
+		DocCategory:    config.DocCategory,
+		DsDescription:  fmt.Sprintf("This data source resolves the %s ID from the names of the objects along its path.", strings.ToLower(config.Name)),
+	}
+	for i, level := range levels {
+		name := strings.ToLower(level.Name)
+		example := ""
+		for _, attr := range level.Attributes {
+			if attr.ModelName == "name" {
+				example = attr.Example
+			}
+		}
+		if i == len(levels)-1 {
+			path.Attributes = append(path.Attributes, YamlConfigAttribute{TfName: "name", Type: "String", Reference: true, Description: fmt.Sprintf("The name of the %s.", name), Example: example})
+			path.PathSegments = append(path.PathSegments, YamlPathSegment{Endpoint: level.RestEndpoint, NameAttribute: "name", IdAttribute: "id"})
+			break
+		}
+		prefix := SnakeCase(level.Name)
+		model := CamelCase(level.Name) + "Id"
+		path.Attributes = append(path.Attributes,
+			YamlConfigAttribute{TfName: prefix + "_name", Type: "String", Reference: true, Description: fmt.Sprintf("The name of the %s.", name), Example: example},
+			YamlConfigAttribute{ModelName: strings.ToLower(model[:1]) + model[1:], TfName: prefix + "_id", Type: "String", Description: fmt.Sprintf("The ID of the %s.", name)},
+		)
+		path.PathSegments = append(path.PathSegments, YamlPathSegment{Endpoint: level.RestEndpoint, NameAttribute: prefix + "_name", IdAttribute: prefix + "_id"})
+	}
+	return path, nil
+}
+
+// Derive the definition of the data source listing the objects which reference an object, e.g. the groups
+// and policies using a network object, which prevent it from being deleted
+func usageConfig(config YamlConfig) YamlConfig {
+	name := strings.ToLower(config.Name)
+	objectType := strings.ReplaceAll(config.Name, " ", "")
+	for _, attr := range config.Attributes {
+		if attr.ModelName == "type" && (attr.Value != "" || attr.ComposedValue != "") {
+			objectType = attr.Value + attr.ComposedValue
+		}
+	}
+	return YamlConfig{
+		Name:               config.Name + " Usage",
+		RestEndpoint:       config.RestEndpoint[:strings.Index(config.RestEndpoint, "/domain/{DOMAIN_UUID}/")] + "/domain/{DOMAIN_UUID}/object/operational/usage?filter=uuid:%v;type:" + objectType,
+		NoResource:         true,
+		DataSourceNoId:     true,
+		DataSourceAllPages: true,
+		ExcludeTest:        true,
+		DocCategory:        config.DocCategory,
+		DsDescription:      fmt.Sprintf("This data source lists the objects referencing a %s object, which have to be updated before the object can be deleted.", name),
+		Attributes: []YamlConfigAttribute{
+			{
+				TfName:      SnakeCase(config.Name) + "_id",
+				Type:        "String",
+				Reference:   true,
+				Description: fmt.Sprintf("The ID of the %s object.", name),
+				Example:     "76d24097-41c4-4558-a4d0-a8c07ac08470",
+			},
+			{
+				ModelName:   "items",
+				TfName:      "objects",
+				Type:        "List",
+				Description: "List of objects referencing the object.",
+				Attributes: []YamlConfigAttribute{
+					{ModelName: "id", Type: "String", Description: "The ID of the referencing object."},
+					{ModelName: "name", Type: "String", Description: "The name of the referencing object."},
+					{ModelName: "type", Type: "String", Description: "The type of the referencing object."},
+				},
+			},
+		},
+	}
+}
+
+// Derive the definition of the data source counting the objects below the REST endpoint of an object, the
+// count is read from the paging metadata of a list response with a single item instead of all objects
+func countConfig(config YamlConfig) YamlConfig {
+	name := strings.ToLower(config.Name)
+	count := YamlConfig{
+		Name:           config.Name + " Count",
+		RestEndpoint:   config.RestEndpoint + "?limit=1",
+		NoResource:     true,
+		DataSourceNoId: true,
+		ExcludeTest:    true,
+		DocCategory:    config.DocCategory,
+		DsDescription:  fmt.Sprintf("This data source returns the number of %s objects without reading the objects themselves.", name),
+	}
+	for _, attr := range config.Attributes {
+		if attr.Reference {
+			count.Attributes = append(count.Attributes, attr)
+		}
+	}
+	count.Attributes = append(count.Attributes, YamlConfigAttribute{
+		ModelName:   "count",
+		DataPath:    []string{"paging"},
+		TfName:      "total_count",
+		Type:        "Int64",
+		Description: fmt.Sprintf("The number of %s objects.", name),
+	})
+	return count
+}
+
+var referenceTestValueRegex = regexp.MustCompile(`^fmc_(\w+)\.\w+\.id$`)
+
+// Determine the name of the resource a reference attribute points to, either from the resource used as
+// test value (e.g. "fmc_network.test.id") or from the attribute name (e.g. "network_id")
+func referencedResource(attr YamlConfigAttribute, names map[string]string) string {
+	if matches := referenceTestValueRegex.FindStringSubmatch(attr.TestValue); len(matches) > 1 {
+		if name, ok := names[matches[1]]; ok {
+			return name
+		}
+	}
+	snakeName := strings.TrimSuffix(strings.TrimSuffix(attr.TfName, "_ids"), "_id")
+	if name, ok := names[snakeName]; ok {
+		return name
+	}
+	return snakeName
+}
+
+// Build the dependency graph of all resources from their reference attributes, mapping each resource name
+// to the sorted names of the resources it references. Referenced resources which are not defined are
+// included with the name derived from the attribute.
+func referenceGraph(configs []YamlConfig) map[string][]string {
+	names := make(map[string]string)
+	for _, config := range configs {
+		names[SnakeCase(config.Name)] = config.Name
+	}
+	var collect func(attributes []YamlConfigAttribute, refs map[string]bool)
+	collect = func(attributes []YamlConfigAttribute, refs map[string]bool) {
+		for _, attr := range attributes {
+			if attr.Reference {
+				refs[referencedResource(attr, names)] = true
+			}
+			collect(attr.Attributes, refs)
+		}
+	}
+
+	graph := make(map[string][]string)
+	for _, config := range configs {
+		refs := make(map[string]bool)
+		collect(config.Attributes, refs)
+		delete(refs, config.Name)
+		graph[config.Name] = make([]string, 0, len(refs))
+		for ref := range refs {
+			graph[config.Name] = append(graph[config.Name], ref)
+		}
+		sort.Strings(graph[config.Name])
+	}
+	return graph
+}
+
+// Render the dependency graph in Graphviz DOT format, referenced resources which are not defined are dashed
+func writeGraph(w io.Writer, graph map[string][]string) {
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	fmt.Fprintln(w, "digraph references {")
+	fmt.Fprintln(w, "\trankdir=LR;")
+	fmt.Fprintln(w, "\tnode [shape=box];")
+	external := make(map[string]bool)
+	for _, node := range nodes {
+		fmt.Fprintf(w, "\t%q;\n", node)
+		for _, ref := range graph[node] {
+			if _, ok := graph[ref]; !ok {
+				external[ref] = true
+			}
+		}
+	}
+	for _, node := range sortedKeys(external) {
+		fmt.Fprintf(w, "\t%q [style=dashed];\n", node)
+	}
+	for _, node := range nodes {
+		for _, ref := range graph[node] {
+			fmt.Fprintf(w, "\t%q -> %q;\n", node, ref)
+		}
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// Build the JSON Schema document of the configuration of a resource, the types, enums and constraints are the
+// ones of the framework schema, computed attributes are read-only
+func jsonSchema(config YamlConfig) map[string]interface{} {
+	properties, required := jsonSchemaProperties(config.Attributes)
+	properties["id"] = map[string]interface{}{"type": "string", "description": "The id of the object", "readOnly": true}
+	properties["domain"] = map[string]interface{}{"type": "string", "description": "The name of the FMC domain"}
+	doc := map[string]interface{}{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"title":                "fmc_" + SnakeCase(config.Name),
+		"description":          config.ResDescription,
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		doc["required"] = required
+	}
+	return doc
+}
+
+func jsonSchemaProperties(attributes []YamlConfigAttribute) (map[string]interface{}, []string) {
+	properties := make(map[string]interface{})
+	required := make([]string, 0)
+	for _, attr := range attributes {
+		if attr.Value != "" {
+			continue
+		}
+		property := map[string]interface{}{}
+		if attr.Description != "" {
+			property["description"] = attr.Description
+		}
+		switch attr.Type {
+		case "String":
+			property["type"] = "string"
+			jsonSchemaString(attr, property)
+		case "Int64":
+			property["type"] = "integer"
+			if attr.MinInt != 0 || attr.MaxInt != 0 {
+				property["minimum"] = attr.MinInt
+				property["maximum"] = attr.MaxInt
+			}
+		case "Float64":
+			property["type"] = "number"
+			if attr.MinFloat != 0 || attr.MaxFloat != 0 {
+				property["minimum"] = attr.MinFloat
+				property["maximum"] = attr.MaxFloat
+			}
+		case "Bool":
+			property["type"] = "boolean"
+		case "Map":
+			element, _ := jsonSchemaProperties([]YamlConfigAttribute{{TfName: "element", Type: attr.ElementType}})
+			property["type"] = "object"
+			property["additionalProperties"] = element["element"]
+		case "StringList":
+			items := map[string]interface{}{"type": "string"}
+			jsonSchemaString(attr, items)
+			property["type"] = "array"
+			property["items"] = items
+		case "List", "Set":
+			nested, nestedRequired := jsonSchemaProperties(attr.Attributes)
+			items := map[string]interface{}{"type": "object", "properties": nested, "additionalProperties": false}
+			if len(nestedRequired) > 0 {
+				items["required"] = nestedRequired
+			}
+			property["type"] = "array"
+			property["items"] = items
+			if attr.Type == "Set" {
+				property["uniqueItems"] = true
+			}
+		}
+		if attr.Type == "List" || attr.Type == "Set" || attr.Type == "StringList" {
+			if attr.MinList != 0 {
+				property["minItems"] = attr.MinList
+			}
+			if attr.MaxList != 0 {
+				property["maxItems"] = attr.MaxList
+			}
+			if attr.UniqueValues {
+				property["uniqueItems"] = true
+			}
+		}
+		if attr.DefaultValue != "" {
+			switch attr.Type {
+			case "Int64":
+				property["default"], _ = strconv.ParseInt(attr.DefaultValue, 10, 64)
+			case "Float64":
+				property["default"], _ = strconv.ParseFloat(attr.DefaultValue, 64)
+			case "Bool":
+				property["default"], _ = strconv.ParseBool(attr.DefaultValue)
+			default:
+				property["default"] = attr.DefaultValue
+			}
+		} else if len(attr.DefaultList) > 0 {
+			property["default"] = attr.DefaultList
+		}
+		if attr.ResourceId || attr.ComposedValue != "" || attr.ReadEndpoint != "" || attr.Computed {
+			property["readOnly"] = true
+		} else if (attr.Reference && !attr.ParentReference) || attr.Mandatory {
+			required = append(required, attr.TfName)
+		}
+		properties[attr.TfName] = property
+	}
+	sort.Strings(required)
+	return properties, required
+}
+
+// Add the enum and the constraints of a string value to its JSON Schema
+func jsonSchemaString(attr YamlConfigAttribute, property map[string]interface{}) {
+	if len(attr.EnumValues) > 0 {
+		property["enum"] = attr.EnumValues
+	}
+	if attr.StringMinLength != 0 || attr.StringMaxLength != 0 {
+		property["minLength"] = attr.StringMinLength
+		property["maxLength"] = attr.StringMaxLength
+	}
+	if len(attr.StringPatterns) == 1 {
+		property["pattern"] = attr.StringPatterns[0]
+	} else if len(attr.StringPatterns) > 1 {
+		patterns := make([]interface{}, len(attr.StringPatterns))
+		for i, pattern := range attr.StringPatterns {
+			patterns[i] = map[string]interface{}{"pattern": pattern}
+		}
+		property["allOf"] = patterns
+	}
+}
+
+// Write the JSON Schema documents of all resources to a directory, one file per resource
+func writeJsonSchemas(dir string, configs []YamlConfig) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, config := range configs {
+		if config.NoResource {
+			continue
+		}
+		content, err := json.MarshalIndent(jsonSchema(config), "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "fmc_"+SnakeCase(config.Name)+".json"), append(content, '\n'), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func main() {
+	validate := flag.Bool("validate", false, "Render the Go templates to memory and check the result for syntax errors instead of writing files")
+	graph := flag.String("graph", "", "Write the dependency graph of all resources built from reference attributes to the given file in Graphviz DOT format instead of generating code")
+	schemas := flag.String("schemas", "", "Write a JSON Schema document of the configuration of every resource to the given directory instead of generating code")
+	flag.Parse()
+
+	providerConfig := make([]YamlConfig, 0)
+	valid := true
+
+	files, _ := os.ReadDir(definitionsPath)
+	configs := make([]YamlConfig, len(files))
+	names := make([]string, len(files))
+
+	// Load configs
+	for i, filename := range files {
+		yamlFile, err := os.ReadFile(filepath.Join(definitionsPath, filename.Name()))
+		if err != nil {
+			log.Fatalf("Error reading file: %v", err)
+		}
+
+		config := YamlConfig{}
+		err = yaml.Unmarshal(yamlFile, &config)
+		if err != nil {
+			log.Fatalf("Error parsing yaml: %v", err)
+		}
+		configs[i] = config
+		names[i] = filename.Name()
+	}
+
+	if err := validatePreviousResourceNames(configs); err != nil {
+		log.Fatalf("Error validating definitions: %v", err)
+	}
+
+	// Resolve the related resources before derived definitions are added
+	for i := range configs {
+		related, err := relatedResources(configs[i], configs)
+		if err != nil {
+			log.Fatalf("Error validating definition '%s': %v", names[i], err)
+		}
+		configs[i].RelatedResources = related
+	}
+
+	// Add the override data sources of overridable objects
+	for _, override := range overrideConfigs(configs) {
+		configs = append(configs, override)
+		names = append(names, override.Name)
+	}
+
+	// Add the path data sources
+	for _, config := range configs {
+		if !config.DataSourcePath {
+			continue
+		}
+		path, err := pathConfig(config, configs)
+		if err != nil {
+			log.Fatalf("Error validating definition '%s': %v", config.Name, err)
+		}
+		configs = append(configs, path)
+		names = append(names, path.Name)
+	}
+
+	// Add the usage data sources
+	for _, config := range configs {
+		if config.DataSourceUsage {
+			usage := usageConfig(config)
+			configs = append(configs, usage)
+			names = append(names, usage.Name)
+		}
+	}
+
+	// Add the count data sources
+	for _, config := range configs {
+		if config.DataSourceCount {
+			count := countConfig(config)
+			configs = append(configs, count)
+			names = append(names, count.Name)
+		}
+	}
+
+	if *graph != "" {
+		for i := range configs {
+			augmentConfig(&configs[i])
+		}
+		f, err := os.Create(*graph)
+		if err != nil {
+			log.Fatalf("Error creating graph file: %v", err)
+		}
+		defer f.Close()
+		writeGraph(f, referenceGraph(configs))
+		return
+	}
+
+	if *schemas != "" {
+		for i := range configs {
+			augmentConfig(&configs[i])
+		}
+		if err := writeJsonSchemas(*schemas, configs); err != nil {
+			log.Fatalf("Error writing JSON schemas: %v", err)
+		}
+		return
+	}
+
+	for i := range configs {
+		// Augment config
+		augmentConfig(&configs[i])
+
+		// Validate config
+		if err := validateConfig(configs[i]); err != nil {
+			log.Fatalf("Error validating definition '%s': %v", names[i], err)
+		}
+		for _, warning := range idWarnings(configs[i].Attributes) {
+			log.Printf("Warning for definition '%s': %s", names[i], warning)
+		}
+		providerConfig = append(providerConfig, configs[i])
+	}
+
+	// Render the templates of all definitions, the errors are reported in the order of the definitions
+	for _, errs := range renderDefinitions(configs, names, *validate) {
+		for _, err := range errs {
+			if !*validate {
+				log.Fatal(err)
+			}
+			log.Print(err)
+			valid = false
+		}
+	}
+
+	if *validate {
+		if err := validateTemplate(providerTemplate, providerConfig); err != nil {
+			log.Printf("Error validating template '%s': %v", providerTemplate, err)
+			valid = false
+		}
+		if err := validateTemplate(errorsTemplate, nil); err != nil {
+			log.Printf("Error validating template '%s': %v", errorsTemplate, err)
+			valid = false
+		}
+		if err := validateTemplate(functionsTemplate, nil); err != nil {
+			log.Printf("Error validating template '%s': %v", functionsTemplate, err)
+			valid = false
+		}
+		if err := validateTemplate(importAllTemplate, providerConfig); err != nil {
+			log.Printf("Error validating template '%s': %v", importAllTemplate, err)
+			valid = false
+		}
+		if !valid {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// render provider.go
 	renderTemplate(providerTemplate, providerLocation, providerConfig)
 
+	// render the errors package, which is shared by all resources and data sources
+	renderTemplate(errorsTemplate, errorsLocation, nil)
+
+	// render the provider functions
+	renderTemplate(functionsTemplate, functionsLocation, nil)
+
+	// render the import-all package listing the objects of all resources
+	renderTemplate(importAllTemplate, importAllLocation, providerConfig)
+
 	changelog, err := os.ReadFile(changelogOriginal)
 	if err != nil {
 		log.Fatalf("Error reading changelog: %v", err)
@@ -0,0 +1,4100 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build ignore
+
+// Run with "go test gen/generator.go gen/generator_test.go"
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/imports"
+	"gopkg.in/yaml.v3"
+)
+
+func loadTestConfig(t *testing.T, name string) YamlConfig {
+	t.Helper()
+	yamlFile, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("Error reading file: %v", err)
+	}
+	config := YamlConfig{}
+	if err := yaml.Unmarshal(yamlFile, &config); err != nil {
+		t.Fatalf("Error parsing yaml: %v", err)
+	}
+	augmentConfig(&config)
+	return config
+}
+
+func TestValidateTestValues(t *testing.T) {
+	tests := []struct {
+		fixture string
+		err     string
+	}{
+		{"valid_test_value.yaml", ""},
+		{"invalid_test_value_bool.yaml", "attribute 'enabled': invalid test_value for type Bool"},
+		{"invalid_test_value_int.yaml", "attribute 'port': invalid test_value for type Int64"},
+		{"invalid_test_value_float.yaml", "attribute 'ratio': invalid minimum_test_value for type Float64"},
+		{"invalid_test_value_string.yaml", "attribute 'name': invalid test_value for type String"},
+		{"invalid_test_value_string_list.yaml", "attribute 'names': invalid test_value for type StringList"},
+		{"invalid_test_value_nested.yaml", "attribute 'port': invalid test_value for type Int64"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			err := validateConfig(loadTestConfig(t, tt.fixture))
+			if tt.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+				t.Fatalf("expected error containing '%s', got: %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	config := loadTestConfig(t, "valid_test_value.yaml")
+	for _, tmpl := range templates {
+		if !strings.HasSuffix(tmpl.path, ".go") {
+			continue
+		}
+		if err := validateTemplate(filepath.Join("..", tmpl.path), config); err != nil {
+			t.Errorf("unexpected error for template '%s': %v", tmpl.path, err)
+		}
+	}
+
+	// The first line of a Go template is skipped, as it contains the build constraint
+	broken := filepath.Join(t.TempDir(), "broken.go")
+	content := "//go:build ignore\npackage provider\n\nfunc (data {{camelCase .Name}}) getPath() string {\n\treturn \"{{.RestEndpoint}}\"\n"
+	if err := os.WriteFile(broken, []byte(content), 0644); err != nil {
+		t.Fatalf("Error writing template: %v", err)
+	}
+	err := validateTemplate(broken, config)
+	if err == nil || !strings.Contains(err.Error(), "broken.go:4:") {
+		t.Fatalf("expected syntax error in broken.go, got: %v", err)
+	}
+}
+
+func TestTestUpdateAction(t *testing.T) {
+	config := loadTestConfig(t, "test_update_action.yaml")
+	name, description, zone := config.Attributes[0], config.Attributes[1], config.Attributes[2]
+
+	tests := []struct {
+		attributes []YamlConfigAttribute
+		action     string
+	}{
+		{[]YamlConfigAttribute{name}, "Noop"},
+		{[]YamlConfigAttribute{name, description}, "Update"},
+		{[]YamlConfigAttribute{name, zone}, "Replace"},
+		{[]YamlConfigAttribute{name, description, zone}, "Replace"},
+	}
+	for _, tt := range tests {
+		if action := TestUpdateAction(tt.attributes); action != tt.action {
+			t.Errorf("expected action %s for %d attributes, got %s", tt.action, len(tt.attributes), action)
+		}
+	}
+
+	config.Attributes = []YamlConfigAttribute{name, description}
+	output, err := executeTemplate("../gen/templates/resource_test.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output.String(), `plancheck.ExpectResourceAction("fmc_update_action.test", plancheck.ResourceActionUpdate)`) {
+		t.Errorf("expected update plan check in rendered test")
+	}
+}
+
+func TestTfType(t *testing.T) {
+	tests := []struct {
+		attr     YamlConfigAttribute
+		expected string
+	}{
+		{YamlConfigAttribute{Type: "String"}, "string"},
+		{YamlConfigAttribute{Type: "Int64"}, "number"},
+		{YamlConfigAttribute{Type: "Float64"}, "number"},
+		{YamlConfigAttribute{Type: "Bool"}, "bool"},
+		{YamlConfigAttribute{Type: "StringList"}, "list(string)"},
+		{YamlConfigAttribute{Type: "List", Attributes: []YamlConfigAttribute{
+			{TfName: "name", Type: "String"},
+			{TfName: "id", Type: "String"},
+			{TfName: "ports", Type: "Set", Attributes: []YamlConfigAttribute{{TfName: "port", Type: "Int64"}}},
+		}}, "list(object({id=string,name=string,ports=set(object({port=number}))}))"},
+		{YamlConfigAttribute{Type: "Map"}, "map(string)"},
+		{YamlConfigAttribute{Type: "Map", ElementType: "Int64"}, "map(number)"},
+		{YamlConfigAttribute{Type: "Map", Attributes: []YamlConfigAttribute{
+			{TfName: "enabled", Type: "Bool"},
+			{TfName: "tags", Type: "StringList"},
+		}}, "map(object({enabled=bool,tags=list(string)}))"},
+	}
+	for _, tt := range tests {
+		if got := TfType(tt.attr); got != tt.expected {
+			t.Errorf("expected type %s for %s, got %s", tt.expected, tt.attr.Type, got)
+		}
+	}
+	if functions["tfType"] == nil {
+		t.Error("expected tfType to be available to templates")
+	}
+}
+
+func TestReferenceGraph(t *testing.T) {
+	configs := []YamlConfig{
+		{Name: "Network"},
+		{Name: "Network Group", Attributes: []YamlConfigAttribute{
+			{TfName: "objects", Type: "List", Attributes: []YamlConfigAttribute{
+				{TfName: "id", Type: "String", Reference: true, TestValue: "fmc_network.test.id"},
+			}},
+		}},
+		{Name: "Device Interface", Attributes: []YamlConfigAttribute{
+			{TfName: "device_id", Type: "String", Reference: true},
+			{TfName: "network_group_id", Type: "String", Reference: true},
+		}},
+	}
+	graph := referenceGraph(configs)
+	if len(graph["Network"]) != 0 {
+		t.Errorf("expected no references for Network, got: %v", graph["Network"])
+	}
+	if refs := strings.Join(graph["Network Group"], ","); refs != "Network" {
+		t.Errorf("expected Network Group to reference Network, got: %s", refs)
+	}
+	if refs := strings.Join(graph["Device Interface"], ","); refs != "Network Group,device" {
+		t.Errorf("expected Device Interface to reference Network Group and device, got: %s", refs)
+	}
+
+	output := new(bytes.Buffer)
+	writeGraph(output, graph)
+	for _, line := range []string{
+		`"Network Group" -> "Network";`,
+		`"Device Interface" -> "Network Group";`,
+		`"device" [style=dashed];`,
+	} {
+		if !strings.Contains(output.String(), line) {
+			t.Errorf("expected line '%s' in graph, got:\n%s", line, output.String())
+		}
+	}
+}
+
+func TestJsonSchema(t *testing.T) {
+	config := loadTestConfig(t, "../definitions/prefilter_rule.yaml")
+	doc := jsonSchema(config)
+	if doc["title"] != "fmc_prefilter_rule" {
+		t.Errorf("expected title fmc_prefilter_rule, got: %v", doc["title"])
+	}
+	if required := strings.Join(doc["required"].([]string), ","); required != "action,name,prefilter_policy_id" {
+		t.Errorf("unexpected required attributes: %s", required)
+	}
+	properties := doc["properties"].(map[string]interface{})
+	if _, ok := properties["type"]; ok {
+		t.Error("expected no property for the constant type attribute")
+	}
+	action := properties["action"].(map[string]interface{})
+	if enum := strings.Join(action["enum"].([]string), ","); action["type"] != "string" || enum != "FASTPATH,ANALYZE,BLOCK" {
+		t.Errorf("expected the action enum FASTPATH,ANALYZE,BLOCK, got: %v %s", action["type"], enum)
+	}
+	insertBefore := properties["insert_before"].(map[string]interface{})
+	if insertBefore["type"] != "integer" || insertBefore["minimum"] != int64(1) {
+		t.Errorf("expected insert_before as integer with minimum 1, got: %v", insertBefore)
+	}
+	items := properties["source_network_objects"].(map[string]interface{})["items"].(map[string]interface{})
+	if required := strings.Join(items["required"].([]string), ","); required != "id,type" {
+		t.Errorf("unexpected required attributes of source_network_objects: %s", required)
+	}
+
+	dir := t.TempDir()
+	if err := writeJsonSchemas(dir, []YamlConfig{config}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "fmc_prefilter_rule.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var written map[string]interface{}
+	if err := json.Unmarshal(content, &written); err != nil {
+		t.Fatalf("expected a valid JSON document, got error: %v", err)
+	}
+	if written["$schema"] != "https://json-schema.org/draft/2020-12/schema" {
+		t.Errorf("unexpected $schema: %v", written["$schema"])
+	}
+}
+
+func TestUsageConfig(t *testing.T) {
+	tests := []struct {
+		config   YamlConfig
+		endpoint string
+	}{
+		{
+			YamlConfig{Name: "Network Group", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networkgroups"},
+			"/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/operational/usage?filter=uuid:%v;type:NetworkGroup",
+		},
+		{
+			YamlConfig{Name: "ICMPv4 Object", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/icmpv4objects", Attributes: []YamlConfigAttribute{
+				{ModelName: "type", Type: "String", Value: "ICMPV4Object"},
+			}},
+			"/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/operational/usage?filter=uuid:%v;type:ICMPV4Object",
+		},
+	}
+	for _, tt := range tests {
+		usage := usageConfig(tt.config)
+		if usage.Name != tt.config.Name+" Usage" || usage.RestEndpoint != tt.endpoint || !usage.DataSourceAllPages {
+			t.Errorf("unexpected usage config %s: %s", usage.Name, usage.RestEndpoint)
+		}
+		if err := validateConfig(usage); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	if err := validateConfig(YamlConfig{Name: "Category", DataSourceUsage: true, RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories"}); err == nil {
+		t.Errorf("expected error for usage data source of child object")
+	}
+}
+
+func TestCountConfig(t *testing.T) {
+	config := YamlConfig{Name: "Access Control Policy Category", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories", Attributes: []YamlConfigAttribute{
+		{TfName: "access_control_policy_id", Type: "String", Reference: true},
+		{ModelName: "name", Type: "String", Mandatory: true},
+	}}
+	count := countConfig(config)
+	if count.Name != "Access Control Policy Category Count" || count.RestEndpoint != config.RestEndpoint+"?limit=1" {
+		t.Errorf("unexpected count config %s: %s", count.Name, count.RestEndpoint)
+	}
+	if len(count.Attributes) != 2 || count.Attributes[0].TfName != "access_control_policy_id" || count.Attributes[1].TfName != "total_count" {
+		t.Errorf("expected the reference and the count attribute, got: %+v", count.Attributes)
+	}
+	augmentConfig(&count)
+	if err := validateConfig(count); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := validateConfig(YamlConfig{Name: "Pending Changes", DataSourceCount: true, NoResource: true, DataSourceNoId: true}); err == nil {
+		t.Errorf("expected error for count data source of a data source without objects")
+	}
+}
+
+func TestTestDisappears(t *testing.T) {
+	config := YamlConfig{Name: "Category", TestDisappears: true, RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories", Attributes: []YamlConfigAttribute{
+		{TfName: "access_control_policy_id", Type: "String", Reference: true, Example: "76d24097-41c4-4558-a4d0-a8c07ac08470"},
+		{ModelName: "name", Type: "String", Mandatory: true, Example: "Category1"},
+	}}
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := executeTemplate("../gen/templates/resource_test.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []string{
+		`testAccCheckDisappears("fmc_category.test", func(attributes map[string]string) string {`,
+		`return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories", attributes["access_control_policy_id"]) + "/" + attributes["id"]`,
+		`ExpectNonEmptyPlan: true,`,
+		`plancheck.ExpectResourceAction("fmc_category.test", plancheck.ResourceActionCreate)`,
+	} {
+		if !strings.Contains(output.String(), s) {
+			t.Errorf("expected '%s' in rendered resource_test.go", s)
+		}
+	}
+
+	config.NaturalKey = []string{"name"}
+	if err := validateConfig(config); err == nil {
+		t.Errorf("expected error for test_disappears with natural_key")
+	}
+}
+
+func TestOverridable(t *testing.T) {
+	configs := []YamlConfig{
+		{Name: "Network", Overridable: true, RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks", Attributes: []YamlConfigAttribute{
+			{ModelName: "name", Type: "String"},
+			{ModelName: "value", TfName: "prefix", Type: "String"},
+		}},
+		{Name: "Security Zone", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/securityzones", Attributes: []YamlConfigAttribute{
+			{ModelName: "name", Type: "String"},
+		}},
+	}
+	for i := range configs {
+		augmentConfig(&configs[i])
+	}
+	if len(AttributesByName(configs[0].Attributes, []string{"overridable"})) != 1 {
+		t.Errorf("expected overridable attribute on Network")
+	}
+	if len(AttributesByName(configs[1].Attributes, []string{"overridable"})) != 0 {
+		t.Errorf("expected no overridable attribute on Security Zone")
+	}
+
+	overrides := overrideConfigs(configs)
+	if len(overrides) != 1 || overrides[0].Name != "Network Override" {
+		t.Fatalf("expected only a Network Override config, got: %v", overrides)
+	}
+	override := overrides[0]
+	if !strings.HasSuffix(override.RestEndpoint, "/object/networks/%v?overrideTargetId=%v") {
+		t.Errorf("unexpected override endpoint: %s", override.RestEndpoint)
+	}
+	names := []string{}
+	for _, attr := range override.Attributes {
+		names = append(names, attr.TfName)
+	}
+	if got := strings.Join(names, ","); got != "network_id,target_id,prefix" {
+		t.Errorf("unexpected override attributes: %s", got)
+	}
+}
+
+func TestPreviousResourceNames(t *testing.T) {
+	config := YamlConfig{Name: "Access Policy Rule", PreviousResourceNames: []string{"Access Rule"}, RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/accessrules"}
+	augmentConfig(&config)
+
+	if err := validatePreviousResourceNames([]YamlConfig{config, {Name: "Network"}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err := validatePreviousResourceNames([]YamlConfig{config, {Name: "Access Rule"}})
+	if err == nil || !strings.Contains(err.Error(), "collides with definition 'Access Rule'") {
+		t.Errorf("expected collision error, got: %v", err)
+	}
+
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output.String(), `return helpers.NewDeprecatedResource(&AccessPolicyRuleResource{}, "access_rule", "access_policy_rule")`) {
+		t.Errorf("expected deprecated resource constructor in rendered resource")
+	}
+	output, err = executeTemplate("../gen/templates/provider.go", []YamlConfig{config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output.String(), "NewAccessPolicyRuleResource,\n\t\tNewAccessRuleResource,") {
+		t.Errorf("expected both resource names to be registered in rendered provider")
+	}
+}
+
+// The rendered model is compiled with a test matching list elements by a key of a String, an Int64 and a Bool
+// attribute
+const compositeIdUpdate = `package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+)
+
+func TestCompositeIdUpdate(t *testing.T) {
+	data := CompositeId{Entries: []CompositeIdEntries{
+		{Protocol: types.StringValue("TCP"), Port: types.Int64Value(80), Enabled: types.BoolValue(true), Description: types.StringValue("HTTP")},
+		{Protocol: types.StringValue("TCP"), Port: types.Int64Value(80), Enabled: types.BoolValue(false), Description: types.StringValue("Disabled")},
+		{Protocol: types.StringValue("UDP"), Port: types.Int64Value(80), Enabled: types.BoolValue(true), Description: types.StringValue("QUIC")},
+	}}
+	body := ` + "`" + `{"entries": [{"protocol": "UDP", "port": 80, "enabled": true, "description": "QUIC2"}, {"protocol": "TCP", "port": 80, "enabled": false}, {"protocol": "TCP", "port": 80, "enabled": true, "description": "HTTP2"}]}` + "`" + `
+	data.updateFromBody(context.Background(), gjson.Parse(body))
+	if data.Entries[0].Description.ValueString() != "HTTP2" || !data.Entries[1].Description.IsNull() || data.Entries[2].Description.ValueString() != "QUIC2" {
+		t.Errorf("expected the elements to be matched by all their id attributes, got: %+v", data.Entries)
+	}
+}
+`
+
+func TestValidateIdAttributes(t *testing.T) {
+	list := func(attributes ...YamlConfigAttribute) YamlConfig {
+		return YamlConfig{Name: "Test", Attributes: []YamlConfigAttribute{{TfName: "objects", Type: "List", Attributes: attributes}}}
+	}
+	tests := []struct {
+		config YamlConfig
+		err    bool
+	}{
+		{list(YamlConfigAttribute{ModelName: "id", TfName: "id", Type: "String", Id: true}, YamlConfigAttribute{ModelName: "name", TfName: "name", Type: "String"}), false},
+		// Composite keys of list elements
+		{list(YamlConfigAttribute{ModelName: "type", TfName: "type", Type: "String", Id: true}, YamlConfigAttribute{ModelName: "value", TfName: "value", Type: "String", Id: true}), false},
+		// Int64 and Bool keys of list elements
+		{list(YamlConfigAttribute{ModelName: "port", TfName: "port", Type: "Int64", Id: true}), false},
+		{list(YamlConfigAttribute{ModelName: "port", TfName: "port", Type: "Int64", Id: true}, YamlConfigAttribute{ModelName: "enabled", TfName: "enabled", Type: "Bool", Id: true}), false},
+		// The ID of the object
+		{YamlConfig{Name: "Test", Attributes: []YamlConfigAttribute{{ModelName: "id", TfName: "object_id", Type: "String", Id: true}}}, false},
+		{YamlConfig{Name: "Test", Attributes: []YamlConfigAttribute{{ModelName: "id", TfName: "object_id", Type: "String", Id: true}, {ModelName: "uuid", TfName: "uuid", Type: "String", Id: true}}}, true},
+		{YamlConfig{Name: "Test", Attributes: []YamlConfigAttribute{{ModelName: "id", TfName: "object_id", Type: "Int64", Id: true}}}, true},
+	}
+	for i, tt := range tests {
+		if err := validateConfig(tt.config); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+
+	warnings := idWarnings([]YamlConfigAttribute{{ModelName: "id", TfName: "object_id", Type: "String", Id: true, DataPath: []string{"object"}}})
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "'object.id'") {
+		t.Errorf("expected warning for id attribute with data_path, got: %v", warnings)
+	}
+	if warnings := idWarnings(list(YamlConfigAttribute{ModelName: "port", TfName: "port", Type: "Int64", Id: true}).Attributes); len(warnings) != 0 {
+		t.Errorf("expected no warnings for keys of list elements, got: %v", warnings)
+	}
+
+	config := loadTestConfig(t, "composite_id.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedModel(t, config, compositeIdUpdate); err != nil {
+		t.Errorf("composite id test failed: %v\n%s", err, out)
+	}
+}
+
+func TestValidateMinAttribute(t *testing.T) {
+	warning := YamlConfigAttribute{TfName: "warning_threshold", Type: "Int64"}
+	tests := []struct {
+		attributes []YamlConfigAttribute
+		err        bool
+	}{
+		{[]YamlConfigAttribute{warning, {TfName: "critical_threshold", Type: "Int64", MinAttribute: "warning_threshold"}}, false},
+		{[]YamlConfigAttribute{warning, {TfName: "critical_threshold", Type: "Int64", MinAttribute: "unknown_threshold"}}, true},
+		{[]YamlConfigAttribute{warning, {TfName: "critical_threshold", Type: "String", MinAttribute: "warning_threshold"}}, true},
+		{[]YamlConfigAttribute{{TfName: "critical_threshold", Type: "Int64", MinAttribute: "critical_threshold"}}, true},
+	}
+	for i, tt := range tests {
+		if err := validateAttributes(tt.attributes); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+}
+
+func TestValidateWithinCidr(t *testing.T) {
+	supernet := YamlConfigAttribute{TfName: "supernet", Type: "String"}
+	tests := []struct {
+		attributes []YamlConfigAttribute
+		err        bool
+	}{
+		{[]YamlConfigAttribute{{TfName: "prefix", Type: "String", WithinCidr: "10.0.0.0/8"}}, false},
+		{[]YamlConfigAttribute{{TfName: "prefix", Type: "String", WithinCidr: "10.0.0.0"}}, true},
+		{[]YamlConfigAttribute{{TfName: "prefix", Type: "StringList", WithinCidr: "10.0.0.0/8"}}, true},
+		{[]YamlConfigAttribute{{TfName: "prefix", Type: "String", WithinCidr: "10.0.0.0/8", EnumValues: []string{"10.1.1.0/24"}}}, true},
+		{[]YamlConfigAttribute{supernet, {TfName: "prefix", Type: "String", WithinCidrAttribute: "supernet"}}, false},
+		{[]YamlConfigAttribute{supernet, {TfName: "prefix", Type: "String", WithinCidrAttribute: "unknown"}}, true},
+		{[]YamlConfigAttribute{supernet, {TfName: "prefix", Type: "String", WithinCidr: "10.0.0.0/8", WithinCidrAttribute: "supernet"}}, true},
+		{[]YamlConfigAttribute{{TfName: "prefix", Type: "String", WithinCidrAttribute: "prefix"}}, true},
+	}
+	for i, tt := range tests {
+		if err := validateAttributes(tt.attributes); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+
+	config := loadTestConfig(t, "within_cidr.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := validateTemplate("../gen/templates/resource.go", config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []string{
+		`helpers.WithinCIDRValidator("10.0.0.0/8"),`,
+		`helpers.WithinCIDRAttributeValidator(path.MatchRelative().AtParent().AtName("supernet")),`,
+		`.AddWithinCidrDescription("10.0.0.0/8")`,
+	} {
+		if !strings.Contains(output.String(), s) {
+			t.Errorf("expected '%s' in rendered resource.go", s)
+		}
+	}
+}
+
+func TestValidateAfterAttribute(t *testing.T) {
+	start := YamlConfigAttribute{TfName: "start", Type: "String", Format: "time_of_day"}
+	tests := []struct {
+		attributes []YamlConfigAttribute
+		err        bool
+	}{
+		{[]YamlConfigAttribute{start, {TfName: "end", Type: "String", Format: "time_of_day", AfterAttribute: "start"}}, false},
+		{[]YamlConfigAttribute{start, {TfName: "end", Type: "String", Format: "date_time", AfterAttribute: "start"}}, true},
+		{[]YamlConfigAttribute{start, {TfName: "end", Type: "String", AfterAttribute: "start"}}, true},
+		{[]YamlConfigAttribute{start, {TfName: "end", Type: "String", Format: "time_of_day", AfterAttribute: "unknown"}}, true},
+		{[]YamlConfigAttribute{{TfName: "end", Type: "String", Format: "time_of_day", AfterAttribute: "end"}}, true},
+		{[]YamlConfigAttribute{{TfName: "end", Type: "Int64", Format: "date_time"}}, true},
+	}
+	for i, tt := range tests {
+		if err := validateAttributes(tt.attributes); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+
+	config := loadTestConfig(t, "after_attribute.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []string{
+		`helpers.DateTimeValidator(),`,
+		`helpers.TimeOfDayValidator(),`,
+		`helpers.AfterAttributeValidator(path.MatchRelative().AtParent().AtName("start_date_time")),`,
+		`helpers.AfterAttributeValidator(path.MatchRelative().AtParent().AtName("start_time")),`,
+		`.AddFormatDescription("YYYY-MM-DDTHH:MM").AddAfterAttributeDescription("start_date_time")`,
+	} {
+		if !strings.Contains(output.String(), s) {
+			t.Errorf("expected '%s' in rendered resource.go", s)
+		}
+	}
+}
+
+func TestValidateExplicitNull(t *testing.T) {
+	tests := []struct {
+		attributes []YamlConfigAttribute
+		err        bool
+	}{
+		{[]YamlConfigAttribute{{TfName: "description", Type: "String", ExplicitNull: true}}, false},
+		{[]YamlConfigAttribute{{TfName: "ports", Type: "StringList", ExplicitNull: true}}, false},
+		{[]YamlConfigAttribute{{TfName: "description", Type: "String", ExplicitNull: true, Mandatory: true}}, true},
+		{[]YamlConfigAttribute{{TfName: "description", Type: "String", ExplicitNull: true, DefaultValue: "none"}}, true},
+		{[]YamlConfigAttribute{{TfName: "entries", Type: "List", ExplicitNull: true}}, true},
+		{[]YamlConfigAttribute{{TfName: "entries", Type: "List", Attributes: []YamlConfigAttribute{{TfName: "description", Type: "String", ExplicitNull: true}}}}, true},
+	}
+	for i, tt := range tests {
+		if err := validateConfig(YamlConfig{Name: "Test", Attributes: tt.attributes}); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+}
+
+// The rendered resource is compiled with a test configuring the legacy name instead of the attribute
+const acceptLegacyNameCreate = `package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/tidwall/gjson"
+)
+
+type legacyNameProvider struct{}
+
+func (p *legacyNameProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "fmc"
+}
+
+func (p *legacyNameProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+}
+
+func (p *legacyNameProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+}
+
+func (p *legacyNameProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{NewLegacyNameResource}
+}
+
+func (p *legacyNameProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func TestLegacyNameCreate(t *testing.T) {
+	var body string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			w.Write([]byte(` + "`" + `{"id":"OBJECT-1","name":"NAME1","value":"10.1.1.0/24"}` + "`" + `))
+			return
+		}
+		w.Write([]byte(` + "`" + `{"id":"OBJECT-1","name":"NAME1","value":"10.1.2.0/24"}` + "`" + `))
+	})
+	ctx := context.Background()
+	r := &LegacyNameResource{client: client}
+	schema := testResourceSchema(r)
+	data := LegacyName{Id: types.StringUnknown(), Domain: types.StringNull(), Name: types.StringValue("NAME1"), Prefix: types.StringNull(), Network: types.StringValue("10.1.1.0/24")}
+
+	// Terraform warns about the legacy name when validating the configuration
+	config := tfsdk.State{Schema: schema}
+	config.Set(ctx, &LegacyName{Id: types.StringNull(), Domain: types.StringNull(), Name: data.Name, Prefix: data.Prefix, Network: data.Network})
+	value, err := tfprotov6.NewDynamicValue(schema.Type().TerraformType(ctx), config.Raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := providerserver.NewProtocol6(&legacyNameProvider{})()
+	validateResp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{TypeName: "fmc_legacy_name", Config: &value})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(validateResp.Diagnostics) != 1 || validateResp.Diagnostics[0].Severity != tfprotov6.DiagnosticSeverityWarning || validateResp.Diagnostics[0].Detail != "The ` + "`" + `network` + "`" + ` attribute has been renamed to ` + "`" + `prefix` + "`" + ` and will be removed in a future release." {
+		t.Errorf("expected a single deprecation warning, got: %+v", validateResp.Diagnostics)
+	}
+
+	// The value of the legacy name is sent as the attribute and kept under the legacy name in the state
+	plan := tfsdk.Plan{Schema: schema}
+	plan.Set(ctx, &data)
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if gjson.Get(body, "value").String() != "10.1.1.0/24" {
+		t.Errorf("expected the legacy name to be sent as value, got: %s", body)
+	}
+	readResp := resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	var read LegacyName
+	readResp.State.Get(ctx, &read)
+	if read.Network.ValueString() != "10.1.2.0/24" || !read.Prefix.IsNull() {
+		t.Errorf("expected the value to be read back into the legacy name only, got: %s, %s", read.Network, read.Prefix)
+	}
+}
+`
+
+func TestAcceptLegacyName(t *testing.T) {
+	config := loadTestConfig(t, "accept_legacy_name.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, tmpl := range templates {
+		if !strings.HasSuffix(tmpl.path, ".go") {
+			continue
+		}
+		if err := validateTemplate(filepath.Join("..", tmpl.path), config); err != nil {
+			t.Errorf("unexpected error for template '%s': %v", tmpl.path, err)
+		}
+	}
+	if out, err := testRenderedResource(t, config, acceptLegacyNameCreate); err != nil {
+		t.Errorf("configuring the legacy name failed: %v\n%s", err, out)
+	}
+
+	tests := []struct {
+		template string
+		contains []string
+	}{
+		{"model.go", []string{
+			"Network types.String `tfsdk:\"network\"`",
+			"if data.Prefix.IsNull() {\n\t\tdata.Prefix = data.Network\n\t}",
+			"if value := res.Get(\"value\"); value.Exists() && !data.Network.IsNull() {",
+		}},
+		{"resource.go", []string{
+			`"network": schema.StringAttribute{`,
+			"DeprecationMessage:  \"The `network` attribute has been renamed to `prefix` and will be removed in a future release.\",",
+			`stringvalidator.ConflictsWith(path.MatchRoot("prefix")),`,
+		}},
+	}
+	for _, tt := range tests {
+		output, err := executeTemplate("../gen/templates/"+tt.template, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, s := range tt.contains {
+			if !strings.Contains(output.String(), s) {
+				t.Errorf("expected '%s' in rendered %s", s, tt.template)
+			}
+		}
+	}
+
+	config.Attributes[1].DefaultValue = "0.0.0.0/0"
+	if err := validateConfig(config); err == nil || !strings.Contains(err.Error(), "accept_legacy_name is only supported for optional attributes") {
+		t.Errorf("expected error for legacy name with default value, got: %v", err)
+	}
+	config.Attributes[1].DefaultValue = ""
+	config.Attributes[1].AcceptLegacyName = "name"
+	if err := validateConfig(config); err == nil || !strings.Contains(err.Error(), "collides with attribute 'name'") {
+		t.Errorf("expected collision error, got: %v", err)
+	}
+}
+
+func TestValidateDiscriminator(t *testing.T) {
+	discriminator := YamlConfigAttribute{TfName: "enrollment_type", Type: "String", Discriminator: true, EnumValues: []string{"SCEP", "MANUAL"}}
+	tests := []struct {
+		attributes []YamlConfigAttribute
+		err        string
+	}{
+		{[]YamlConfigAttribute{discriminator, {TfName: "url", Type: "String", DiscriminatorValues: []string{"SCEP"}}}, ""},
+		{[]YamlConfigAttribute{discriminator, {TfName: "url", Type: "String", DiscriminatorValues: []string{"EST"}}}, "discriminator value 'EST' is not one of the enum_values"},
+		{[]YamlConfigAttribute{{TfName: "url", Type: "String", DiscriminatorValues: []string{"SCEP"}}}, "discriminator_values requires a discriminator attribute"},
+		{[]YamlConfigAttribute{discriminator, discriminator}, "only a single attribute can be a discriminator"},
+		{[]YamlConfigAttribute{discriminator, {TfName: "url", Type: "String", DiscriminatorValues: []string{"SCEP"}, RequiredForValues: true}}, ""},
+		{[]YamlConfigAttribute{discriminator, {TfName: "url", Type: "String", RequiredForValues: true}}, "required_for_values requires discriminator_values"},
+	}
+	for i, tt := range tests {
+		err := validateConfig(YamlConfig{Name: "Certificate Enrollment", Attributes: tt.attributes})
+		if (err == nil) != (tt.err == "") || (err != nil && !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("case %d: expected error '%s', got: %v", i, tt.err, err)
+		}
+	}
+}
+
+func TestValidateImplies(t *testing.T) {
+	tests := []struct {
+		attributes []YamlConfigAttribute
+		err        string
+	}{
+		{[]YamlConfigAttribute{{TfName: "username", Type: "String", Implies: []string{"password"}}, {TfName: "password", Type: "String"}}, ""},
+		{[]YamlConfigAttribute{{TfName: "username", Type: "String", Implies: []string{"secret"}}, {TfName: "password", Type: "String"}}, "implied attribute 'secret' is not another configurable top-level attribute"},
+		{[]YamlConfigAttribute{{TfName: "username", Type: "String", Implies: []string{"username"}}}, "implied attribute 'username' is not another configurable top-level attribute"},
+		{[]YamlConfigAttribute{{TfName: "username", Type: "String", Implies: []string{"password"}}, {TfName: "password", Type: "String", Mandatory: true}}, "implies is only supported between optional attributes"},
+		{[]YamlConfigAttribute{{TfName: "users", Type: "List", Attributes: []YamlConfigAttribute{{TfName: "username", Type: "String", Implies: []string{"password"}}, {TfName: "password", Type: "String"}}}}, "implies is only supported for top-level attributes"},
+	}
+	for i, tt := range tests {
+		err := validateConfig(YamlConfig{Name: "Implies", Attributes: tt.attributes})
+		if (err == nil) != (tt.err == "") || (err != nil && !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("case %d: expected error '%s', got: %v", i, tt.err, err)
+		}
+	}
+}
+
+func TestPathConfig(t *testing.T) {
+	configs := []YamlConfig{
+		{Name: "Access Control Policy", RestEndpoint: "/policy/accesspolicies", Attributes: []YamlConfigAttribute{
+			{ModelName: "name", Type: "String", Example: "POLICY1"},
+		}},
+		{Name: "Access Control Policy Category", RestEndpoint: "/policy/accesspolicies/%v/categories", Attributes: []YamlConfigAttribute{
+			{TfName: "access_control_policy_id", Type: "String", Reference: true},
+			{ModelName: "name", Type: "String"},
+		}},
+		{Name: "Access Rule", RestEndpoint: "/policy/accesspolicies/%v/categories/%v/rules", Attributes: []YamlConfigAttribute{
+			{TfName: "access_control_policy_id", Type: "String", Reference: true},
+			{TfName: "access_control_policy_category_id", Type: "String", Reference: true},
+			{ModelName: "name", Type: "String"},
+		}},
+		{Name: "Device Interface", RestEndpoint: "/devices/%v/interfaces", Attributes: []YamlConfigAttribute{
+			{TfName: "device_id", Type: "String", Reference: true},
+		}},
+	}
+
+	path, err := pathConfig(configs[2], configs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path.Name != "Access Rule Path" || path.RestEndpoint != configs[2].RestEndpoint {
+		t.Errorf("unexpected path config: %s, %s", path.Name, path.RestEndpoint)
+	}
+	segments := []string{}
+	for _, segment := range path.PathSegments {
+		segments = append(segments, segment.Endpoint+"="+segment.NameAttribute+":"+segment.IdAttribute)
+	}
+	expected := "/policy/accesspolicies=access_control_policy_name:access_control_policy_id," +
+		"/policy/accesspolicies/%v/categories=access_control_policy_category_name:access_control_policy_category_id," +
+		"/policy/accesspolicies/%v/categories/%v/rules=name:id"
+	if got := strings.Join(segments, ","); got != expected {
+		t.Errorf("unexpected path segments: %s", got)
+	}
+	if path.Attributes[0].Example != "POLICY1" || !path.Attributes[0].Reference || path.Attributes[1].Reference {
+		t.Errorf("expected required name and computed id attributes, got: %+v", path.Attributes[:2])
+	}
+
+	if _, err := pathConfig(configs[0], configs); err == nil || !strings.Contains(err.Error(), "has no parent objects") {
+		t.Errorf("expected error for top-level object, got: %v", err)
+	}
+	if _, err := pathConfig(configs[3], configs); err == nil || !strings.Contains(err.Error(), "no definition found for the parent of 'Device Interface'") {
+		t.Errorf("expected error for undefined parent, got: %v", err)
+	}
+
+	cyclic := []YamlConfig{
+		{Name: "Folder", RestEndpoint: "/folders/%v/folders", Attributes: []YamlConfigAttribute{
+			{TfName: "folder_id", Type: "String", Reference: true},
+		}},
+		{Name: "Node A", RestEndpoint: "/nodes/%v/a", Attributes: []YamlConfigAttribute{
+			{TfName: "node_b_id", Type: "String", Reference: true},
+		}},
+		{Name: "Node B", RestEndpoint: "/nodes/%v/b", Attributes: []YamlConfigAttribute{
+			{TfName: "node_a_id", Type: "String", Reference: true},
+		}},
+	}
+	if _, err := pathConfig(cyclic[0], cyclic); err == nil || !strings.Contains(err.Error(), "the parents of 'Folder' form a cycle through 'Folder'") {
+		t.Errorf("expected error for self-referencing parent, got: %v", err)
+	}
+	if _, err := pathConfig(cyclic[1], cyclic); err == nil || !strings.Contains(err.Error(), "the parents of 'Node A' form a cycle through 'Node A'") {
+		t.Errorf("expected error for cyclic parents, got: %v", err)
+	}
+}
+
+func TestValidateLookup(t *testing.T) {
+	name := YamlConfigAttribute{TfName: "network_name", Type: "String", WriteOnly: true}
+	lookup := YamlConfigAttribute{TfName: "network_id", Type: "String", LookupEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks", LookupName: "network_name"}
+	mandatory := lookup
+	mandatory.Mandatory = true
+	noEndpoint := lookup
+	noEndpoint.LookupEndpoint = ""
+	tests := []struct {
+		attributes []YamlConfigAttribute
+		err        bool
+	}{
+		{[]YamlConfigAttribute{name, lookup}, false},
+		{[]YamlConfigAttribute{lookup}, true},
+		{[]YamlConfigAttribute{{TfName: "network_name", Type: "String"}, lookup}, true},
+		{[]YamlConfigAttribute{name, mandatory}, true},
+		{[]YamlConfigAttribute{name, noEndpoint}, true},
+	}
+	for i, tt := range tests {
+		if err := validateAttributes(tt.attributes); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+
+	list := YamlConfigAttribute{TfName: "variables", Type: "List", Attributes: []YamlConfigAttribute{name, lookup}}
+	if err := validateConfig(YamlConfig{Attributes: []YamlConfigAttribute{list}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateConfig(YamlConfig{Attributes: []YamlConfigAttribute{name, lookup}}); err == nil {
+		t.Error("expected error for top-level lookup_endpoint")
+	}
+	nested := YamlConfigAttribute{TfName: "objects", Type: "List", Attributes: []YamlConfigAttribute{list}}
+	if err := validateConfig(YamlConfig{Attributes: []YamlConfigAttribute{nested}}); err == nil {
+		t.Error("expected error for nested lookup_endpoint")
+	}
+
+	augmentAttribute(&list)
+	if !list.Attributes[0].IsLookupName || list.Attributes[1].IsLookupName {
+		t.Error("expected only the lookup_name attribute to be marked as lookup name")
+	}
+}
+
+func TestAutoCreateParent(t *testing.T) {
+	config := YamlConfig{
+		Name:             "Access Control Policy Category",
+		RestEndpoint:     "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories",
+		AutoCreateParent: YamlAutoCreateParent{Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies", Body: `{"type": "AccessPolicy"}`},
+		Attributes: []YamlConfigAttribute{
+			{TfName: "access_control_policy_id", Type: "String", Reference: true},
+			{ModelName: "name", Type: "String", Mandatory: true},
+		},
+	}
+	augmentConfig(&config)
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !config.Attributes[0].ParentReference || config.AutoCreateParent.NameAttribute != "access_control_policy_name" || config.AutoCreateParent.FlagAttribute != "create_access_control_policy" {
+		t.Errorf("unexpected parent attributes: %+v", config.AutoCreateParent)
+	}
+	if len(config.Attributes) != 4 || !config.Attributes[2].ParentAttribute || !config.Attributes[3].ParentAttribute {
+		t.Fatalf("expected name and flag attributes of the parent to be added, got: %+v", config.Attributes)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []string{
+		`helpers.EnsureParent(client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies", plan.AccessControlPolicyName.ValueString(), ` + "`" + `{"type": "AccessPolicy"}` + "`" + `, plan.CreateAccessControlPolicy.ValueBool(), reqMods...)`,
+		`resp.Private.SetKey(ctx, "parent_owned", []byte("true"))`,
+		`req.Private.GetKey(ctx, "parent_owned")`,
+	} {
+		if !strings.Contains(output.String(), s) {
+			t.Errorf("expected '%s' in rendered resource.go", s)
+		}
+	}
+
+	invalid := config
+	invalid.Attributes = append([]YamlConfigAttribute{{TfName: "device_id", Type: "String", Reference: true}}, config.Attributes...)
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for multiple reference attributes")
+	}
+	invalid = config
+	invalid.AutoCreateParent.Body = "{"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for invalid body")
+	}
+	invalid = config
+	invalid.NoDelete = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for no_delete")
+	}
+}
+
+func TestDeleteEndpoint(t *testing.T) {
+	config := loadTestConfig(t, "delete_endpoint.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `client.Delete(fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords/%v/associations/%v/disassociate", state.DeviceId.ValueString(), state.Id.ValueString()), reqMods...)`
+	if !strings.Contains(output.String(), expected) {
+		t.Errorf("expected Delete to use the delete_endpoint, got:\n%s", output.String())
+	}
+	if strings.Contains(output.String(), `client.Delete(state.getPath() + "/" + state.Id.ValueString(), reqMods...)`) {
+		t.Error("expected Delete not to use the rest_endpoint")
+	}
+
+	invalid := config
+	invalid.DeleteEndpoint = "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords/%v/disassociate"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for missing placeholder")
+	}
+	invalid = config
+	invalid.NoDelete = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for no_delete")
+	}
+}
+
+func TestExampleOutput(t *testing.T) {
+	config := loadTestConfig(t, "example_output.yaml")
+	output, err := executeTemplate("../gen/templates/resource.tf", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	golden, err := os.ReadFile(filepath.Join("testdata", "example_output.tf"))
+	if err != nil {
+		t.Fatalf("Error reading file: %v", err)
+	}
+	if output.String() != string(golden) {
+		t.Errorf("rendered example does not match testdata/example_output.tf, got:\n%s", output.String())
+	}
+}
+
+func TestValidateWarnThreshold(t *testing.T) {
+	tests := []struct {
+		attr YamlConfigAttribute
+		err  bool
+	}{
+		{YamlConfigAttribute{TfName: "count", Type: "Int64", MaxInt: 100, WarnThreshold: 90}, false},
+		{YamlConfigAttribute{TfName: "count", Type: "Int64", WarnThreshold: 90}, true},
+		{YamlConfigAttribute{TfName: "count", Type: "Int64", MaxInt: 100, WarnThreshold: 100}, true},
+		{YamlConfigAttribute{TfName: "count", Type: "Float64", MaxFloat: 100, WarnThreshold: 90}, true},
+	}
+	for i, tt := range tests {
+		if err := validateAttributes([]YamlConfigAttribute{tt.attr}); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+
+	config := YamlConfig{Name: "Warn Threshold", Attributes: []YamlConfigAttribute{tests[0].attr}}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output.String(), "helpers.WarnThresholdValidator(100, 90),") {
+		t.Error("expected 'helpers.WarnThresholdValidator(100, 90),' in rendered resource.go")
+	}
+}
+
+func TestExampleVariabilize(t *testing.T) {
+	config := loadTestConfig(t, "example_variabilize.yaml")
+	render := func(name string) string {
+		output, err := executeTemplate("../gen/templates/"+name, config)
+		if err != nil {
+			t.Fatalf("unexpected error rendering %s: %v", name, err)
+		}
+		return output.String()
+	}
+
+	variables := render("variables.tf")
+	expected := "variable \"name\" {\n" +
+		"  description = \"The \\\"name\\\" of the object.\"\n" +
+		"  type        = string\n" +
+		"}\n\n" +
+		"variable \"mtu\" {\n" +
+		"  description = \"Maximum transmission unit.\"\n" +
+		"  type        = number\n" +
+		"  default     = null\n" +
+		"}\n\n" +
+		"variable \"enabled\" {\n" +
+		"  description = \"Enable the object.\"\n" +
+		"  type        = bool\n" +
+		"  default     = null\n" +
+		"}\n\n" +
+		"variable \"literals\" {\n" +
+		"  description = \"Literal values.\"\n" +
+		"  type        = list(string)\n" +
+		"  default     = null\n" +
+		"}\n"
+	if variables != expected {
+		t.Errorf("unexpected variables.tf, got:\n%s", variables)
+	}
+
+	tfvars := render("terraform.tfvars.example")
+	expected = "name     = \"NAME1\"\nmtu      = 1500\nenabled  = true\nliterals = [\"10.1.1.1\"]\n"
+	if tfvars != expected {
+		t.Errorf("unexpected terraform.tfvars.example, got:\n%s", tfvars)
+	}
+	config.Attributes[0].Example = `C:\names\"NAME1"`
+	if tfvars := render("terraform.tfvars.example"); !strings.Contains(tfvars, `name     = "C:\\names\\\"NAME1\""`) {
+		t.Errorf("expected escaped string in terraform.tfvars.example, got:\n%s", tfvars)
+	}
+	config.Attributes[0].Example = "NAME1"
+
+	example := render("resource.tf")
+	for _, s := range []string{"name = var.name", "mtu = var.mtu", "literals = var.literals", "value = \"ENTRY1\""} {
+		if !strings.Contains(example, s) {
+			t.Errorf("expected '%s' in rendered example, got:\n%s", s, example)
+		}
+	}
+	if strings.Contains(example, "var.entries") || strings.Contains(example, "secret") {
+		t.Errorf("expected lists and excluded attributes to remain inline, got:\n%s", example)
+	}
+
+	if err := validateConfig(YamlConfig{Name: "Test", ExampleVariabilize: true, NoResource: true}); err == nil {
+		t.Errorf("expected error combining example_variabilize with no_resource")
+	}
+}
+
+func TestSkipReadAfterCreate(t *testing.T) {
+	config := loadTestConfig(t, "skip_read_after_create.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := validateTemplate("../gen/templates/resource.go", config); err != nil {
+		t.Fatalf("rendered resource is not valid Go: %v", err)
+	}
+	create := getTemplateSection(output.String(), "create")
+	post := strings.Index(create, "client.Post(")
+	if post < 0 {
+		t.Fatalf("expected Create to POST the object, got:\n%s", create)
+	}
+	if strings.Contains(create[post:], "client.Get(") {
+		t.Errorf("expected no GET after the POST in Create, got:\n%s", create)
+	}
+	expected := `if value := res.Get("defaultAction.id"); value.Exists() {` + "\n" +
+		`		plan.DefaultActionId = types.StringValue(value.String())`
+	if !strings.Contains(create, expected) {
+		t.Errorf("expected the resource_id to be taken from the create response, got:\n%s", create)
+	}
+	if !strings.Contains(getTemplateSection(output.String(), "read"), "client.Get(") {
+		t.Error("expected Read to still GET the object")
+	}
+
+	config.SkipReadAfterCreate = false
+	output, err = executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(getTemplateSection(output.String(), "create"), "client.Get(") {
+		t.Error("expected Create to read the object back without skip_read_after_create")
+	}
+
+	invalid := loadTestConfig(t, "skip_read_after_create.yaml")
+	invalid.ReadEndpoints = []YamlReadEndpoint{{Path: "/settings", Attributes: []string{"default_action_id"}}}
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for read_endpoints")
+	}
+	invalid = loadTestConfig(t, "skip_read_after_create.yaml")
+	invalid.Attributes = append(invalid.Attributes, YamlConfigAttribute{ModelName: "rules", TfName: "rules", Type: "List", Attributes: []YamlConfigAttribute{{ModelName: "id", TfName: "id", Type: "String", ResourceId: true}}})
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for nested resource_id")
+	}
+}
+
+// testModule copies the provider module into a temporary directory, so rendered files can be compiled with
+// the provider packages without writing them into the source tree
+func testModule(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, name := range []string{"go.mod", "go.sum"} {
+		content, err := os.ReadFile(filepath.Join("..", name))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		os.WriteFile(filepath.Join(dir, name), content, 0644)
+	}
+	err := filepath.WalkDir("../internal", func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, strings.TrimPrefix(path, ".."))
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, content, 0644)
+	})
+	if err != nil {
+		t.Fatalf("failed to copy module: %v", err)
+	}
+	return dir
+}
+
+// testRenderedModel renders the model of a definition and runs the given test source against it in a copy of
+// the provider module, returning the output of the test run
+func testRenderedModel(t *testing.T, config YamlConfig, source string) ([]byte, error) {
+	t.Helper()
+	output, err := executeTemplate("../gen/templates/model.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	model, err := imports.Process("model.go", output.Bytes(), nil)
+	if err != nil {
+		t.Fatalf("rendered model is not valid Go: %v", err)
+	}
+	module := testModule(t)
+	dir := filepath.Join(module, "internal", "provider", "model")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	os.WriteFile(filepath.Join(dir, "model.go"), model, 0644)
+	os.WriteFile(filepath.Join(dir, "model_test.go"), []byte(source), 0644)
+	cmd := exec.Command("go", "test", "-count=1", "./internal/provider/model")
+	cmd.Dir = module
+	return cmd.CombinedOutput()
+}
+
+// testRenderedResource renders the model and resource of a definition into a copy of the provider package and
+// runs the given test there, the test source can use the mock helpers of the provider package
+func testRenderedResource(t *testing.T, config YamlConfig, source string) ([]byte, error) {
+	t.Helper()
+	files := map[string][]byte{"_test.go": []byte(source)}
+	names := []string{"model", "resource"}
+	if config.SplitFiles {
+		names = append(names, "resource_schema")
+	}
+	if config.DataSourceDiff {
+		names = append(names, "data_source", "data_source_diff")
+	}
+	if config.DataSourceDrift {
+		names = append(names, "data_source", "data_source_drift")
+	}
+	if config.Bulk {
+		names = append(names, "resource_bulk")
+	}
+	for _, name := range names {
+		output, err := executeTemplate("../gen/templates/"+name+".go", config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if files["_"+name+".go"], err = imports.Process(name+".go", output.Bytes(), nil); err != nil {
+			t.Fatalf("rendered %s is not valid Go: %v", name, err)
+		}
+	}
+	module := testModule(t)
+	prefix := filepath.Join(module, "internal", "provider", "zz_generator_"+SnakeCase(config.Name))
+	for suffix, content := range files {
+		os.WriteFile(prefix+suffix, content, 0644)
+	}
+	cmd := exec.Command("go", "test", "-count=1", "-run", "^Test"+CamelCase(config.Name), "./internal/provider")
+	cmd.Dir = module
+	return cmd.CombinedOutput()
+}
+
+// The rendered model is compiled with a test round-tripping the id-keyed object through the model
+const mapKeyedRoundTrip = `package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestMapKeyedRoundTrip(t *testing.T) {
+	body := ` + "`" + `{"name":"NAME1","members":{"id1":{"priority":10,"enabled":true},"a.b":{"priority":20}}}` + "`" + `
+	var data MapKeyed
+	data.fromBody(context.Background(), gjson.Parse(body))
+	if len(data.Members) != 2 || data.Members[0].Id.ValueString() != "id1" || data.Members[1].Id.ValueString() != "a.b" || data.Members[1].Priority.ValueInt64() != 20 {
+		t.Fatalf("unexpected members: %+v", data.Members)
+	}
+	if output := data.toBody(context.Background(), MapKeyed{}); !gjson.Valid(output) || gjson.Get(output, "members").Raw != gjson.Get(body, "members").Raw {
+		t.Errorf("expected members to be written back as keyed object, got: %s", output)
+	}
+
+	data.updateFromBody(context.Background(), gjson.Parse(` + "`" + `{"members":{"a.b":{"priority":30}}}` + "`" + `))
+	if !data.Members[0].Id.IsNull() || data.Members[1].Priority.ValueInt64() != 30 {
+		t.Errorf("unexpected members after update: %+v", data.Members)
+	}
+}
+`
+
+func TestMapKeyed(t *testing.T) {
+	config := loadTestConfig(t, "map_keyed.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedModel(t, config, mapKeyedRoundTrip); err != nil {
+		t.Errorf("round trip of map_keyed attribute failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "map_keyed.yaml")
+	invalid.Attributes[1].Attributes[0].Id = false
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for map_keyed without id attribute")
+	}
+	invalid = loadTestConfig(t, "map_keyed.yaml")
+	invalid.Attributes[0].MapKeyed = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for map_keyed on a String attribute")
+	}
+	invalid = loadTestConfig(t, "map_keyed.yaml")
+	invalid.Attributes = append(invalid.Attributes, YamlConfigAttribute{ModelName: "rules", TfName: "rules", Type: "List", Attributes: []YamlConfigAttribute{invalid.Attributes[1]}})
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for nested map_keyed")
+	}
+}
+
+// The rendered model is compiled with a test resolving the REST endpoint from two different attribute values
+const endpointParameterPaths = `package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEndpointParameterPath(t *testing.T) {
+	expected := map[string]string{
+		"protocolport": "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/protocolportobjects",
+		"icmpv4":       "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/icmpv4objects",
+	}
+	for value, path := range expected {
+		data := EndpointParameter{ObjectType: types.StringValue(value)}
+		if err := data.checkPath(); err != nil {
+			t.Errorf("unexpected error for '%s': %v", value, err)
+		}
+		if data.getPath() != path {
+			t.Errorf("expected path '%s' for '%s', got: %s", path, value, data.getPath())
+		}
+	}
+	if err := (EndpointParameter{ObjectType: types.StringNull()}).checkPath(); err == nil {
+		t.Error("expected error for unset object_type")
+	}
+}
+`
+
+func TestEndpointParameter(t *testing.T) {
+	config := loadTestConfig(t, "endpoint_parameter.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !config.Attributes[0].EndpointParameter || config.Attributes[1].EndpointParameter {
+		t.Errorf("expected only object_type to resolve the REST endpoint")
+	}
+	if out, err := testRenderedModel(t, config, endpointParameterPaths); err != nil {
+		t.Errorf("resolving the REST endpoint failed: %v\n%s", err, out)
+	}
+	for _, tmpl := range []string{"../gen/templates/resource.go", "../gen/templates/data_source.go"} {
+		if err := validateTemplate(tmpl, config); err != nil {
+			t.Errorf("unexpected error rendering '%s': %v", tmpl, err)
+		}
+	}
+	output, err := executeTemplate("../gen/templates/import.sh", config)
+	if err != nil || !strings.Contains(output.String(), `"protocolport,76d24097-41c4-4558-a4d0-a8c07ac08470"`) {
+		t.Errorf("expected import identifier with object_type, got: %s", output.String())
+	}
+
+	invalid := loadTestConfig(t, "endpoint_parameter.yaml")
+	invalid.RestEndpoint = "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/{unknown}objects"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for placeholder without attribute")
+	}
+	invalid = loadTestConfig(t, "endpoint_parameter.yaml")
+	invalid.Attributes[0].RequiresReplace = false
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for placeholder attribute without requires_replace")
+	}
+	invalid = loadTestConfig(t, "endpoint_parameter.yaml")
+	invalid.DataSourceCount = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for placeholder combined with data_source_count")
+	}
+}
+
+// The rendered resource is compiled with a test creating, reading and importing an object below an endpoint
+// built from two attributes and the ID of the parent object
+const endpointParametersCreate = `package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEndpointParametersCreate(t *testing.T) {
+	var paths []string
+	var body string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPost {
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ` + "`" + `{"id": "OBJECT-1", "name": "NAME1"}` + "`" + `)
+	})
+	ctx := context.Background()
+	r := &EndpointParametersResource{client: client}
+	schema := testResourceSchema(r)
+
+	data := EndpointParameters{Id: types.StringUnknown(), Domain: types.StringNull(), DeviceType: types.StringValue("chassis"), DeviceId: types.StringValue("DEVICE-1"), InterfaceType: types.StringValue("vlaninterfaces"), Name: types.StringValue("NAME1")}
+	plan := tfsdk.Plan{Schema: schema}
+	plan.Set(ctx, &data)
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	expected := "POST /api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/devices/chassis/DEVICE-1/vlaninterfaces"
+	if len(paths) == 0 || paths[0] != expected {
+		t.Errorf("expected request '%s', got: %v", expected, paths)
+	}
+	if strings.Contains(body, "deviceType") || strings.Contains(body, "interfaceType") {
+		t.Errorf("expected the endpoint parameters not to be sent in the body, got: %s", body)
+	}
+
+	// The parameters are not part of the response and are kept in the state to build the path of the object
+	paths = nil
+	readResp := resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	var read EndpointParameters
+	readResp.State.Get(ctx, &read)
+	if read.DeviceType.ValueString() != "chassis" || read.InterfaceType.ValueString() != "vlaninterfaces" {
+		t.Errorf("expected the endpoint parameters to survive the read, got: %s, %s", read.DeviceType, read.InterfaceType)
+	}
+	expected = "GET /api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/devices/chassis/DEVICE-1/vlaninterfaces/OBJECT-1"
+	if len(paths) == 0 || paths[0] != expected {
+		t.Errorf("expected request '%s', got: %v", expected, paths)
+	}
+
+	state := tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)}
+	importResp := resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "devicerecords,DEVICE-2,subinterfaces,OBJECT-2"}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", importResp.Diagnostics)
+	}
+	var imported EndpointParameters
+	importResp.State.Get(ctx, &imported)
+	if imported.Id.ValueString() != "OBJECT-2" || imported.getPath() != "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords/DEVICE-2/subinterfaces" {
+		t.Errorf("unexpected imported object %s below: %s", imported.Id.ValueString(), imported.getPath())
+	}
+
+	importResp = resource.ImportStateResponse{State: state}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "devicerecords,subinterfaces,OBJECT-2"}, &importResp)
+	if !importResp.Diagnostics.HasError() {
+		t.Error("expected error for import identifier without parent ID")
+	}
+
+	data.InterfaceType = types.StringNull()
+	if err := data.checkPath(); err == nil || !strings.Contains(err.Error(), "interface_type") {
+		t.Errorf("expected error for unset interface_type, got: %v", err)
+	}
+}
+`
+
+func TestEndpointParameters(t *testing.T) {
+	config := loadTestConfig(t, "endpoint_parameters.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, endpointParametersCreate); err != nil {
+		t.Errorf("building the REST endpoint from two attributes failed: %v\n%s", err, out)
+	}
+	output, err := executeTemplate("../gen/templates/import.sh", config)
+	if err != nil || !strings.Contains(output.String(), `"devicerecords,76d24097-41c4-4558-a4d0-a8c07ac08470,subinterfaces,76d24097-41c4-4558-a4d0-a8c07ac08470"`) {
+		t.Errorf("expected import identifier with both placeholders and the parent ID, got: %s", output.String())
+	}
+	output, err = executeTemplate("../gen/templates/resource_test.go", config)
+	if err != nil || !strings.Contains(output.String(), `attributes["device_type"], attributes["device_id"], attributes["interface_type"], attributes["id"]`) {
+		t.Errorf("expected import step with both placeholders and the parent ID, got: %v", err)
+	}
+}
+
+// The rendered resource is compiled with a test creating an object whose status converges after two polls and
+// updating an object whose status can not be retrieved
+const postApplyCheckApply = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestPostApplyCheckApply(t *testing.T) {
+	interval := helpers.TaskPollInterval
+	helpers.TaskPollInterval = 0
+	t.Cleanup(func() { helpers.TaskPollInterval = interval })
+
+	objectPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/postapplychecks"
+	polls := 0
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case objectPath, objectPath + "/OBJECT-1", objectPath + "/OBJECT-2":
+			fmt.Fprint(w, ` + "`" + `{"id": "OBJECT-1", "name": "NAME1"}` + "`" + `)
+		case objectPath + "/OBJECT-1/operational":
+			polls++
+			if polls < 2 {
+				fmt.Fprint(w, ` + "`" + `{"status": {"state": "PENDING"}}` + "`" + `)
+			} else {
+				fmt.Fprint(w, ` + "`" + `{"status": {"state": "UP"}}` + "`" + `)
+			}
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, ` + "`" + `{"error": {"messages": [{"description": "Internal error"}]}}` + "`" + `)
+		}
+	})
+	ctx := context.Background()
+	r := &PostApplyCheckResource{client: client}
+	schema := testResourceSchema(r)
+
+	data := PostApplyCheck{Id: types.StringUnknown(), Domain: types.StringNull(), Name: types.StringValue("NAME1")}
+	plan := tfsdk.Plan{Schema: schema}
+	plan.Set(ctx, &data)
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if polls != 2 {
+		t.Errorf("expected the status to be polled twice, got: %d", polls)
+	}
+
+	data.Id = types.StringValue("OBJECT-2")
+	plan.Set(ctx, &data)
+	state := tfsdk.State{Schema: schema}
+	state.Set(ctx, &data)
+	updateResp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &updateResp)
+	if !updateResp.Diagnostics.HasError() || !strings.Contains(updateResp.Diagnostics.Errors()[0].Summary(), "Post-Apply Check Failed") {
+		t.Errorf("expected failed post-apply check, got: %v", updateResp.Diagnostics)
+	}
+	var updated PostApplyCheck
+	updateResp.State.Get(ctx, &updated)
+	if updated.Id.ValueString() != "OBJECT-2" {
+		t.Errorf("expected the object to be kept in the state, got: %s", updated.Id.ValueString())
+	}
+}
+`
+
+func TestPostApplyCheck(t *testing.T) {
+	config := loadTestConfig(t, "post_apply_check.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.PostApplyCheck.Timeout != 300 {
+		t.Errorf("expected default timeout of 300 seconds, got: %d", config.PostApplyCheck.Timeout)
+	}
+	if out, err := testRenderedResource(t, config, postApplyCheckApply); err != nil {
+		t.Errorf("post-apply check failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "post_apply_check.yaml")
+	invalid.PostApplyCheck.Value = ""
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for post_apply_check without value")
+	}
+	invalid = loadTestConfig(t, "post_apply_check.yaml")
+	invalid.PostApplyCheck.Path = "operational"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for post_apply_check path not starting with '/'")
+	}
+	invalid = loadTestConfig(t, "post_apply_check.yaml")
+	invalid.NaturalKey = []string{"name"}
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for post_apply_check combined with natural_key")
+	}
+}
+
+// The rendered resource is compiled with a test creating an object whose create response nests the ID below
+// metadata.object, while the read response has it at the top level
+const createDataPathCreate = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateDataPathCreate(t *testing.T) {
+	objectPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/createdatapaths"
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == objectPath:
+			fmt.Fprint(w, ` + "`" + `{"id": "TASK-1", "metadata": {"object": {"id": "OBJECT-1"}}}` + "`" + `)
+		case r.Method == http.MethodGet && r.URL.Path == objectPath+"/OBJECT-1":
+			fmt.Fprint(w, ` + "`" + `{"id": "OBJECT-1", "name": "NAME1", "description": "My description"}` + "`" + `)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+	r := &CreateDataPathResource{client: client}
+	schema := testResourceSchema(r)
+
+	data := CreateDataPath{Id: types.StringUnknown(), Domain: types.StringNull(), Name: types.StringValue("NAME1"), Description: types.StringValue("My description")}
+	plan := tfsdk.Plan{Schema: schema}
+	plan.Set(ctx, &data)
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var created CreateDataPath
+	resp.State.Get(ctx, &created)
+	if created.Id.ValueString() != "OBJECT-1" {
+		t.Errorf("expected the ID from the create response, got: %s", created.Id.ValueString())
+	}
+}
+`
+
+func TestCreateDataPath(t *testing.T) {
+	config := loadTestConfig(t, "create_data_path.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, createDataPathCreate); err != nil {
+		t.Errorf("taking the ID from the create data path failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "create_data_path.yaml")
+	invalid.NaturalKey = []string{"name"}
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for create_data_path combined with natural_key")
+	}
+}
+
+// The rendered resource is compiled with a test creating two rules of an ordered policy, moving the second rule
+// to the top and changing the action of the first rule
+const moveEndpointUpdate = `package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+func TestMoveRuleUpdate(t *testing.T) {
+	rulesPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/policy/movepolicies/POLICY-1/moverules"
+	var rules, requests []string
+	position := func(id string) int {
+		for i, rule := range rules {
+			if gjson.Get(rule, "id").String() == id {
+				return i
+			}
+		}
+		return -1
+	}
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, rulesPath), "/"), "/")
+		requests = append(requests, r.Method+" "+strings.Join(segments, "/"))
+		index := position(segments[0])
+		switch {
+		case r.Method == http.MethodPost && segments[0] == "":
+			rule, _ := sjson.Set(string(body), "id", fmt.Sprintf("RULE-%d", len(rules)+1))
+			rules = append(rules, rule)
+			fmt.Fprint(w, rule)
+		case r.Method == http.MethodPut && index >= 0 && len(segments) == 2 && segments[1] == "move":
+			before, _ := strconv.Atoi(r.URL.Query().Get("insertBefore"))
+			rule := rules[index]
+			rules = append(rules[:index], rules[index+1:]...)
+			rules = append(rules[:before-1], append([]string{rule}, rules[before-1:]...)...)
+			fmt.Fprint(w, rule)
+		case r.Method == http.MethodPut && index >= 0:
+			rules[index] = string(body)
+			fmt.Fprint(w, rules[index])
+		case r.Method == http.MethodGet && index >= 0:
+			fmt.Fprint(w, rules[index])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+	r := &MoveRuleResource{client: client}
+	schema := testResourceSchema(r)
+
+	apply := func(data, previous MoveRule) MoveRule {
+		plan := tfsdk.Plan{Schema: schema}
+		plan.Set(ctx, &data)
+		if previous.Id.IsNull() {
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			resp.State.Get(ctx, &data)
+			return data
+		}
+		state := tfsdk.State{Schema: schema}
+		state.Set(ctx, &previous)
+		resp := resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		resp.State.Get(ctx, &data)
+		return data
+	}
+	rule := func(name string) MoveRule {
+		return MoveRule{Id: types.StringNull(), Domain: types.StringNull(), MovePolicyId: types.StringValue("POLICY-1"), Name: types.StringValue(name), Action: types.StringValue("ALLOW"), InsertBefore: types.Int64Null()}
+	}
+	order := func() string {
+		var names []string
+		for _, rule := range rules {
+			names = append(names, gjson.Get(rule, "name").String()+":"+gjson.Get(rule, "action").String())
+		}
+		return strings.Join(names, ",")
+	}
+
+	first := apply(rule("RULE1"), MoveRule{Id: types.StringNull()})
+	second := apply(rule("RULE2"), MoveRule{Id: types.StringNull()})
+
+	requests = nil
+	moved := second
+	moved.InsertBefore = types.Int64Value(1)
+	second = apply(moved, second)
+	if order() != "RULE2:ALLOW,RULE1:ALLOW" {
+		t.Errorf("expected the second rule to be moved to the top, got: %s", order())
+	}
+	if strings.Join(requests, ",") != "PUT RULE-2/move" {
+		t.Errorf("expected a single move request, got: %v", requests)
+	}
+	if second.Id.ValueString() != "RULE-2" || second.InsertBefore.ValueInt64() != 1 {
+		t.Errorf("expected the moved rule with its new position in the state, got: %s, %d", second.Id.ValueString(), second.InsertBefore.ValueInt64())
+	}
+
+	requests = nil
+	changed := first
+	changed.Action = types.StringValue("BLOCK")
+	apply(changed, first)
+	if order() != "RULE2:ALLOW,RULE1:BLOCK" {
+		t.Errorf("expected the first rule to be configured in place, got: %s", order())
+	}
+	if strings.Join(requests, ",") != "PUT RULE-1" {
+		t.Errorf("expected a single update request, got: %v", requests)
+	}
+}
+`
+
+func TestMoveEndpoint(t *testing.T) {
+	config := loadTestConfig(t, "move_endpoint.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.MoveEndpoint.Parameter != "insertBefore" {
+		t.Errorf("expected the model_name of the position attribute as parameter, got: %s", config.MoveEndpoint.Parameter)
+	}
+	if out, err := testRenderedResource(t, config, moveEndpointUpdate); err != nil {
+		t.Errorf("moving a rule failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "move_endpoint.yaml")
+	invalid.Attributes[3].RequiresReplace = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for move_endpoint with a position attribute requiring a replacement")
+	}
+	invalid = loadTestConfig(t, "move_endpoint.yaml")
+	invalid.MoveEndpoint.Attribute = "name"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for move_endpoint with a position attribute which is no query_parameter")
+	}
+	invalid = loadTestConfig(t, "move_endpoint.yaml")
+	invalid.NoUpdate = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for move_endpoint combined with no_update")
+	}
+}
+
+// The rendered resource is compiled with a test checking good and bad values of the typed enums
+const typedEnumValues = `package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTypedEnumValues(t *testing.T) {
+	if !TypedEnumAction("ALLOW").IsValid() || !TypedEnumProtocols("UDP").IsValid() {
+		t.Error("expected valid enum values")
+	}
+	if TypedEnumAction("DENY").IsValid() || TypedEnumAction("allow").IsValid() || TypedEnumAction("").IsValid() {
+		t.Error("expected invalid enum values")
+	}
+	if values := strings.Join(TypedEnumAction("").Values(), ","); values != "ALLOW,BLOCK" {
+		t.Errorf("unexpected enum values: %s", values)
+	}
+}
+`
+
+func TestTypedEnum(t *testing.T) {
+	config := loadTestConfig(t, "typed_enum.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, typedEnumValues); err != nil {
+		t.Errorf("checking the typed enum values failed: %v\n%s", err, out)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []string{
+		`stringvalidator.OneOf(TypedEnumAction("").Values()...),`,
+		`listvalidator.ValueStringsAre(stringvalidator.OneOf(TypedEnumProtocols("").Values()...)),`,
+	} {
+		if !strings.Contains(output.String(), s) {
+			t.Errorf("expected '%s' in rendered resource.go", s)
+		}
+	}
+
+	invalid := loadTestConfig(t, "typed_enum.yaml")
+	invalid.Attributes[0].TypedEnum = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for typed_enum without enum_values")
+	}
+}
+
+// The rendered resource is compiled with a test reading a policy whose response has many fields which are not
+// tracked by the resource
+const partialReadRead = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPartialReadRead(t *testing.T) {
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/policy/partialreads/POLICY-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ` + "`" + `{
+		  "id": "POLICY-1", "name": "POLICY1", "type": "PartialRead", "description": "Not tracked",
+		  "defaultAction": {"id": "ACTION-1", "action": "BLOCK", "logBegin": true},
+		  "rules": [
+		    {"id": "RULE-1", "name": "RULE1", "enabled": true, "sourceNetworks": {"objects": [{"id": "NET-1", "name": "NET1", "type": "Network"}]}},
+		    {"id": "RULE-2", "name": "RULE2", "enabled": false}
+		  ],
+		  "metadata": {"domain": {"name": "Global"}},
+		  "links": {"self": "https://fmc/POLICY-1"}
+		}` + "`" + `)
+	})
+	ctx := context.Background()
+	r := &PartialReadResource{client: client}
+	schema := testResourceSchema(r)
+
+	data := PartialRead{Id: types.StringValue("POLICY-1"), Domain: types.StringNull(), Name: types.StringNull(), DefaultAction: types.StringNull()}
+	state := tfsdk.State{Schema: schema}
+	state.Set(ctx, &data)
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var read PartialRead
+	resp.State.Get(ctx, &read)
+	if read.Name.ValueString() != "POLICY1" || read.DefaultAction.ValueString() != "BLOCK" || len(read.Rules) != 2 {
+		t.Fatalf("unexpected object read: %+v", read)
+	}
+	if read.Rules[0].Name.ValueString() != "RULE1" || len(read.Rules[0].SourceNetworks) != 1 || read.Rules[0].SourceNetworks[0].Id.ValueString() != "NET-1" || read.Rules[1].Name.ValueString() != "RULE2" {
+		t.Errorf("unexpected rules read: %+v", read.Rules)
+	}
+}
+`
+
+func TestPartialRead(t *testing.T) {
+	config := loadTestConfig(t, "partial_read.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `res = helpers.Project(res, "id", "name", "defaultAction.action", "rules.#.name", "rules.#.sourceNetworks.objects.#.id", )`
+	if !strings.Contains(output.String(), expected) {
+		t.Errorf("expected '%s' in rendered resource.go", expected)
+	}
+	if out, err := testRenderedResource(t, config, partialReadRead); err != nil {
+		t.Errorf("reading the tracked fields failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "partial_read.yaml")
+	invalid.Attributes[3].MapKeyed = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for partial_read with map_keyed attributes")
+	}
+}
+
+// The rendered resource is compiled with a test placing a rule into a category and section, FMC reports the
+// section capitalized and keeps the placement in the metadata of the rule
+const placementCreate = `package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+func TestPlacementRuleCreate(t *testing.T) {
+	rulesPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/policy/placementpolicies/POLICY-1/placementrules"
+	var rule string
+	var requests []string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.Path, rulesPath)+"?"+r.URL.RawQuery)
+		if gjson.GetBytes(body, "metadata").Exists() {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		place := func() {
+			if category := r.URL.Query().Get("category"); category != "" {
+				rule, _ = sjson.Set(rule, "metadata.category", category)
+			}
+			if section := r.URL.Query().Get("section"); section != "" {
+				rule, _ = sjson.Set(rule, "metadata.section", strings.ToUpper(section[:1])+section[1:])
+			}
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == rulesPath:
+			rule, _ = sjson.Set(string(body), "id", "RULE-1")
+			rule, _ = sjson.Set(rule, "metadata.category", "--Undefined--")
+			rule, _ = sjson.Set(rule, "metadata.section", "Default")
+			place()
+			fmt.Fprint(w, rule)
+		case r.Method == http.MethodPut && r.URL.Path == rulesPath+"/RULE-1":
+			metadata := gjson.Get(rule, "metadata").Raw
+			rule, _ = sjson.SetRaw(string(body), "metadata", metadata)
+			place()
+			fmt.Fprint(w, string(body))
+		case r.Method == http.MethodGet && r.URL.Path == rulesPath+"/RULE-1":
+			fmt.Fprint(w, rule)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+	r := &PlacementRuleResource{client: client}
+	schema := testResourceSchema(r)
+
+	data := PlacementRule{Id: types.StringNull(), Domain: types.StringNull(), PlacementPolicyId: types.StringValue("POLICY-1"), Name: types.StringValue("RULE1"), Action: types.StringValue("ALLOW"), Category: types.StringValue("CAT1"), Section: types.StringValue("mandatory")}
+	plan := tfsdk.Plan{Schema: schema}
+	plan.Set(ctx, &data)
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	if requests[0] != "POST ?category=CAT1&section=mandatory" {
+		t.Errorf("expected the placement as query parameters of the create request, got: %s", requests[0])
+	}
+	var state PlacementRule
+	createResp.State.Get(ctx, &state)
+	if state.Category.ValueString() != "CAT1" || state.Section.ValueString() != "mandatory" {
+		t.Errorf("expected the rule to be read back from category CAT1 and section mandatory, got: %s, %s", state.Category.ValueString(), state.Section.ValueString())
+	}
+
+	requests = nil
+	data = state
+	data.Category = types.StringValue("CAT2")
+	plan.Set(ctx, &data)
+	updateResp := resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: createResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	if requests[0] != "PUT /RULE-1?category=CAT2&section=mandatory" {
+		t.Errorf("expected the new placement as query parameters of the update request, got: %s", requests[0])
+	}
+	updateResp.State.Get(ctx, &state)
+	if state.Category.ValueString() != "CAT2" || state.Section.ValueString() != "mandatory" {
+		t.Errorf("expected the rule to be read back from category CAT2 and section mandatory, got: %s, %s", state.Category.ValueString(), state.Section.ValueString())
+	}
+
+	requests = nil
+	data = state
+	data.Action = types.StringValue("BLOCK")
+	plan.Set(ctx, &data)
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: updateResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	if requests[0] != "PUT /RULE-1?" {
+		t.Errorf("expected no placement for an update keeping the category, got: %s", requests[0])
+	}
+}
+`
+
+func TestPlacement(t *testing.T) {
+	config := loadTestConfig(t, "placement.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, placementCreate); err != nil {
+		t.Errorf("placing a rule failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "placement.yaml")
+	invalid.Attributes[3].WriteOnly = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for a write_only placement attribute")
+	}
+	invalid = loadTestConfig(t, "placement.yaml")
+	invalid.NaturalKey = []string{"name"}
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for placement combined with natural_key")
+	}
+}
+
+// The rendered resource is compiled with a test creating a subinterface without a VLAN, which is assigned by
+// FMC, and planning it again with the unchanged configuration
+const autoAssignedCreate = `package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+func TestAutoSubinterfaceCreate(t *testing.T) {
+	var object string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		switch r.Method {
+		case http.MethodPost:
+			if gjson.GetBytes(body, "vlanId").Exists() && gjson.GetBytes(body, "vlanId").Int() == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			object, _ = sjson.Set(string(body), "id", "SUB-1")
+			if !gjson.GetBytes(body, "vlanId").Exists() {
+				object, _ = sjson.Set(object, "vlanId", 100)
+			}
+			fmt.Fprint(w, object)
+		case http.MethodGet:
+			fmt.Fprint(w, object)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+	r := &AutoSubinterfaceResource{client: client}
+	s := testResourceSchema(r)
+
+	create := func(vlan types.Int64) AutoSubinterface {
+		data := AutoSubinterface{Id: types.StringNull(), Domain: types.StringNull(), Name: types.StringValue("SUB1"), VlanId: vlan}
+		plan := tfsdk.Plan{Schema: s}
+		plan.Set(ctx, &data)
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		resp.State.Get(ctx, &data)
+		return data
+	}
+	if state := create(types.Int64Value(200)); state.VlanId.ValueInt64() != 200 {
+		t.Errorf("expected the configured VLAN to be kept, got: %d", state.VlanId.ValueInt64())
+	}
+	state := create(types.Int64Unknown())
+	if state.VlanId.ValueInt64() != 100 {
+		t.Fatalf("expected the VLAN assigned by FMC in the state, got: %s", state.VlanId)
+	}
+
+	// The unset attribute is planned as unknown again, the plan modifiers keep the assigned value
+	modifyReq := planmodifier.Int64Request{Path: path.Root("vlan_id"), ConfigValue: types.Int64Null(), StateValue: state.VlanId, PlanValue: types.Int64Unknown(), State: tfsdk.State{Schema: s}}
+	modifyReq.State.Set(ctx, &state)
+	modifyResp := planmodifier.Int64Response{PlanValue: modifyReq.PlanValue}
+	for _, m := range s.Attributes["vlan_id"].(schema.Int64Attribute).PlanModifiers {
+		m.PlanModifyInt64(ctx, modifyReq, &modifyResp)
+	}
+	if !modifyResp.PlanValue.Equal(state.VlanId) {
+		t.Errorf("expected no diff for the assigned VLAN, got planned value: %s", modifyResp.PlanValue)
+	}
+
+	readResp := resource.ReadResponse{State: tfsdk.State{Schema: s}}
+	readResp.State.Set(ctx, &state)
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, &readResp)
+	readResp.State.Get(ctx, &state)
+	if state.VlanId.ValueInt64() != 100 {
+		t.Errorf("expected the assigned VLAN to be read back, got: %s", state.VlanId)
+	}
+}
+`
+
+func TestAutoAssigned(t *testing.T) {
+	config := loadTestConfig(t, "auto_assigned.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, autoAssignedCreate); err != nil {
+		t.Errorf("creating an object with an assigned value failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "auto_assigned.yaml")
+	invalid.Attributes[1].Mandatory = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for a mandatory auto_assigned attribute")
+	}
+}
+
+// The rendered resource is compiled with a test creating and updating an object, FMC accepts both requests
+// with warnings in the metadata of the responses
+const surfaceWarningsCreate = `package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/sjson"
+)
+
+func TestWarningObjectCreate(t *testing.T) {
+	var object string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		switch r.Method {
+		case http.MethodPost:
+			object, _ = sjson.Set(string(body), "id", "OBJ-1")
+			res, _ := sjson.Set(object, "metadata.warnings", []interface{}{map[string]string{"description": "Object is not used by any policy"}})
+			w.Write([]byte(res))
+		case http.MethodPut:
+			object = string(body)
+			res, _ := sjson.Set(object, "metadata.warnings", []string{"Description is ignored"})
+			w.Write([]byte(res))
+		default:
+			w.Write([]byte(object))
+		}
+	})
+	ctx := context.Background()
+	r := &WarningObjectResource{client: client}
+	schema := testResourceSchema(r)
+
+	data := WarningObject{Id: types.StringNull(), Domain: types.StringNull(), Name: types.StringValue("OBJ1"), Description: types.StringNull()}
+	plan := tfsdk.Plan{Schema: schema}
+	plan.Set(ctx, &data)
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	if warnings := createResp.Diagnostics.Warnings(); len(warnings) != 1 || warnings[0].Summary() != "FMC Warning" || warnings[0].Detail() != "Object is not used by any policy" {
+		t.Errorf("expected the warning of the create response as diagnostic, got: %v", createResp.Diagnostics)
+	}
+
+	createResp.State.Get(ctx, &data)
+	data.Description = types.StringValue("My object")
+	plan.Set(ctx, &data)
+	updateResp := resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: createResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	if warnings := updateResp.Diagnostics.Warnings(); len(warnings) != 1 || warnings[0].Detail() != "Description is ignored" {
+		t.Errorf("expected the warning of the update response as diagnostic, got: %v", updateResp.Diagnostics)
+	}
+}
+`
+
+func TestSurfaceWarnings(t *testing.T) {
+	config := loadTestConfig(t, "surface_warnings.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, surfaceWarningsCreate); err != nil {
+		t.Errorf("surfacing the warnings failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "surface_warnings.yaml")
+	invalid.NoResource = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for surface_warnings combined with no_resource")
+	}
+}
+
+func TestChangeComment(t *testing.T) {
+	config := loadTestConfig(t, "surface_warnings.yaml")
+	config.ChangeComment = true
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := config
+	invalid.MoveEndpoint = YamlMoveEndpoint{Path: "/move", Attribute: "position"}
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for change_comment combined with move_endpoint")
+	}
+}
+
+func TestSplitFiles(t *testing.T) {
+	config := loadTestConfig(t, "split_files.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var outputs []string
+	for _, tmpl := range templates {
+		if tmpl.rendered(config) && strings.HasPrefix(tmpl.prefix, "./internal/provider/resource_") {
+			outputs = append(outputs, tmpl.prefix+SnakeCase(config.Name)+tmpl.suffix)
+		}
+	}
+	expected := []string{
+		"./internal/provider/resource_fmc_split_object.go",
+		"./internal/provider/resource_fmc_split_object_schema.go",
+		"./internal/provider/resource_fmc_split_object_test.go",
+	}
+	if strings.Join(outputs, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected resource files %v, got: %v", expected, outputs)
+	}
+
+	resource, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	schema, err := executeTemplate("../gen/templates/resource_schema.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(resource.String(), ") Schema(") || !strings.Contains(schema.String(), "func (r *SplitObjectResource) Schema(") {
+		t.Error("expected the schema to be rendered into the schema file only")
+	}
+	if out, err := testRenderedResource(t, config, splitFilesSchema); err != nil {
+		t.Errorf("compiling the split files failed: %v\n%s", err, out)
+	}
+
+	config.SplitFiles = false
+	for _, tmpl := range templates {
+		if tmpl.split && tmpl.rendered(config) {
+			t.Errorf("expected %s not to be rendered without split_files", tmpl.path)
+		}
+	}
+	resource, err = executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(resource.String(), "func (r *SplitObjectResource) Schema(") {
+		t.Error("expected the schema to be rendered into the resource file without split_files")
+	}
+
+	invalid := loadTestConfig(t, "split_files.yaml")
+	invalid.NoResource = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for split_files combined with no_resource")
+	}
+}
+
+// The rendered resource is compiled from the split files with a test reading the schema
+const splitFilesSchema = `package provider
+
+import (
+	"testing"
+)
+
+func TestSplitObjectSchema(t *testing.T) {
+	schema := testResourceSchema(&SplitObjectResource{})
+	if _, ok := schema.Attributes["description"]; !ok {
+		t.Errorf("expected description attribute, got: %v", schema.Attributes)
+	}
+}
+`
+
+func TestRenderDefinitions(t *testing.T) {
+	original := templates
+	t.Cleanup(func() { templates = original })
+	// the second template is missing, so that rendering every definition fails
+	model, missing := original[0], original[0]
+	model.path = "../gen/templates/model.go"
+	missing.path = "./testdata/missing.go"
+	templates = append(original[:0:0], model, missing)
+	var configs []YamlConfig
+	var names []string
+	for i := 0; i < 50; i++ {
+		config := loadTestConfig(t, "surface_warnings.yaml")
+		config.Name = "Object " + strconv.Itoa(i)
+		configs = append(configs, config)
+		names = append(names, "object_"+strconv.Itoa(i)+".yaml")
+	}
+	errs := renderDefinitions(configs, names, true)
+	if len(errs) != len(configs) {
+		t.Fatalf("expected errors of %d definitions, got %d", len(configs), len(errs))
+	}
+	for i, err := range errs {
+		if len(err) != 1 || !strings.Contains(err[0].Error(), "for definition '"+names[i]+"'") {
+			t.Errorf("expected the missing template error of definition %s, got: %v", names[i], err)
+		}
+	}
+
+	templates = templates[:1]
+	for i, err := range renderDefinitions(configs, names, true) {
+		if len(err) != 0 {
+			t.Errorf("unexpected errors of definition %s: %v", names[i], err)
+		}
+	}
+}
+
+func TestMapAttribute(t *testing.T) {
+	config := loadTestConfig(t, "map_attribute.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, name := range []string{"data_source", "data_source_test", "resource_test"} {
+		if err := validateTemplate("../gen/templates/"+name+".go", config); err != nil {
+			t.Errorf("rendered %s is not valid Go: %v", name, err)
+		}
+	}
+	if out, err := testRenderedResource(t, config, mapAttributeRoundTrip); err != nil {
+		t.Errorf("round trip of map attributes failed: %v\n%s", err, out)
+	}
+	if got := TfType(config.Attributes[3]); got != "list(object({flags=map(bool),name=string}))" {
+		t.Errorf("unexpected Terraform type of a list with a map: %s", got)
+	}
+	properties, _ := jsonSchemaProperties(config.Attributes)
+	if got, _ := json.Marshal(properties["weights"]); string(got) != `{"additionalProperties":{"type":"integer"},"description":"Weights of the object.","type":"object"}` {
+		t.Errorf("unexpected JSON schema of a map: %s", got)
+	}
+
+	invalid := loadTestConfig(t, "map_attribute.yaml")
+	invalid.Attributes[1].ElementType = ""
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for map without element_type")
+	}
+	invalid = loadTestConfig(t, "map_attribute.yaml")
+	invalid.Attributes[0].ElementType = "String"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for element_type on a String attribute")
+	}
+	invalid = loadTestConfig(t, "map_attribute.yaml")
+	invalid.Attributes = append(invalid.Attributes, YamlConfigAttribute{ModelName: "rules", TfName: "rules", Type: "List", Attributes: []YamlConfigAttribute{invalid.Attributes[3]}})
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for map nested below the elements of a top-level list")
+	}
+}
+
+// The rendered resource is compiled with a test round-tripping the maps through the model and checking
+// their element types in the schema
+const mapAttributeRoundTrip = `package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+)
+
+func TestMapObjectRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	body := ` + "`" + `{"name":"OBJ1","labels":{"environment":"production","a.b":"c"},"metadata":{"weights":{"primary":10}},"entries":[{"name":"ENTRY1","flags":{"logged":true}}]}` + "`" + `
+	var data MapObject
+	data.fromBody(ctx, gjson.Parse(body))
+	if len(data.Labels.Elements()) != 2 || data.Weights.Elements()["primary"] != types.Int64Value(10) || data.Entries[0].Flags.Elements()["logged"] != types.BoolValue(true) {
+		t.Fatalf("unexpected maps read from %s: %+v", body, data)
+	}
+	output := data.toBody(ctx, MapObject{})
+	for _, path := range []string{"labels", "metadata.weights", "entries.0.flags"} {
+		for key, value := range gjson.Get(body, path).Map() {
+			if gjson.Get(output, path+"."+gjson.Escape(key)).Raw != value.Raw {
+				t.Errorf("expected %s to be written back as %s, got: %s", path, gjson.Get(body, path).Raw, gjson.Get(output, path).Raw)
+			}
+		}
+	}
+
+	data.updateFromBody(ctx, gjson.Parse(` + "`" + `{"name":"OBJ1","labels":{"environment":"staging"}}` + "`" + `))
+	if data.Labels.Elements()["environment"] != types.StringValue("staging") || !data.Weights.IsNull() || !data.Entries[0].Flags.IsNull() {
+		t.Errorf("unexpected maps updated from the response: %+v", data)
+	}
+
+	attributes := testResourceSchema(&MapObjectResource{}).Attributes
+	if attributes["weights"].(schema.MapAttribute).ElementType != types.Int64Type {
+		t.Errorf("expected weights to be a map of Int64, got: %v", attributes["weights"])
+	}
+	entry := attributes["entries"].(schema.ListNestedAttribute).NestedObject.Attributes["flags"]
+	if entry.(schema.MapAttribute).ElementType != types.BoolType {
+		t.Errorf("expected flags to be a map of Bool, got: %v", entry)
+	}
+}
+`
+
+// The rendered resource is compiled with a test creating and updating a host, the metadata computed by FMC
+// is kept in the state but never sent in the request body
+const computedCreate = `package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+func TestComputedHostCreate(t *testing.T) {
+	var object string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			if gjson.GetBytes(body, "metadata").Exists() {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			object, _ = sjson.Set(string(body), "id", "HOST-1")
+			object, _ = sjson.Set(object, "metadata.domain.name", "Global")
+			if strings.Contains(gjson.GetBytes(body, "value").String(), ":") {
+				object, _ = sjson.Set(object, "metadata.ipType", "V_6")
+			} else {
+				object, _ = sjson.Set(object, "metadata.ipType", "V_4")
+			}
+			w.Write([]byte(object))
+		default:
+			w.Write([]byte(object))
+		}
+	})
+	ctx := context.Background()
+	r := &ComputedHostResource{client: client}
+	s := testResourceSchema(r)
+	if attr := s.Attributes["ip_type"].(schema.StringAttribute); !attr.Computed || attr.Optional || attr.Required {
+		t.Errorf("expected ip_type to be computed only, got: %+v", attr)
+	}
+
+	data := ComputedHost{Id: types.StringNull(), Domain: types.StringNull(), Name: types.StringValue("HOST1"), Value: types.StringValue("10.1.1.1"), DomainName: types.StringUnknown(), IpType: types.StringUnknown()}
+	plan := tfsdk.Plan{Schema: s}
+	plan.Set(ctx, &data)
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	createResp.State.Get(ctx, &data)
+	if data.DomainName.ValueString() != "Global" || data.IpType.ValueString() != "V_4" {
+		t.Fatalf("expected the computed metadata in the state, got: %s, %s", data.DomainName, data.IpType)
+	}
+
+	data.Value = types.StringValue("2001:db8::1")
+	data.IpType = types.StringUnknown()
+	plan.Set(ctx, &data)
+	updateResp := resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: createResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	updateResp.State.Get(ctx, &data)
+	if data.IpType.ValueString() != "V_6" {
+		t.Errorf("expected the computed metadata to be read back after the update, got: %s", data.IpType)
+	}
+}
+`
+
+func TestComputed(t *testing.T) {
+	config := loadTestConfig(t, "computed.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, computedCreate); err != nil {
+		t.Errorf("creating an object with computed attributes failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "computed.yaml")
+	invalid.Attributes[3].Mandatory = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for a mandatory computed attribute")
+	}
+	nested := loadTestConfig(t, "computed.yaml")
+	nested.Attributes = append(nested.Attributes, YamlConfigAttribute{TfName: "entries", ModelName: "entries", Type: "List", Attributes: []YamlConfigAttribute{{TfName: "type", ModelName: "type", Type: "String", Computed: true}}})
+	if err := validateConfig(nested); err == nil {
+		t.Error("expected error for a computed attribute of list elements")
+	}
+}
+
+// The rendered bulk resource is compiled into the provider package with a test creating two objects and
+// destroying them with a bulk delete task which fails to delete one of them
+const bulkResource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestBulkCreateDelete(t *testing.T) {
+	interval := helpers.TaskPollInterval
+	helpers.TaskPollInterval = 0
+	t.Cleanup(func() { helpers.TaskPollInterval = interval })
+
+	var requests []string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s?%s %s", r.Method, r.URL.Path, r.URL.RawQuery, b))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost:
+			fmt.Fprint(w, ` + "`" + `{"items": [{"id": "ID1", "name": "NAME1"}, {"id": "ID2", "name": "NAME2"}]}` + "`" + `)
+		case r.Method == http.MethodDelete:
+			fmt.Fprint(w, ` + "`" + `{"metadata": {"task": {"id": "TASK1"}}}` + "`" + `)
+		case r.URL.Path == "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/job/taskstatuses/TASK1":
+			fmt.Fprint(w, ` + "`" + `{"status": "FAILED", "message": "NAME2 is in use", "subTasks": [{"status": "SUCCESS"}, {"status": "FAILED"}]}` + "`" + `)
+		default:
+			fmt.Fprint(w, ` + "`" + `{"items": [{"id": "ID2", "name": "NAME2"}, {"id": "ID3", "name": "OTHER"}]}` + "`" + `)
+		}
+	})
+
+	ctx := context.Background()
+	r := &BulkBulkResource{client: client, clients: helpers.NewDomainClients()}
+	s := testResourceSchema(r)
+	plan := tfsdk.Plan{Schema: s}
+	plan.Set(ctx, BulkBulk{Id: types.StringUnknown(), Domain: types.StringNull(), Items: []BulkBulkItems{
+		{Id: types.StringUnknown(), Name: types.StringValue("NAME1"), Description: types.StringValue("My object")},
+		{Id: types.StringUnknown(), Name: types.StringValue("NAME2"), Description: types.StringNull()},
+	}})
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	expected := ` + "`" + `POST /api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/bulks?bulk=true [{"name":"NAME1","description":"My object"},{"name":"NAME2"}]` + "`" + `
+	if len(requests) != 1 || requests[0] != expected {
+		t.Errorf("expected a single bulk create, got requests: %q", requests)
+	}
+	var created BulkBulk
+	createResp.State.Get(ctx, &created)
+	if created.Id.ValueString() != "ID1" || len(created.Items) != 2 || created.Items[1].Id.ValueString() != "ID2" {
+		t.Fatalf("expected the IDs of the created objects, got: %+v", created)
+	}
+
+	requests = nil
+	resp := resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for the object which failed to delete")
+	}
+	if len(requests) < 3 || requests[0] != "DELETE /api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/bulks?bulk=true&filter=ids%3AID1%2CID2 " {
+		t.Errorf("expected a single bulk delete followed by polling the task, got requests: %q", requests)
+	}
+	var state BulkBulk
+	resp.State.Get(ctx, &state)
+	if len(state.Items) != 1 || state.Items[0].Id.ValueString() != "ID2" || state.Items[0].Name.ValueString() != "NAME2" {
+		t.Errorf("expected only the object which failed to delete to stay in the state, got: %+v", state.Items)
+	}
+}
+`
+
+func TestBulk(t *testing.T) {
+	config := loadTestConfig(t, "bulk.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, bulkResource); err != nil {
+		t.Errorf("bulk test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "bulk.yaml")
+	invalid.Attributes[0].TfName = "title"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for bulk without a name attribute")
+	}
+	invalid = loadTestConfig(t, "bulk.yaml")
+	invalid.Attributes[1].Reference = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for bulk with a reference attribute")
+	}
+}
+
+// The rendered model is compiled with a test round-tripping a single value and a list through the body
+const scalarOrListRoundTrip = `package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestScalarOrListRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	for _, body := range []string{
+		` + "`" + `{"name":"NAME1","port":"443","entries":[{"value":"10.1.1.1"}]}` + "`" + `,
+		` + "`" + `{"name":"NAME1","port":["443","8443"],"entries":[{"value":["10.1.1.1","10.1.1.2"]}]}` + "`" + `,
+	} {
+		var data ScalarOrList
+		data.fromBody(ctx, gjson.Parse(body))
+		if len(data.Ports.Elements()) != len(gjson.Get(body, "port").Array()) || len(data.Entries) != 1 {
+			t.Fatalf("unexpected values read from %s: %+v", body, data)
+		}
+		output := data.toBody(ctx, ScalarOrList{})
+		for _, path := range []string{"port", "entries"} {
+			if gjson.Get(output, path).Raw != gjson.Get(body, path).Raw {
+				t.Errorf("expected %s to be written back as %s, got: %s", path, gjson.Get(body, path).Raw, gjson.Get(output, path).Raw)
+			}
+		}
+	}
+}
+`
+
+func TestScalarOrList(t *testing.T) {
+	config := loadTestConfig(t, "scalar_or_list.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedModel(t, config, scalarOrListRoundTrip); err != nil {
+		t.Errorf("round trip of scalar_or_list attribute failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "scalar_or_list.yaml")
+	invalid.Attributes[0].ScalarOrList = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for scalar_or_list on a String attribute")
+	}
+}
+
+// The rendered resource is compiled with a test updating an object, changing an attribute which can not be
+// updated recreates the object within the update
+const recreateOnChangeUpdate = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRecreateOnChangeUpdate(t *testing.T) {
+	var requests []string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/recreateonchanges"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ` + "`" + `{"id": "OBJECT-2", "name": "NAME1", "subType": "RANGE"}` + "`" + `)
+	})
+	ctx := context.Background()
+	r := &RecreateOnChangeResource{client: client}
+	schema := testResourceSchema(r)
+
+	tests := []struct {
+		name     string
+		subType  string
+		id       string
+		requests string
+	}{
+		{"unchanged sub_type", "HOST", "OBJECT-1", "PUT /OBJECT-1"},
+		{"changed sub_type", "RANGE", "OBJECT-2", "DELETE /OBJECT-1,POST "},
+	}
+	for _, tt := range tests {
+		requests = nil
+		prior := RecreateOnChange{Id: types.StringValue("OBJECT-1"), Domain: types.StringNull(), Name: types.StringValue("NAME1"), SubType: types.StringValue("HOST")}
+		state := tfsdk.State{Schema: schema}
+		state.Set(ctx, &prior)
+		planned := prior
+		planned.Name = types.StringValue("NAME2")
+		planned.SubType = types.StringValue(tt.subType)
+		if tt.subType != prior.SubType.ValueString() {
+			planned.Id = types.StringUnknown()
+		}
+		plan := tfsdk.Plan{Schema: schema}
+		plan.Set(ctx, &planned)
+
+		resp := resource.UpdateResponse{State: tfsdk.State{Schema: schema, Raw: plan.Raw.Copy()}}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected error: %v", tt.name, resp.Diagnostics)
+		}
+		if strings.Join(requests, ",") != tt.requests {
+			t.Errorf("%s: expected requests '%s', got: %v", tt.name, tt.requests, requests)
+		}
+		var updated RecreateOnChange
+		resp.State.Get(ctx, &updated)
+		if updated.Id.ValueString() != tt.id || updated.Name.ValueString() != "NAME2" {
+			t.Errorf("%s: expected object %s named NAME2, got: %s %s", tt.name, tt.id, updated.Id.ValueString(), updated.Name.ValueString())
+		}
+	}
+}
+`
+
+func TestRecreateOnChange(t *testing.T) {
+	config := loadTestConfig(t, "recreate_on_change.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, recreateOnChangeUpdate); err != nil {
+		t.Errorf("recreating the object within the update failed: %v\n%s", err, out)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil || !strings.Contains(output.String(), `helpers.UnknownOnChange(path.Root("sub_type"), ),`) {
+		t.Errorf("expected unknown id on changes of sub_type, got: %v", err)
+	}
+
+	invalid := loadTestConfig(t, "recreate_on_change.yaml")
+	invalid.Attributes[1].RequiresReplace = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for recreate_on_change combined with requires_replace")
+	}
+	invalid = loadTestConfig(t, "recreate_on_change.yaml")
+	invalid.NoDelete = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for recreate_on_change combined with no_delete")
+	}
+	invalid = loadTestConfig(t, "recreate_on_change.yaml")
+	invalid.Attributes = append(invalid.Attributes, YamlConfigAttribute{ModelName: "entries", TfName: "entries", Type: "List", Attributes: []YamlConfigAttribute{invalid.Attributes[1]}})
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for nested recreate_on_change")
+	}
+}
+
+// The rendered model is compiled with a test distinguishing false from an unset boolean in both directions
+const triStateBodies = `package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+)
+
+func TestTriStateBodies(t *testing.T) {
+	ctx := context.Background()
+	data := TriState{Name: types.StringValue("NAME1"), Enabled: types.BoolValue(false)}
+	if value := gjson.Get(data.toBody(ctx, TriState{}), "enabled"); value.Type != gjson.False {
+		t.Errorf("expected false to be sent, got: %s", value.Raw)
+	}
+	data.Enabled = types.BoolNull()
+	if value := gjson.Get(data.toBody(ctx, TriState{}), "enabled"); value.Exists() {
+		t.Errorf("expected unset value to be omitted, got: %s", value.Raw)
+	}
+
+	for body, expected := range map[string]types.Bool{
+		` + "`" + `{"enabled":false,"rules":[{"name":"RULE1","logging":false}]}` + "`" + `: types.BoolValue(false),
+		` + "`" + `{"enabled":null,"rules":[{"name":"RULE1","logging":null}]}` + "`" + `:   types.BoolNull(),
+		` + "`" + `{"rules":[{"name":"RULE1"}]}` + "`" + `:                                  types.BoolNull(),
+	} {
+		var data TriState
+		data.fromBody(ctx, gjson.Parse(body))
+		if !data.Enabled.Equal(expected) || !data.Rules[0].Logging.Equal(expected) {
+			t.Errorf("expected %s to be read as %s, got: %s, %s", body, expected, data.Enabled, data.Rules[0].Logging)
+		}
+	}
+
+	state := TriState{Enabled: types.BoolValue(true), Rules: []TriStateRules{{Name: types.StringValue("RULE1"), Logging: types.BoolValue(true)}}}
+	state.updateFromBody(ctx, gjson.Parse(` + "`" + `{"enabled":null,"rules":[{"name":"RULE1","logging":null}]}` + "`" + `))
+	if !state.Enabled.IsNull() || !state.Rules[0].Logging.IsNull() {
+		t.Errorf("expected null values to be read as null, got: %s, %s", state.Enabled, state.Rules[0].Logging)
+	}
+}
+`
+
+func TestTriState(t *testing.T) {
+	config := loadTestConfig(t, "tri_state.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedModel(t, config, triStateBodies); err != nil {
+		t.Errorf("tri-state boolean test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "tri_state.yaml")
+	invalid.Attributes[0].TriState = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for tri_state on a String attribute")
+	}
+	invalid = loadTestConfig(t, "tri_state.yaml")
+	invalid.Attributes[1].DefaultValue = "false"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for tri_state with default_value")
+	}
+}
+
+// The rendered resource is compiled into the provider package with a test destroying an object
+const softDeleteResource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSoftDeleteDestroy(t *testing.T) {
+	var requests []string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, b))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ` + "`{}`" + `)
+	})
+
+	ctx := context.Background()
+	r := &SoftDeleteResource{client: client}
+	s := testResourceSchema(r)
+	state := tfsdk.State{Schema: s}
+	state.Set(ctx, SoftDelete{Id: types.StringValue("ID1"), Domain: types.StringNull(), Name: types.StringValue("NAME1"), Enabled: types.BoolValue(true)})
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	expected := ` + "`" + `PUT /api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/softdeletes/ID1 {"id":"ID1","name":"NAME1","enabled":false}` + "`" + `
+	if len(requests) != 1 || requests[0] != expected {
+		t.Errorf("expected the object to be disabled instead of deleted, got requests: %q", requests)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the resource to be removed from the state")
+	}
+}
+`
+
+func TestSoftDelete(t *testing.T) {
+	config := loadTestConfig(t, "soft_delete.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, softDeleteResource); err != nil {
+		t.Errorf("soft delete test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "soft_delete.yaml")
+	invalid.SoftDelete = "name"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for soft_delete referencing a String attribute")
+	}
+	invalid = loadTestConfig(t, "soft_delete.yaml")
+	invalid.NoDelete = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for soft_delete combined with no_delete")
+	}
+}
+
+// The rendered resource is compiled with a test changing the members, once with PATCH requests supported and
+// once falling back to configuring the whole object
+const deltaUpdateUpdate = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeltaUpdateUpdate(t *testing.T) {
+	var requests []string
+	patchStatus := http.StatusOK
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.RequestURI(), "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/deltaupdates"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "PATCH" {
+			w.WriteHeader(patchStatus)
+		}
+		fmt.Fprint(w, ` + "`" + `{"id": "OBJECT-1", "name": "NAME1"}` + "`" + `)
+	})
+	ctx := context.Background()
+	r := &DeltaUpdateResource{client: client}
+	schema := testResourceSchema(r)
+
+	tests := []struct {
+		name        string
+		rename      bool
+		patchStatus int
+		requests    string
+	}{
+		{"patch supported", false, http.StatusOK, "PATCH /OBJECT-1?action=add,PATCH /OBJECT-1?action=remove"},
+		{"patch not supported", false, http.StatusMethodNotAllowed, "PATCH /OBJECT-1?action=add,PUT /OBJECT-1"},
+		{"name changed", true, http.StatusOK, "PUT /OBJECT-1"},
+	}
+	for _, tt := range tests {
+		requests = nil
+		patchStatus = tt.patchStatus
+		prior := DeltaUpdate{Id: types.StringValue("OBJECT-1"), Domain: types.StringNull(), Name: types.StringValue("NAME1"), Objects: []DeltaUpdateObjects{{Id: types.StringValue("NETWORK-1")}, {Id: types.StringValue("NETWORK-2")}}}
+		state := tfsdk.State{Schema: schema}
+		state.Set(ctx, &prior)
+		planned := prior
+		planned.Objects = []DeltaUpdateObjects{{Id: types.StringValue("NETWORK-1")}, {Id: types.StringValue("NETWORK-3")}}
+		if tt.rename {
+			planned.Name = types.StringValue("NAME2")
+		}
+		plan := tfsdk.Plan{Schema: schema}
+		plan.Set(ctx, &planned)
+
+		resp := resource.UpdateResponse{State: tfsdk.State{Schema: schema, Raw: plan.Raw.Copy()}}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected error: %v", tt.name, resp.Diagnostics)
+		}
+		if strings.Join(requests, ",") != tt.requests {
+			t.Errorf("%s: expected requests '%s', got: %v", tt.name, tt.requests, requests)
+		}
+	}
+}
+`
+
+func TestDeltaUpdate(t *testing.T) {
+	config := loadTestConfig(t, "delta_update.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, deltaUpdateUpdate); err != nil {
+		t.Errorf("changing the members within the update failed: %v\n%s", err, out)
+	}
+}
+
+func TestValidateDeltaUpdate(t *testing.T) {
+	members := YamlConfigAttribute{ModelName: "objects", TfName: "objects", Type: "List", DeltaUpdate: true, Attributes: []YamlConfigAttribute{{ModelName: "id", TfName: "id", Type: "String", Id: true}}}
+	noId := members
+	noId.Attributes = []YamlConfigAttribute{{ModelName: "name", TfName: "name", Type: "String"}}
+	tests := []struct {
+		config YamlConfig
+		err    string
+	}{
+		{YamlConfig{Name: "Network Group", Attributes: []YamlConfigAttribute{members}}, ""},
+		{YamlConfig{Name: "Network Group", Attributes: []YamlConfigAttribute{noId}}, "delta_update is only supported for attributes of type List or Set"},
+		{YamlConfig{Name: "Network Group", NoUpdate: true, Attributes: []YamlConfigAttribute{members}}, "delta_update can not be combined with no_update"},
+		{YamlConfig{Name: "Network Group", Attributes: []YamlConfigAttribute{members, members}}, "only a single attribute can use delta_update"},
+	}
+	for i, tt := range tests {
+		err := validateConfig(tt.config)
+		if (err == nil) != (tt.err == "") || (err != nil && !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("case %d: expected error '%s', got: %v", i, tt.err, err)
+		}
+	}
+}
+
+func TestValidateDataSourceAllPages(t *testing.T) {
+	items := YamlConfigAttribute{ModelName: "items", TfName: "devices", Type: "List", Attributes: []YamlConfigAttribute{{ModelName: "name", TfName: "name", Type: "String"}}}
+	nested := items
+	nested.DataPath = []string{"data"}
+	tests := []struct {
+		config YamlConfig
+		err    bool
+	}{
+		{YamlConfig{Name: "Devices", RestEndpoint: "/devices?expanded=true", DataSourceNoId: true, DataSourceAllPages: true, Attributes: []YamlConfigAttribute{items}}, false},
+		{YamlConfig{Name: "Devices", RestEndpoint: "/devices", DataSourceAllPages: true, Attributes: []YamlConfigAttribute{items}}, true},
+		{YamlConfig{Name: "Devices", RestEndpoint: "/devices", DataSourceNoId: true, DataSourceAllPages: true, Attributes: []YamlConfigAttribute{nested}}, true},
+		{YamlConfig{Name: "Devices", RestEndpoint: "/devices?limit=25", DataSourceNoId: true, DataSourceAllPages: true, Attributes: []YamlConfigAttribute{items}}, true},
+	}
+	for i, tt := range tests {
+		if err := validateConfig(tt.config); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+}
+
+func TestValidateExistsEndpoint(t *testing.T) {
+	device := YamlConfigAttribute{TfName: "device_id", Type: "String", Reference: true}
+	name := YamlConfigAttribute{ModelName: "name", TfName: "name", Type: "String", ExistsEndpoint: "/devices/%v/physicalinterfaces"}
+	tests := []struct {
+		config YamlConfig
+		err    bool
+	}{
+		{YamlConfig{Name: "Interface", Attributes: []YamlConfigAttribute{device, name}}, false},
+		{YamlConfig{Name: "Interface", Attributes: []YamlConfigAttribute{name}}, true},
+		{YamlConfig{Name: "Interface", Attributes: []YamlConfigAttribute{device, {ModelName: "name", TfName: "name", Type: "String", ExistsEndpoint: "/devices/{device_type}/%v"}}}, true},
+		{YamlConfig{Name: "Interface", Attributes: []YamlConfigAttribute{device, {ModelName: "mtu", TfName: "mtu", Type: "Int64", ExistsEndpoint: "/devices/%v/physicalinterfaces"}}}, true},
+	}
+	for i, tt := range tests {
+		if err := validateConfig(tt.config); (err != nil) != tt.err {
+			t.Errorf("case %d: expected error %v, got: %v", i, tt.err, err)
+		}
+	}
+
+	config := YamlConfig{Name: "Interface", Attributes: []YamlConfigAttribute{device, name}}
+	augmentConfig(&config)
+	if config.Attributes[1].ExistsField != "name" {
+		t.Errorf("expected default exists_field 'name', got: %s", config.Attributes[1].ExistsField)
+	}
+}
+
+func TestWriteOrder(t *testing.T) {
+	config := loadTestConfig(t, "write_order.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := validateTemplate("../gen/templates/model.go", config); err != nil {
+		t.Fatalf("rendered model is not valid Go: %v", err)
+	}
+	output, err := executeTemplate("../gen/templates/model.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	toBody := getTemplateSection(output.String(), "toBody")
+	expected := []string{
+		`body, _ = sjson.Set(body, "id"`,
+		`body, _ = sjson.Set(body, "type", "WriteOrder")`,
+		`body, _ = sjson.Set(body, "mode"`,
+		`body, _ = sjson.Set(body, "name"`,
+		`body, _ = sjson.Set(body, "description"`,
+		`itemBody, _ = sjson.Set(itemBody, "kind"`,
+		`itemBody, _ = sjson.Set(itemBody, "value"`,
+	}
+	last := -1
+	for _, call := range expected {
+		i := strings.Index(toBody, call)
+		if i < 0 {
+			t.Fatalf("expected '%s' in toBody, got:\n%s", call, toBody)
+		}
+		if i < last {
+			t.Errorf("expected '%s' after the previous set calls, got:\n%s", call, toBody)
+		}
+		last = i
+	}
+
+	invalid := loadTestConfig(t, "write_order.yaml")
+	invalid.Attributes[1].WriteOrder = -1
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for negative write_order")
+	}
+}
+
+func TestExperimental(t *testing.T) {
+	config := loadTestConfig(t, "skip_read_after_create.yaml")
+	config.Experimental = true
+	for _, tmpl := range []struct {
+		path, expected string
+	}{
+		{"../gen/templates/resource.go", `MarkdownDescription: helpers.NewAttributeDescription("This resource can manage a Skip Read After Create.").AddExperimentalDescription("resource").String,`},
+		{"../gen/templates/data_source.go", `MarkdownDescription: helpers.NewAttributeDescription("This data source can read the Skip Read After Create.").AddExperimentalDescription("data source").String,`},
+	} {
+		if err := validateTemplate(tmpl.path, config); err != nil {
+			t.Fatalf("rendered template %s is not valid Go: %v", tmpl.path, err)
+		}
+		output, err := executeTemplate(tmpl.path, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(output.String(), tmpl.expected) {
+			t.Errorf("expected the experimental note in the schema description of %s, got:\n%s", tmpl.path, getTemplateSection(output.String(), "model"))
+		}
+	}
+
+	config.Experimental = false
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output.String(), "AddExperimentalDescription") {
+		t.Error("expected no experimental note without experimental")
+	}
+}
+
+func TestValidateEnumValues(t *testing.T) {
+	tests := []struct {
+		attribute YamlConfigAttribute
+		err       string
+	}{
+		{YamlConfigAttribute{TfName: "protocols", Type: "StringList", EnumValues: []string{"TCP", "UDP"}, UniqueValues: true}, ""},
+		{YamlConfigAttribute{TfName: "port", Type: "Int64", EnumValues: []string{"80", "443"}}, "enum_values is only supported for types String and StringList"},
+		{YamlConfigAttribute{TfName: "protocol", Type: "String", UniqueValues: true}, "unique_values is only supported for type StringList"},
+	}
+	for i, tt := range tests {
+		err := validateConfig(YamlConfig{Name: "Test", Attributes: []YamlConfigAttribute{tt.attribute}})
+		if (err == nil) != (tt.err == "") || (err != nil && !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("case %d: expected error '%s', got: %v", i, tt.err, err)
+		}
+	}
+}
+
+func TestHasTags(t *testing.T) {
+	config := YamlConfig{Name: "Host", HasTags: true, Attributes: []YamlConfigAttribute{{ModelName: "name", Type: "String", Mandatory: true}}}
+	augmentConfig(&config)
+	if len(config.Attributes) != 2 || config.Attributes[1].TfName != "tags" || !config.Attributes[1].Attributes[0].Id {
+		t.Fatalf("expected a tags attribute identified by name, got: %+v", config.Attributes)
+	}
+	if err := validateConfig(config); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	augmentConfig(&config)
+	if len(config.Attributes) != 2 {
+		t.Errorf("expected the tags attribute to be added only once, got %d attributes", len(config.Attributes))
+	}
+}
+
+func TestReservedNames(t *testing.T) {
+	config := YamlConfig{Name: "Host", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/hosts", ReservedNames: []string{"internal"}, Attributes: []YamlConfigAttribute{{ModelName: "name", Type: "String", Mandatory: true}}}
+	augmentConfig(&config)
+	if !config.Attributes[0].CheckReservedNames {
+		t.Fatal("expected reserved names to be checked for the name attribute")
+	}
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", config)
+	if err != nil || !strings.Contains(output.String(), `helpers.ReservedNamesValidator("internal", )`) {
+		t.Errorf("expected reserved names validator with additional name, got error: %v", err)
+	}
+
+	invalid := YamlConfig{Name: "Host", CheckReservedNames: true, Attributes: []YamlConfigAttribute{{ModelName: "value", Type: "String"}}}
+	augmentConfig(&invalid)
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for check_reserved_names without name attribute")
+	}
+	invalid = YamlConfig{Name: "Host", ReservedNames: []string{" "}, Attributes: []YamlConfigAttribute{{ModelName: "name", Type: "String"}}}
+	augmentConfig(&invalid)
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for empty reserved name")
+	}
+}
+
+func TestRelatedResources(t *testing.T) {
+	network := YamlConfig{Name: "Network", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks"}
+	group := YamlConfig{
+		Name:              "Network Group",
+		RestEndpoint:      "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networkgroups",
+		TestPrerequisites: `resource "fmc_network" "test" {}`,
+		RelatedResources:  []YamlRelatedResource{{Resource: "Network", Attribute: "objects.0.id", Checks: map[string]string{"objects.0.name": "name"}}},
+		Attributes:        []YamlConfigAttribute{{ModelName: "objects", Type: "List"}},
+	}
+	augmentConfig(&group)
+	related, err := relatedResources(group, []YamlConfig{network, group})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(related) != 1 || related[0].Endpoint != network.RestEndpoint {
+		t.Errorf("expected the endpoint of the related resource to be resolved, got: %+v", related)
+	}
+	group.RelatedResources = related
+	output, err := executeTemplate("../gen/templates/resource_test.go", group)
+	if err != nil || !strings.Contains(output.String(), `resource.TestCheckResourceAttrPair("fmc_network_group.test", "objects.0.name", "fmc_network.test", "name")`) {
+		t.Errorf("expected related resource checks in the rendered test, got error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*YamlConfig)
+	}{
+		{"unknown resource", func(c *YamlConfig) { c.RelatedResources[0].Resource = "Host" }},
+		{"missing prerequisite", func(c *YamlConfig) { c.TestPrerequisites = "" }},
+		{"unknown attribute", func(c *YamlConfig) { c.RelatedResources[0].Attribute = "members.0.id" }},
+		{"excluded test", func(c *YamlConfig) { c.ExcludeTest = true }},
+	}
+	for _, tt := range tests {
+		invalid := group
+		invalid.RelatedResources = []YamlRelatedResource{group.RelatedResources[0]}
+		tt.modify(&invalid)
+		if _, err := relatedResources(invalid, []YamlConfig{network, invalid}); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestLogRedactPattern(t *testing.T) {
+	config := loadTestConfig(t, "log_redact_pattern.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `.Logger.WithRedaction("://[^/:@]*:([^/@]*)@", "token=(\\w+)")`
+	for _, name := range []string{"resource", "data_source"} {
+		output, err := executeTemplate("../gen/templates/"+name+".go", config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		formatted, err := imports.Process(name+".go", output.Bytes(), nil)
+		if err != nil {
+			t.Fatalf("rendered %s is invalid: %v", name, err)
+		}
+		if !strings.Contains(string(formatted), expected) {
+			t.Errorf("expected %s to redact the distinct patterns with %s", name, expected)
+		}
+		// The client logs the payloads itself, which is disabled for every request
+		if strings.Contains(string(formatted), "reqMods := [](func(*fmc.Req)){}") || !strings.Contains(string(formatted), "reqMods := [](func(*fmc.Req)){fmc.NoLogPayload}") {
+			t.Errorf("expected all requests of %s to disable the payload logging of the client", name)
+		}
+	}
+	output, err := executeTemplate("../gen/templates/resource.go", loadTestConfig(t, "soft_delete.yaml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output.String(), "NoLogPayload") {
+		t.Error("expected the payloads of a definition without log_redact_pattern to be logged")
+	}
+
+	invalid := loadTestConfig(t, "log_redact_pattern.yaml")
+	invalid.Attributes[1].LogRedactPattern = "token=("
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for invalid log_redact_pattern")
+	}
+	invalid = loadTestConfig(t, "log_redact_pattern.yaml")
+	invalid.Attributes[2].LogRedactPattern = "token"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for log_redact_pattern on a List attribute")
+	}
+}
+
+// The rendered resource is compiled into the provider package with a test parsing the create request
+const multipartResource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestMultipartUploadCreate(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "upload.txt")
+	os.WriteFile(file, []byte("CONTENT1"), 0600)
+
+	var fields map[string][]string
+	var filename, content string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.ParseMultipartForm(1<<20) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fields = r.MultipartForm.Value
+		if files := r.MultipartForm.File["payloadFile"]; len(files) == 1 {
+			filename = files[0].Filename
+			f, _ := files[0].Open()
+			b, _ := io.ReadAll(f)
+			content = string(b)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ` + "`" + `{"id": "ID1"}` + "`" + `)
+	})
+
+	ctx := context.Background()
+	r := &MultipartUploadResource{client: client, clients: helpers.NewDomainClients()}
+	s := testResourceSchema(r)
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	req.Plan.Set(ctx, MultipartUpload{
+		Id:       types.StringUnknown(),
+		Domain:   types.StringNull(),
+		Name:     types.StringValue("NAME1"),
+		Priority: types.Int64Value(10),
+		Labels:   helpers.StringListValue("LABEL1", "LABEL2"),
+		FilePath: types.StringValue(file),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if fmt.Sprint(fields) != "map[labels:[LABEL1 LABEL2] name:[NAME1] priority:[10] type:[Upload]]" {
+		t.Errorf("expected form fields, got: %v", fields)
+	}
+	if filename != "upload.txt" || content != "CONTENT1" {
+		t.Errorf("expected file part upload.txt, got: %s, %s", filename, content)
+	}
+	var state MultipartUpload
+	resp.State.Get(ctx, &state)
+	if state.Id.ValueString() != "ID1" || state.FilePath.ValueString() != file {
+		t.Errorf("unexpected state: %s, %s", state.Id, state.FilePath)
+	}
+}
+`
+
+func TestMultipart(t *testing.T) {
+	config := loadTestConfig(t, "multipart.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, multipartResource); err != nil {
+		t.Errorf("multipart test failed: %v\n%s", err, out)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*YamlConfig)
+	}{
+		{"invalid content type", func(c *YamlConfig) { c.ContentType = "xml" }},
+		{"update", func(c *YamlConfig) { c.NoUpdate = false }},
+		{"no file", func(c *YamlConfig) { c.Attributes[4].Multipart = "field" }},
+		{"file read back", func(c *YamlConfig) { c.Attributes[4].WriteOnly = false }},
+		{"data path", func(c *YamlConfig) { c.Attributes[2].DataPath = []string{"settings"} }},
+		{"json content", func(c *YamlConfig) { c.ContentType = "" }},
+	}
+	for _, tt := range tests {
+		invalid := loadTestConfig(t, "multipart.yaml")
+		tt.modify(&invalid)
+		if err := validateConfig(invalid); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+// The rendered model is compiled with a test adding the query parameters to a request
+const queryParameterRequest = `package provider
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+func TestQueryParameterRequest(t *testing.T) {
+	data := QueryParameter{Name: types.StringValue("NAME1"), InsertBefore: types.Int64Value(2), Section: types.StringNull()}
+	req := fmc.Req{HttpReq: httptest.NewRequest("POST", "/object/queryparameters?ignoreWarnings=true", nil)}
+	data.setQueryParameters(&req)
+	if req.HttpReq.URL.RawQuery != "ignoreWarnings=true&insertBefore=2" {
+		t.Errorf("unexpected query: %s", req.HttpReq.URL.RawQuery)
+	}
+	if body := data.toBody(context.Background(), QueryParameter{}); gjson.Get(body, "insertBefore").Exists() {
+		t.Errorf("expected query parameters to be omitted from the body, got: %s", body)
+	}
+}
+`
+
+func TestQueryParameter(t *testing.T) {
+	config := loadTestConfig(t, "query_parameter.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedModel(t, config, queryParameterRequest); err != nil {
+		t.Errorf("query parameter test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "query_parameter.yaml")
+	invalid.Attributes[1].WriteOnly = false
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for query_parameter without write_only")
+	}
+	invalid = loadTestConfig(t, "query_parameter.yaml")
+	invalid.PutCreate = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for query_parameter combined with put_create")
+	}
+}
+
+func TestMinimumVersion(t *testing.T) {
+	config := loadTestConfig(t, "minimum_version.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, name := range []string{"resource_test", "data_source_test"} {
+		output, err := executeTemplate("../gen/templates/"+name+".go", config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(output.String(), `PreCheck:                 func() { testAccPreCheckMinimumVersion(t, "7.4") },`) {
+			t.Errorf("expected %s to check the minimum version", name)
+		}
+		if name == "resource_test" && strings.Contains(output.String(), `os.Getenv("SKIP_MINIMUM_TEST")`) {
+			t.Errorf("expected %s to honor skip_minimum_test", name)
+		}
+	}
+
+	config.MinimumVersion = ""
+	output, err := executeTemplate("../gen/templates/resource_test.go", config)
+	if err != nil || strings.Contains(output.String(), "testAccPreCheckMinimumVersion") {
+		t.Errorf("expected no minimum version check without minimum_version, got error: %v", err)
+	}
+
+	invalid := loadTestConfig(t, "minimum_version.yaml")
+	invalid.MinimumVersion = "7.4-beta"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for invalid minimum_version")
+	}
+}
+
+// The rendered resource is compiled into the provider package with a test reading an object with a new ID
+const trackByNameResource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestTrackByNameRead(t *testing.T) {
+	objects := map[string]string{
+		"ID2": ` + "`" + `{"id": "ID2", "name": "NAME1", "description": "Changed"}` + "`" + `,
+		"ID3": ` + "`" + `{"id": "ID3", "name": "NAME3"}` + "`" + `,
+	}
+	path := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/trackbynames"
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == path {
+			fmt.Fprintf(w, ` + "`" + `{"items": [%s, %s]}` + "`" + `, objects["ID3"], objects["ID2"])
+			return
+		}
+		if object, ok := objects[r.URL.Path[len(path)+1:]]; ok {
+			fmt.Fprint(w, object)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, ` + "`" + `{"error": {"messages": [{"description": "Not found"}]}}` + "`" + `)
+	})
+
+	ctx := context.Background()
+	r := &TrackByNameResource{client: client, clients: helpers.NewDomainClients()}
+	s := testResourceSchema(r)
+	for _, tt := range []struct {
+		name     string
+		expected string
+	}{
+		{"NAME1", "ID2"},
+		{"NAME4", ""},
+	} {
+		state := tfsdk.State{Schema: s}
+		state.Set(ctx, TrackByName{Id: types.StringValue("ID1"), Domain: types.StringNull(), Name: types.StringValue(tt.name), Description: types.StringValue("My object")})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if tt.expected == "" {
+			if !resp.State.Raw.IsNull() {
+				t.Errorf("%s: expected the resource to be removed from the state", tt.name)
+			}
+			continue
+		}
+		var data TrackByName
+		resp.State.Get(ctx, &data)
+		if data.Id.ValueString() != tt.expected || data.Description.ValueString() != "Changed" {
+			t.Errorf("%s: expected object %s to be read, got: %s, %s", tt.name, tt.expected, data.Id, data.Description)
+		}
+	}
+}
+`
+
+func TestTrackByName(t *testing.T) {
+	config := loadTestConfig(t, "track_by_name.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, trackByNameResource); err != nil {
+		t.Errorf("track by name test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "track_by_name.yaml")
+	invalid.Attributes[0].Mandatory = false
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for track_by_name with an optional name")
+	}
+	invalid = loadTestConfig(t, "track_by_name.yaml")
+	invalid.NaturalKey = []string{"name"}
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for track_by_name combined with natural_key")
+	}
+}
+
+// The rendered resource is compiled with a test reading an object whose references are enriched with the
+// names of the referenced objects, each referenced object is retrieved once and the requests are bounded
+const enrichReadResource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestEnrichReadRead(t *testing.T) {
+	domain := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f"
+	requests := map[string]int{}
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch path := strings.TrimPrefix(r.URL.Path, domain); path {
+		case "/object/enrichreads/ENRICH-1":
+			fmt.Fprint(w, ` + "`" + `{"id": "ENRICH-1", "name": "NAME1", "policy": {"id": "POLICY-1"}, "objects": [{"id": "NETWORK-1"}, {"id": "NETWORK-2"}, {"id": "NETWORK-1"}]}` + "`" + `)
+		case "/policy/accesspolicies/POLICY-1":
+			fmt.Fprint(w, ` + "`" + `{"id": "POLICY-1", "name": "POLICY1"}` + "`" + `)
+		case "/object/networks/NETWORK-1", "/object/networks/NETWORK-2":
+			fmt.Fprintf(w, ` + "`" + `{"id": "%s", "name": "NET%s"}` + "`" + `, path[len(path)-9:], path[len(path)-1:])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	r := &EnrichReadResource{client: client, clients: helpers.NewDomainClients()}
+	s := testResourceSchema(r)
+	defer func(limit int) { helpers.MaxEnrichRequests = limit }(helpers.MaxEnrichRequests)
+	for _, tt := range []struct {
+		limit    int
+		expected []string
+	}{
+		{10, []string{"POLICY1", "NET1", "NET2", "NET1"}},
+		{2, []string{"POLICY1", "NET1", "", "NET1"}},
+	} {
+		helpers.MaxEnrichRequests = tt.limit
+		requests = map[string]int{}
+		objects := []EnrichReadObjects{}
+		for _, id := range []string{"NETWORK-1", "NETWORK-2", "NETWORK-1"} {
+			objects = append(objects, EnrichReadObjects{Id: types.StringValue(id), Name: types.StringNull()})
+		}
+		state := tfsdk.State{Schema: s}
+		state.Set(ctx, EnrichRead{Id: types.StringValue("ENRICH-1"), Domain: types.StringNull(), Name: types.StringValue("NAME1"), PolicyId: types.StringValue("POLICY-1"), PolicyName: types.StringNull(), Objects: objects})
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		var data EnrichRead
+		resp.State.Get(ctx, &data)
+		names := []string{data.PolicyName.ValueString()}
+		for _, object := range data.Objects {
+			names = append(names, object.Name.ValueString())
+		}
+		if strings.Join(names, ",") != strings.Join(tt.expected, ",") {
+			t.Errorf("limit %d: expected names %v, got: %v", tt.limit, tt.expected, names)
+		}
+		if requests[domain+"/object/networks/NETWORK-1"] != 1 {
+			t.Errorf("limit %d: expected the referenced object to be retrieved once, got %d requests", tt.limit, requests[domain+"/object/networks/NETWORK-1"])
+		}
+	}
+}
+`
+
+func TestEnrichRead(t *testing.T) {
+	config := loadTestConfig(t, "enrich_read.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Attributes[2].ReadEndpoint == "" || !config.Attributes[2].ExcludeTest {
+		t.Error("expected the enriched top-level attribute to be read-only")
+	}
+	if out, err := testRenderedResource(t, config, enrichReadResource); err != nil {
+		t.Errorf("enrich read test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "enrich_read.yaml")
+	invalid.EnrichRead[1].IdAttribute = "policy_id"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for an id_attribute outside the list of the enriched attribute")
+	}
+	invalid = loadTestConfig(t, "enrich_read.yaml")
+	invalid.Attributes[3].Attributes[1].ComputedMetadata = false
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for an enriched list element attribute which is not computed_metadata")
+	}
+	invalid = loadTestConfig(t, "enrich_read.yaml")
+	invalid.EnrichRead[0].Endpoint = ""
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for enrich_read without endpoint")
+	}
+}
+
+func TestImportResources(t *testing.T) {
+	configs := []YamlConfig{
+		{Name: "Network", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks"},
+		{Name: "Category", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories"},
+		{Name: "Parameter", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/{type}s"},
+		{Name: "Interface", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/physicalinterfaces", PutCreate: true},
+		{Name: "Count", RestEndpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks", NoResource: true},
+	}
+	resources := importResources(configs)
+	if len(resources) != 1 || resources[0].Name != "Network" {
+		t.Errorf("expected only the network to be imported in bulk, got: %v", resources)
+	}
+	output, err := executeTemplate("../gen/templates/importall.go", configs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output.String(), `{Type: "fmc_network", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks"},`) {
+		t.Errorf("expected network resource in rendered import-all package")
+	}
+}
+
+// The rendered resource is compiled with a test validating the JSON document of an attribute against its
+// JSON schema at plan time
+const jsonSchemaResource = `package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONSchemaValidation(t *testing.T) {
+	attribute := testResourceSchema(&JSONSchemaResource{}).Attributes["settings"].(schema.StringAttribute)
+	for _, tt := range []struct {
+		value     string
+		violation string
+	}{
+		{` + "`" + `{"port": 443}` + "`" + `, ""},
+		{` + "`" + `{"port": 70000}` + "`" + `, "$.port: must be at most 65535, got 70000"},
+		{` + "`" + `{"name": "web"}` + "`" + `, ` + "`" + `$: missing required property "port"` + "`" + `},
+	} {
+		resp := &validator.StringResponse{}
+		for _, v := range attribute.Validators {
+			v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("settings"), ConfigValue: types.StringValue(tt.value)}, resp)
+		}
+		if tt.violation == "" && resp.Diagnostics.HasError() {
+			t.Errorf("expected %s to be accepted, got: %v", tt.value, resp.Diagnostics)
+		}
+		if tt.violation != "" && (!resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), tt.violation)) {
+			t.Errorf("expected %s to be rejected with %s, got: %v", tt.value, tt.violation, resp.Diagnostics)
+		}
+	}
+}
+`
+
+func TestJSONSchema(t *testing.T) {
+	config := loadTestConfig(t, "json_schema.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, jsonSchemaResource); err != nil {
+		t.Errorf("json schema test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "json_schema.yaml")
+	invalid.Attributes[1].JsonSchema = `{"type": "object"`
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for json_schema which is not a JSON object")
+	}
+	invalid = loadTestConfig(t, "json_schema.yaml")
+	invalid.Attributes[1].Type = "Int64"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for json_schema on a non-String attribute")
+	}
+	invalid = loadTestConfig(t, "json_schema.yaml")
+	invalid.Attributes[1].JsonSchema = `{"type": "object", "properties": {"port": {"oneOf": [{"type": "integer"}, {"type": "string"}]}}}`
+	if err := validateConfig(invalid); err == nil || !strings.Contains(err.Error(), "json_schema keyword 'oneOf' is not supported") {
+		t.Errorf("expected error naming the unsupported keyword, got: %v", err)
+	}
+}
+
+// The rendered resource is compiled with a test updating and deleting an object, the policy is only exported
+// as snapshot before the changes if enabled
+const preChangeSnapshotResource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestPreChangeSnapshotUpdate(t *testing.T) {
+	domain := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f"
+	var requests []string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.Path, domain))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost:
+			fmt.Fprint(w, ` + "`" + `{"type": "PolicyExportRequest", "metadata": {"task": {"id": "TASK1"}}}` + "`" + `)
+		case strings.HasSuffix(r.URL.Path, "/job/taskstatuses/TASK1"):
+			fmt.Fprint(w, ` + "`" + `{"id": "TASK1", "status": "SUCCESS"}` + "`" + `)
+		default:
+			fmt.Fprint(w, ` + "`" + `{"id": "ID1", "name": "NAME1", "description": "Changed"}` + "`" + `)
+		}
+	})
+
+	ctx := context.Background()
+	s := testResourceSchema(&PreChangeSnapshotResource{})
+	object := PreChangeSnapshot{Id: types.StringValue("ID1"), Domain: types.StringNull(), Name: types.StringValue("NAME1"), Description: types.StringValue("My object")}
+	changed := object
+	changed.Description = types.StringValue("Changed")
+	for _, tt := range []struct {
+		enabled  bool
+		expected string
+	}{
+		{true, "POST /action/configexportrequest,GET /job/taskstatuses/TASK1,PUT /object/prechangesnapshots/ID1,POST /action/configexportrequest,GET /job/taskstatuses/TASK1,DELETE /object/prechangesnapshots/ID1"},
+		{false, "PUT /object/prechangesnapshots/ID1,DELETE /object/prechangesnapshots/ID1"},
+	} {
+		requests = nil
+		r := &PreChangeSnapshotResource{client: client, clients: helpers.NewDomainClients(), preChangeSnapshot: tt.enabled}
+		state := tfsdk.State{Schema: s}
+		state.Set(ctx, object)
+		plan := tfsdk.Plan{Schema: s}
+		plan.Set(ctx, changed)
+		updateResp := resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &updateResp)
+		deleteResp := resource.DeleteResponse{State: updateResp.State}
+		r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &deleteResp)
+		if updateResp.Diagnostics.HasError() || deleteResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v %v", updateResp.Diagnostics, deleteResp.Diagnostics)
+		}
+		var changes []string
+		for _, request := range requests {
+			if !strings.HasPrefix(request, "GET /object/") {
+				changes = append(changes, request)
+			}
+		}
+		if strings.Join(changes, ",") != tt.expected {
+			t.Errorf("snapshot enabled %v: expected requests %s, got: %s", tt.enabled, tt.expected, strings.Join(changes, ","))
+		}
+	}
+}
+`
+
+func TestPreChangeSnapshot(t *testing.T) {
+	config := loadTestConfig(t, "pre_change_snapshot.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, preChangeSnapshotResource); err != nil {
+		t.Errorf("pre change snapshot test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "pre_change_snapshot.yaml")
+	invalid.NoUpdate = true
+	invalid.NoDelete = true
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for pre_change_snapshot without update and delete")
+	}
+}
+
+const nestingLimitResource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestNestingLimitModifyPlan(t *testing.T) {
+	groups := map[string]string{
+		"G1": ` + "`" + `{"id": "G1", "objects": [{"id": "G2", "type": "NestingLimit"}]}` + "`" + `,
+		"G2": ` + "`" + `{"id": "G2", "objects": [{"id": "NET1", "type": "Network"}]}` + "`" + `,
+	}
+	var requests []string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/nestinglimits/")
+		requests = append(requests, id)
+		group, ok := groups[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, group)
+	})
+
+	ctx := context.Background()
+	s := testResourceSchema(&NestingLimitResource{})
+	r := &NestingLimitResource{client: client, clients: helpers.NewDomainClients()}
+	for _, tt := range []struct {
+		name     string
+		member   types.String
+		typ      types.String
+		warning  bool
+		requests string
+	}{
+		{"too deep", types.StringValue("G1"), types.StringValue("NestingLimit"), true, "G1,G2"},
+		{"at limit", types.StringValue("G2"), types.StringUnknown(), false, "G2"},
+		{"unknown member", types.StringUnknown(), types.StringUnknown(), false, ""},
+		{"network member", types.StringValue("NET1"), types.StringValue("Network"), false, ""},
+	} {
+		requests = nil
+		plan := tfsdk.Plan{Schema: s}
+		plan.Set(ctx, NestingLimit{Id: types.StringUnknown(), Domain: types.StringNull(), Name: types.StringValue("NAME1"), Objects: []NestingLimitObjects{{Id: tt.member, Type: tt.typ}}})
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected error: %v", tt.name, resp.Diagnostics)
+		}
+		if warning := resp.Diagnostics.WarningsCount() > 0; warning != tt.warning {
+			t.Errorf("%s: expected warning %v, got: %v", tt.name, tt.warning, resp.Diagnostics)
+		}
+		if strings.Join(requests, ",") != tt.requests {
+			t.Errorf("%s: expected groups '%s' to be retrieved, got: %v", tt.name, tt.requests, requests)
+		}
+	}
+}
+`
+
+func TestNestingLimit(t *testing.T) {
+	config := loadTestConfig(t, "nesting_limit.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, nestingLimitResource); err != nil {
+		t.Errorf("nesting limit test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "nesting_limit.yaml")
+	invalid.Attributes[1].Attributes[0].ModelName = "uuid"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for nesting_limit without an id attribute")
+	}
+	invalid = loadTestConfig(t, "nesting_limit.yaml")
+	invalid.Attributes[1].GroupType = ""
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for nesting_limit without a group_type")
+	}
+	invalid = loadTestConfig(t, "nesting_limit.yaml")
+	invalid.Attributes[1].Attributes = invalid.Attributes[1].Attributes[:1]
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for nesting_limit without a type attribute")
+	}
+}
+
+const impliesResource = `package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImpliesValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &ImpliesResource{}
+	s := testResourceSchema(r)
+	for _, tt := range []struct {
+		username types.String
+		password types.String
+		valid    bool
+	}{
+		{types.StringValue("admin"), types.StringNull(), false},
+		{types.StringNull(), types.StringValue("secret"), true},
+		{types.StringValue("admin"), types.StringValue("secret"), true},
+	} {
+		state := tfsdk.State{Schema: s}
+		state.Set(ctx, Implies{Id: types.StringNull(), Domain: types.StringNull(), Name: types.StringValue("NAME1"), Username: tt.username, Password: tt.password})
+		resp := resource.ValidateConfigResponse{}
+		for _, v := range r.ConfigValidators(ctx) {
+			v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: state.Raw}}, &resp)
+		}
+		if resp.Diagnostics.HasError() == tt.valid {
+			t.Errorf("expected username %s with password %s to be valid: %v, got: %v", tt.username, tt.password, tt.valid, resp.Diagnostics)
+		}
+	}
+}
+`
+
+func TestImplies(t *testing.T) {
+	config := loadTestConfig(t, "implies.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, impliesResource); err != nil {
+		t.Errorf("implies test failed: %v\n%s", err, out)
+	}
+}
+
+const dataSourceDiffDataSource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestDataSourceDiffRead(t *testing.T) {
+	objects := map[string]string{
+		"ID1": ` + "`" + `{"id": "ID1", "name": "NAME1", "description": "My object", "objects": [{"id": "M1"}]}` + "`" + `,
+		"ID2": ` + "`" + `{"id": "ID2", "name": "NAME1", "objects": [{"id": "M1"}, {"id": "M2"}]}` + "`" + `,
+	}
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		object, ok := objects[strings.TrimPrefix(r.URL.Path, "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/datasourcediffs/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, object)
+	})
+
+	ctx := context.Background()
+	d := &DataSourceDiffDiffDataSource{client: client, clients: helpers.NewDomainClients()}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	config.SetAttribute(ctx, path.Root("first_id"), "ID1")
+	config.SetAttribute(ctx, path.Root("second_id"), "ID2")
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state DataSourceDiffDiff
+	resp.State.Get(ctx, &state)
+	var differences []string
+	for _, difference := range state.Differences {
+		differences = append(differences, fmt.Sprintf("%s: %s -> %s", difference.Attribute.ValueString(), difference.FirstValue, difference.SecondValue))
+	}
+	expected := ` + "`" + `description: "My object" -> <null>, objects[1].id: <null> -> "M2"` + "`" + `
+	if strings.Join(differences, ", ") != expected {
+		t.Errorf("expected differences %s, got: %s", expected, strings.Join(differences, ", "))
+	}
+}
+`
+
+func TestDataSourceDiff(t *testing.T) {
+	config := loadTestConfig(t, "data_source_diff.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, dataSourceDiffDataSource); err != nil {
+		t.Errorf("data source diff test failed: %v\n%s", err, out)
+	}
+
+	invalid := loadTestConfig(t, "data_source_diff.yaml")
+	invalid.RestEndpoint += "?filter=name"
+	if err := validateConfig(invalid); err == nil {
+		t.Error("expected error for data_source_diff with query parameters")
+	}
+}
+
+const dataSourceDriftDataSource = `package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestDataSourceDriftRead(t *testing.T) {
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/datasourcedrifts/ID1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ` + "`" + `{"id": "ID1", "name": "NAME1", "description": "Changed manually", "value": "10.1.3.0/24", "overridable": true}` + "`" + `)
+	})
+
+	ctx := context.Background()
+	d := &DataSourceDriftDriftDataSource{client: client, clients: helpers.NewDomainClients()}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	for _, tt := range []struct {
+		desired  string
+		expected string
+	}{
+		{` + "`" + `{"name": "NAME1", "description": "My object", "value": "10.1.2.0/24"}` + "`" + `, ` + "`" + `description: "My object" -> "Changed manually", prefix: "10.1.2.0/24" -> "10.1.3.0/24"` + "`" + `},
+		{` + "`" + `{"name": "NAME1", "description": "Changed manually", "value": "10.1.3.0/24"}` + "`" + `, ""},
+	} {
+		config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		config.SetAttribute(ctx, path.Root("id"), "ID1")
+		config.SetAttribute(ctx, path.Root("desired"), tt.desired)
+		resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+
+		var state DataSourceDriftDrift
+		resp.State.Get(ctx, &state)
+		var differences []string
+		for _, difference := range state.Differences {
+			differences = append(differences, fmt.Sprintf("%s: %s -> %s", difference.Attribute.ValueString(), difference.DesiredValue, difference.ActualValue))
+		}
+		if strings.Join(differences, ", ") != tt.expected || state.Drifted.ValueBool() != (tt.expected != "") {
+			t.Errorf("expected differences %s, got: %s, drifted: %v", tt.expected, strings.Join(differences, ", "), state.Drifted)
+		}
+	}
+}
+`
+
+func TestDataSourceDrift(t *testing.T) {
+	config := loadTestConfig(t, "data_source_drift.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, dataSourceDriftDataSource); err != nil {
+		t.Errorf("data source drift test failed: %v\n%s", err, out)
+	}
+}
+
+const composedValueResource = `package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestComposedValueModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &ComposedValueResource{}
+	s := testResourceSchema(r)
+	for _, tt := range []struct {
+		name  string
+		port  types.Int64
+		label types.String
+	}{
+		{"known inputs", types.Int64Value(443), types.StringValue("NAME1:443")},
+		{"null input", types.Int64Null(), types.StringNull()},
+		{"unknown input", types.Int64Unknown(), types.StringUnknown()},
+	} {
+		plan := tfsdk.Plan{Schema: s}
+		plan.Set(ctx, ComposedValue{Id: types.StringUnknown(), Domain: types.StringNull(), Name: types.StringValue("NAME1"), Port: tt.port, Label: types.StringUnknown()})
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected error: %v", tt.name, resp.Diagnostics)
+		}
+		var planned ComposedValue
+		resp.Plan.Get(ctx, &planned)
+		if !planned.Label.Equal(tt.label) {
+			t.Errorf("%s: expected label %s, got: %s", tt.name, tt.label, planned.Label)
+		}
+	}
+}
+`
+
+func TestComposedValue(t *testing.T) {
+	config := loadTestConfig(t, "composed_value.yaml")
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out, err := testRenderedResource(t, config, composedValueResource); err != nil {
+		t.Errorf("composed value test failed: %v\n%s", err, out)
+	}
+}
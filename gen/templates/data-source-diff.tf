@@ -0,0 +1,4 @@
+data "fmc_{{snakeCase .Name}}_diff" "example" {
+  first_id  = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  second_id = "76d24097-41c4-4558-a4d0-a8c07ac08471"
+}
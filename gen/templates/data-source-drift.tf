@@ -0,0 +1,10 @@
+data "fmc_{{snakeCase .Name}}_drift" "example" {
+  id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  desired = jsonencode({
+    {{- range .Attributes}}
+    {{- if and .Mandatory (not .Reference) (not .Value) (not .DataPath) (eq .Type "String")}}
+    {{.ModelName}} = "{{.Example}}"
+    {{- end}}
+    {{- end}}
+  })
+}
@@ -30,8 +30,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
 	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 )
 //template:end imports
@@ -49,7 +49,9 @@ func New{{camelCase .Name}}DataSource() datasource.DataSource {
 }
 
 type {{camelCase .Name}}DataSource struct {
-	client *fmc.Client
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
 }
 
 func (d *{{camelCase .Name}}DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -61,12 +63,14 @@ func (d *{{camelCase .Name}}DataSource) Metadata(_ context.Context, req datasour
 func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "{{.DsDescription}}",
+		MarkdownDescription: {{if .Experimental}}helpers.NewAttributeDescription("{{.DsDescription}}").AddExperimentalDescription("data source").String{{else}}"{{.DsDescription}}"{{end}},
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The id of the object",
-				{{- if not .DataSourceNameQuery}}
+				{{- if .DataSourceNoId}}
+				Computed:            true,
+				{{- else if not .DataSourceNameQuery}}
 				Required:            true,
 				{{- else}}
 				Optional:            true,
@@ -78,13 +82,15 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 				Optional:			true,
 			},
 			{{- range  .Attributes}}
-			{{- if not .Value}}
+			{{- if and (not .Value) (not .WriteOnly)}}
 			"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}Attribute{
 				MarkdownDescription: "{{.Description}}",
 				{{- if eq .Type "StringList"}}
 				ElementType:         types.StringType,
+				{{- else if eq .Type "Map"}}
+				ElementType:         types.{{.ElementType}}Type,
 				{{- end}}
-				{{- if .Reference}}
+				{{- if or .Reference .EndpointParameter}}
 				Required:            true,
 				{{- else}}
 				{{- if and (eq .ModelName "name") ($nameQuery)}}
@@ -96,33 +102,39 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						{{- range  .Attributes}}
-						{{- if not .Value}}
+						{{- if and (not .Value) (not .WriteOnly)}}
 						"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}Attribute{
 							MarkdownDescription: "{{.Description}}",
 							{{- if eq .Type "StringList"}}
 							ElementType:         types.StringType,
+							{{- else if eq .Type "Map"}}
+							ElementType:         types.{{.ElementType}}Type,
 							{{- end}}
 							Computed:            true,
 							{{- if or (eq .Type "List") (eq .Type "Set")}}
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
 									{{- range  .Attributes}}
-									{{- if not .Value}}
+									{{- if and (not .Value) (not .WriteOnly)}}
 									"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}Attribute{
 										MarkdownDescription: "{{.Description}}",
 										{{- if eq .Type "StringList"}}
 										ElementType:         types.StringType,
+										{{- else if eq .Type "Map"}}
+										ElementType:         types.{{.ElementType}}Type,
 										{{- end}}
 										Computed:            true,
 										{{- if or (eq .Type "List") (eq .Type "Set")}}
 										NestedObject: schema.NestedAttributeObject{
 											Attributes: map[string]schema.Attribute{
 												{{- range  .Attributes}}
-												{{- if not .Value}}
+												{{- if and (not .Value) (not .WriteOnly)}}
 												"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}Attribute{
 													MarkdownDescription: "{{.Description}}",
 													{{- if eq .Type "StringList"}}
 													ElementType:         types.StringType,
+													{{- else if eq .Type "Map"}}
+													ElementType:         types.{{.ElementType}}Type,
 													{{- end}}
 													Computed:            true,
 												},
@@ -144,8 +156,21 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 				},
 				{{- end}}
 			},
+			{{- if .AcceptLegacyName}}
+			"{{.AcceptLegacyName}}": schema.{{.Type}}Attribute{
+				MarkdownDescription: "{{.Description}}",
+				Computed:            true,
+				DeprecationMessage:  "The `{{.AcceptLegacyName}}` attribute has been renamed to `{{.TfName}}` and will be removed in a future release.",
+			},
+			{{- end}}
 			{{- end}}
 			{{- end}}
+			{{- if .DataSourceLastModified}}
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the last modification of the object in RFC 3339 format, null if not provided by the FMC.",
+				Computed:            true,
+			},
+			{{- end}}
 		},
 	}
 }
@@ -167,27 +192,50 @@ func (d *{{camelCase .Name}}DataSource) Configure(_ context.Context, req datasou
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	d.logger = req.ProviderData.(*FmcProviderData).Logger{{with logRedactPatterns .Attributes}}.WithRedaction({{range .}}{{printf "%q" .}}, {{end}}){{end}}
 }
 //template:end model
 
 //template:begin read
 func (d *{{camelCase .Name}}DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config {{camelCase .Name}}
+	{{- if or .DataSourceLastModified (hasWriteOnly .Attributes)}}
+
+	// Read config, the schema of the data source differs from the model as it exposes last_modified or omits
+	// write-only attributes which FMC never returns
+	var object types.Object
+	diags := req.Config.Get(ctx, &object)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = helpers.ObjectAs(ctx, object, &config)
+	{{- else}}
 
 	// Read config
 	diags := req.Config.Get(ctx, &config)
+	{{- end}}
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Set request domain if provided
-	reqMods := [](func(*fmc.Req)){}
+	client := d.clients.Client(d.client, config.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+	{{- if hasEndpointParameter .Attributes}}
+
+	if err := config.checkPath(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to build REST endpoint, got error: %s", err))
+		return
+	}
+	{{- end}}
 
 	{{- if .DataSourceNameQuery}}
 	if config.Id.IsNull() && !config.Name.IsNull() {
@@ -195,7 +243,7 @@ func (d *{{camelCase .Name}}DataSource) Read(ctx context.Context, req datasource
 		limit := 1000
 		for page := 1; ; page++ {
 			queryString := fmt.Sprintf("?limit=%d&offset=%d", limit, offset)
-			res, err := d.client.Get(config.getPath() + queryString, reqMods...)
+			res, err := client.Get(config.getPath() + queryString, reqMods...)
 			if err != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve objects, got error: %s", err))
 				return
@@ -204,7 +252,7 @@ func (d *{{camelCase .Name}}DataSource) Read(ctx context.Context, req datasource
 				value.ForEach(func(k, v gjson.Result) bool {
 					if config.Name.ValueString() == v.Get("name").String() {
 						config.Id = types.StringValue(v.Get("id").String())
-						tflog.Debug(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.String(), config.Name.ValueString(), config.Id.String()))
+						d.logger.Summary(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.String(), config.Name.ValueString(), config.Id.String()))
 						return false
 					}
 					return true
@@ -222,18 +270,62 @@ func (d *{{camelCase .Name}}DataSource) Read(ctx context.Context, req datasource
 		}
 	}
 	{{- end}}
+	{{- if len .PathSegments}}
+
+	ids, err := helpers.ResolvePath(client, []helpers.PathSegment{
+		{{- range .PathSegments}}
+		{Endpoint: "{{.Endpoint}}", Name: config.{{toGoName .NameAttribute}}.ValueString()},
+		{{- end}}
+	}, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to resolve path, got error: %s", err))
+		return
+	}
+	{{- range $i, $segment := .PathSegments}}
+	config.{{toGoName $segment.IdAttribute}} = types.StringValue(ids[{{$i}}])
+	{{- end}}
+
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	res, err := d.client.Get(config.getPath() + "/" + config.Id.ValueString(), reqMods...)
+	diags = resp.State.Set(ctx, &config)
+	{{- else}}
+
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		{{- if .DataSourceAllPages}}
+		return helpers.GetAllPages(client, config.getPath(), {{if .ReadExpanded}}append(reqMods, helpers.Expanded){{else}}reqMods{{end}}...)
+		{{- else}}
+		return client.Get(config.getPath(){{if not .DataSourceNoId}} + "/" + config.Id.ValueString(){{end}}, {{if .ReadExpanded}}append(reqMods, helpers.Expanded){{else}}reqMods{{end}}...)
+		{{- end}}
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
 		return
 	}
+	{{- if or (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = config.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+	{{- end}}
+	d.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", config.Id.ValueString(), len(res.Raw)))
 
 	config.fromBody(ctx, res)
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+	{{- if or .DataSourceLastModified (hasWriteOnly .Attributes)}}
+
+	object, diags = helpers.ObjectFrom(ctx, object.AttributeTypes(ctx), config, {{if .DataSourceLastModified}}map[string]attr.Value{"last_modified": helpers.LastModified(res)}{{else}}nil{{end}})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, object)
+	{{- else}}
 
 	diags = resp.State.Set(ctx, &config)
+	{{- end}}
+	{{- end}}
 	resp.Diagnostics.Append(diags...)
 }
 //template:end read
@@ -0,0 +1,209 @@
+//go:build ignore
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+)
+//template:end imports
+
+//template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &{{camelCase .Name}}DriftDataSource{}
+	_ datasource.DataSourceWithConfigure = &{{camelCase .Name}}DriftDataSource{}
+)
+
+func New{{camelCase .Name}}DriftDataSource() datasource.DataSource {
+	return &{{camelCase .Name}}DriftDataSource{}
+}
+
+type {{camelCase .Name}}DriftDataSource struct {
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
+}
+
+type {{camelCase .Name}}Drift struct {
+	Id          types.String `tfsdk:"id"`
+	Domain      types.String `tfsdk:"domain"`
+	Desired     types.String `tfsdk:"desired"`
+	Drifted     types.Bool   `tfsdk:"drifted"`
+	Differences []{{camelCase .Name}}DriftDifferences `tfsdk:"differences"`
+}
+
+type {{camelCase .Name}}DriftDifferences struct {
+	Attribute    types.String `tfsdk:"attribute"`
+	DesiredValue types.String `tfsdk:"desired_value"`
+	ActualValue  types.String `tfsdk:"actual_value"`
+}
+
+func (d *{{camelCase .Name}}DriftDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_{{snakeCase .Name}}_drift"
+}
+
+func (d *{{camelCase .Name}}DriftDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source compares the desired state of a {{toLower .Name}} object with the object on FMC without changing it, e.g. to report configuration drift. The attributes are the ones of the `fmc_{{snakeCase .Name}}` data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Required:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the FMC domain",
+				Optional:            true,
+			},
+			"desired": schema.StringAttribute{
+				MarkdownDescription: "The desired object in the JSON format of the FMC API, e.g. created with `jsonencode()`, attributes which are not set and have no default value are not compared.",
+				Required:            true,
+			},
+			"drifted": schema.BoolAttribute{
+				MarkdownDescription: "Whether any attribute of the object differs from the desired value.",
+				Computed:            true,
+			},
+			"differences": schema.ListNestedAttribute{
+				MarkdownDescription: "List of attributes which differ from the desired value, ordered by attribute path.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute": schema.StringAttribute{
+							MarkdownDescription: "Path of the attribute, e.g. `objects[0].id`, list elements are compared by position.",
+							Computed:            true,
+						},
+						"desired_value": schema.StringAttribute{
+							MarkdownDescription: "Desired value of the attribute.",
+							Computed:            true,
+						},
+						"actual_value": schema.StringAttribute{
+							MarkdownDescription: "Value of the attribute on FMC, null if it is not set.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *{{camelCase .Name}}DriftDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	d.logger = req.ProviderData.(*FmcProviderData).Logger{{with logRedactPatterns .Attributes}}.WithRedaction({{range .}}{{printf "%q" .}}, {{end}}){{end}}
+}
+//template:end model
+
+//template:begin read
+func (d *{{camelCase .Name}}DriftDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config {{camelCase .Name}}Drift
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := d.clients.Client(d.client, config.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+	}
+
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+
+	if !gjson.Valid(config.Desired.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("desired"), "Invalid Desired Object", "The desired object is not valid JSON")
+		return
+	}
+
+	actual := {{camelCase .Name}}{Id: config.Id, Domain: config.Domain}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(actual.getPath() + "/" + config.Id.ValueString(), {{if .ReadExpanded}}append(reqMods, helpers.Expanded){{else}}reqMods{{end}}...)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+	{{- if or (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = actual.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+	{{- end}}
+	d.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", config.Id.ValueString(), len(res.Raw)))
+
+	actual.fromBody(ctx, res)
+	desired := {{camelCase .Name}}{Id: config.Id, Domain: config.Domain}
+	desired.fromBody(ctx, gjson.Parse(config.Desired.ValueString()))
+
+	// The desired object is read as the object on FMC by the data source and compared in its schema
+	schemaResp := datasource.SchemaResponse{}
+	(&{{camelCase .Name}}DataSource{}).Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	attrTypes := schemaResp.Schema.Type().(types.ObjectType).AttrTypes
+	desiredObject, diags := helpers.ObjectFrom(ctx, attrTypes, desired, nil)
+	resp.Diagnostics.Append(diags...)
+	actualObject, diags := helpers.ObjectFrom(ctx, attrTypes, actual, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Differences = []{{camelCase .Name}}DriftDifferences{}
+	for _, difference := range helpers.Diff(desiredObject, actualObject, "id", "domain") {
+		// Attributes without a desired value are not managed
+		if difference.First == nil {
+			continue
+		}
+		config.Differences = append(config.Differences, {{camelCase .Name}}DriftDifferences{
+			Attribute:    types.StringValue(difference.Attribute),
+			DesiredValue: types.StringValue(*difference.First),
+			ActualValue:  types.StringPointerValue(difference.Second),
+		})
+	}
+	config.Drifted = types.BoolValue(len(config.Differences) > 0)
+
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully, %d differences", config.Id.ValueString(), len(config.Differences)))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+//template:end read
@@ -38,14 +38,14 @@ func TestAccDataSourceFmc{{camelCase .Name}}(t *testing.T) {
 	var checks []resource.TestCheckFunc
 	{{- $name := .Name }}
 	{{- range  .Attributes}}
-	{{- if and (not .WriteOnly) (not .ExcludeTest) (not .Value) (not .TestValue) (not .ResourceId)}}
+	{{- if and (not .WriteOnly) (not .ExcludeTest) (not .Value) (not .TestValue) (not .ResourceId) (ne .Type "Map")}}
 	{{- if or (eq .Type "List") (eq .Type "Set")}}
 	{{- $list := .TfName }}
 	{{- if len .TestTags}}
 	if {{range $i, $e := .TestTags}}{{if $i}} || {{end}}os.Getenv("{{$e}}") != ""{{end}} {
 	{{- end}}
 	{{- range  .Attributes}}
-	{{- if and (not .WriteOnly) (not .ExcludeTest) (not .Value) (not .TestValue)}}
+	{{- if and (not .WriteOnly) (not .ExcludeTest) (not .Value) (not .TestValue) (ne .Type "Map")}}
 	{{- if or (eq .Type "List") (eq .Type "Set")}}
 	{{- $clist := .TfName }}
 	{{- if len .TestTags}}
@@ -112,7 +112,7 @@ func TestAccDataSourceFmc{{camelCase .Name}}(t *testing.T) {
 	{{- end}}
 	{{- end}}
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { {{if .MinimumVersion}}testAccPreCheckMinimumVersion(t, "{{.MinimumVersion}}"){{else}}testAccPreCheck(t){{end}} },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -136,7 +136,7 @@ const testAccDataSourceFmc{{camelCase .Name}}PrerequisitesConfig = `
 func testAccDataSourceFmc{{camelCase .Name}}Config() string {
 	config := `resource "fmc_{{snakeCase $name}}" "test" {` + "\n"
 	{{- range  .Attributes}}
-	{{- if and (not .ExcludeTest) (not .Value) (not .ResourceId)}}
+	{{- if and (not .ExcludeTest) (not .Value) (not .ResourceId) (not .ComposedValue)}}
 	{{- if or (eq .Type "List") (eq .Type "Set")}}
 	{{- if len .TestTags}}
 	if {{range $i, $e := .TestTags}}{{if $i}} || {{end}}os.Getenv("{{$e}}") != ""{{end}} {
@@ -218,7 +218,7 @@ func testAccDataSourceFmc{{camelCase .Name}}Config() string {
 		data "fmc_{{snakeCase .Name}}" "test" {
 			id = fmc_{{snakeCase $name}}.test.id
 			{{- range  .Attributes}}
-			{{- if .Reference}}
+			{{- if or .Reference .EndpointParameter}}
 			{{.TfName}} = {{if .TestValue}}{{.TestValue}}{{else}}{{if eq .Type "String"}}"{{.Example}}"{{else if eq .Type "StringList"}}["{{.Example}}"]{{else}}{{.Example}}{{end}}{{end}}
 			{{- end}}
 			{{- end}}
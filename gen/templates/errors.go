@@ -0,0 +1,208 @@
+//go:build ignore
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+// Package fmcerrors classifies failed FMC requests, so that resources and data sources handle them consistently.
+package fmcerrors
+
+//template:begin errors
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// Category is the category of a failed FMC request
+type Category int
+
+const (
+	// Unknown is any error which does not belong to one of the other categories
+	Unknown Category = iota
+	// NotFound is returned if the object does not exist (anymore)
+	NotFound
+	// Conflict is returned if the object conflicts with an existing object, e.g. a duplicate name
+	Conflict
+	// RateLimited is returned if the FMC rejected the request due to too many requests
+	RateLimited
+	// AuthExpired is returned if the access token is not valid (anymore)
+	AuthExpired
+	// Validation is returned if the FMC rejected the content of the request
+	Validation
+)
+
+var categoryNames = []string{"Unknown", "NotFound", "Conflict", "RateLimited", "AuthExpired", "Validation"}
+
+func (c Category) String() string {
+	return categoryNames[c]
+}
+
+var statusCodeRegex = regexp.MustCompile(`StatusCode (\d+)`)
+
+// StatusCode returns the HTTP status code of a failed request, 0 if the error does not carry one
+func StatusCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	matches := statusCodeRegex.FindStringSubmatch(err.Error())
+	if len(matches) < 2 {
+		return 0
+	}
+	code, _ := strconv.Atoi(matches[1])
+	return code
+}
+
+// Message returns the error messages of an FMC error response body joined by ", "
+func Message(res gjson.Result) string {
+	return strings.Join(messages(res), ", ")
+}
+
+func messages(res gjson.Result) []string {
+	var messages []string
+	for _, message := range res.Get("error.messages").Array() {
+		if description := message.Get("description").String(); description != "" {
+			messages = append(messages, description)
+		}
+	}
+	return messages
+}
+
+// Warnings returns the messages of a successful response, which only carries warnings, e.g. if the request
+// was made with ignoreWarnings=true. It returns nil if the request failed for any other reason.
+func Warnings(err error, res gjson.Result) []string {
+	if err == nil || StatusCode(err) != 0 || !strings.EqualFold(res.Get("error.severity").String(), "WARNING") {
+		return nil
+	}
+	return messages(res)
+}
+
+// ResponseWarnings returns the non-fatal warnings FMC reports in the metadata of a successful response, either
+// as plain messages or as objects with a description
+func ResponseWarnings(res gjson.Result) []string {
+	var warnings []string
+	for _, warning := range res.Get("metadata.warnings").Array() {
+		message := warning.String()
+		if warning.IsObject() {
+			message = warning.Get("description").String()
+		}
+		if message != "" {
+			warnings = append(warnings, message)
+		}
+	}
+	return warnings
+}
+
+// EmbeddedError returns the error FMC reports in the body of a successful response, nil if there is none.
+// Failed asynchronous operations are reported by the status of the task in the metadata, failed items of
+// bulk operations by their own error objects.
+func EmbeddedError(res gjson.Result) error {
+	task := res.Get("metadata.task")
+	switch status := strings.ToUpper(task.Get("status").String()); status {
+	case "FAILED", "FAILURE", "ERROR":
+		return fmt.Errorf("task %s failed with status %s: %s", task.Get("id").String(), status, task.Get("message").String())
+	}
+	items := res.Get("items").Array()
+	failed := 0
+	var errors []string
+	for _, item := range items {
+		if item.Get("error").IsObject() && !strings.EqualFold(item.Get("error.severity").String(), "WARNING") {
+			failed++
+			errors = append(errors, messages(item)...)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d items failed: %s", failed, len(items), strings.Join(errors, ", "))
+	}
+	return nil
+}
+
+// Classify returns the category of a failed request based on the status code and the error response body
+func Classify(err error, res gjson.Result) Category {
+	if err == nil {
+		return Unknown
+	}
+	message := strings.ToLower(Message(res))
+	switch code := StatusCode(err); {
+	case code == 404:
+		return NotFound
+	case code == 409:
+		return Conflict
+	case code == 429:
+		return RateLimited
+	case code == 401:
+		return AuthExpired
+	case code == 400 || code == 422:
+		// Only a 404 means the object itself is gone, a validation error may name other missing objects
+		if strings.Contains(message, "already exists") || strings.Contains(message, "duplicate") {
+			return Conflict
+		}
+		return Validation
+	case code == 0 && strings.Contains(err.Error(), "JSON error"):
+		return Validation
+	}
+	return Unknown
+}
+
+// IsNotFound returns true if the request failed because the object does not exist
+func IsNotFound(err error, res gjson.Result) bool {
+	return Classify(err, res) == NotFound
+}
+
+// NonJSONMessage is the beginning of the error message replacing a non-JSON error body, e.g. the HTML error
+// page of an overloaded FMC or a proxy
+const NonJSONMessage = "FMC returned a non-JSON error"
+
+// Retry executes a request and repeats it once after authenticating again if the access token has expired.
+// Repeating the request is safe, as the FMC did not process the rejected request. Rate limited requests are
+// already retried by the client up to the configured number of retries. The message of a non-JSON error
+// body is added to the error, as the status alone does not explain it.
+func Retry(client *fmc.Client, request func() (fmc.Res, error)) (fmc.Res, error) {
+	res, err := retry(client, request)
+	if err != nil && strings.HasPrefix(Message(res), NonJSONMessage) {
+		err = fmt.Errorf("%w, %s", err, Message(res))
+	}
+	return res, err
+}
+
+func retry(client *fmc.Client, request func() (fmc.Res, error)) (fmc.Res, error) {
+	// The token is read under the authentication mutex, as concurrent requests of the client may replace it
+	client.AuthenticationMutex.Lock()
+	token := client.AuthToken
+	client.AuthenticationMutex.Unlock()
+	res, err := request()
+	if Classify(err, res) != AuthExpired {
+		return res, err
+	}
+	// The rejected token is discarded unless a concurrent request already replaced it, Authenticate then
+	// logs in again while holding the authentication mutex of the client
+	client.AuthenticationMutex.Lock()
+	if client.AuthToken == token {
+		client.AuthToken = ""
+	}
+	client.AuthenticationMutex.Unlock()
+	if authErr := client.Authenticate(); authErr != nil {
+		return res, err
+	}
+	return request()
+}
+//template:end errors
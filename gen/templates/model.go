@@ -28,6 +28,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
 	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
@@ -48,6 +49,9 @@ type {{camelCase .Name}} struct {
 {{- else}}
 	{{toGoName .TfName}} types.{{.Type}} `tfsdk:"{{.TfName}}"`
 {{- end}}
+{{- if .AcceptLegacyName}}
+	{{toGoName .AcceptLegacyName}} types.{{.Type}} `tfsdk:"{{.AcceptLegacyName}}"`
+{{- end}}
 {{- end}}
 {{- end}}
 }
@@ -132,98 +136,244 @@ type {{$name}}{{$childName}}{{$childChildName}}{{toGoName .TfName}} struct {
 {{- end}}
 {{- end}}
 {{ end}}
+{{- range .Attributes}}
+{{- if .TypedEnum}}
+{{- $enum := print $name (toGoName .TfName)}}
+
+// {{$enum}} is a value of {{.TfName}}
+type {{$enum}} string
+
+// Values returns the valid values of {{.TfName}}
+func ({{$enum}}) Values() []string {
+	return []string{ {{range .EnumValues}}"{{.}}", {{end}} }
+}
+
+// IsValid returns true if the value is one of the valid values of {{.TfName}}
+func (v {{$enum}}) IsValid() bool {
+	for _, value := range v.Values() {
+		if string(v) == value {
+			return true
+		}
+	}
+	return false
+}
+{{ end}}
+{{- end}}
+{{- if .Getters}}
+{{- template "getters" (modelType $name .Attributes)}}
+{{- range .Attributes}}
+{{- if and (not .Value) (or (eq .Type "List") (eq .Type "Set"))}}
+{{- template "getters" (modelType (print $name (toGoName .TfName)) .Attributes)}}
+{{- end}}
+{{- end}}
+{{ end -}}
 //template:end types
 
 //template:begin getPath
 func (data {{camelCase .Name}}) getPath() string {
-	{{- if hasReference .Attributes}}
-		return fmt.Sprintf("{{.RestEndpoint}}"{{range .Attributes}}{{if .Reference}}, data.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}})
+	{{- $parameters := hasEndpointParameter .Attributes}}
+	{{- if $parameters}}
+	// Resolve the placeholders of the REST endpoint from the attribute values
+	endpoint := strings.NewReplacer({{range .Attributes}}{{if .EndpointParameter}}"{ {{- .TfName -}} }", url.PathEscape(data.{{toGoName .TfName}}.ValueString()), {{end}}{{end}})
+	{{- end}}
+	{{- if len .PathSegments}}
+		return fmt.Sprintf("{{.RestEndpoint}}"{{range .PathSegments}}{{if ne .IdAttribute "id"}}, data.{{toGoName .IdAttribute}}.ValueString(){{end}}{{end}})
+	{{- else if hasReference .Attributes}}
+		return {{if $parameters}}endpoint.Replace({{end}}fmt.Sprintf("{{.RestEndpoint}}"{{range .Attributes}}{{if .Reference}}, data.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}){{if $parameters}}){{end}}
+	{{- else if $parameters}}
+		return endpoint.Replace("{{.RestEndpoint}}")
 	{{- else}}
 		return "{{.RestEndpoint}}"
 	{{- end}}
 }
+{{- if $parameters}}
+
+// checkPath returns an error if an attribute the REST endpoint is resolved from is not set
+func (data {{camelCase .Name}}) checkPath() error {
+	{{- range .Attributes}}
+	{{- if .EndpointParameter}}
+	if data.{{toGoName .TfName}}.ValueString() == "" {
+		return fmt.Errorf("the attribute {{.TfName}} is required to build the REST endpoint")
+	}
+	{{- end}}
+	{{- end}}
+	return nil
+}
+{{- end}}
+{{- if len .NaturalKey}}
+
+// naturalKey returns the composite key which identifies the object
+func (data {{camelCase .Name}}) naturalKey() string {
+	return strings.Join([]string{ {{range attributesByName .Attributes .NaturalKey}}data.{{toGoName .TfName}}.ValueString(), {{end}} }, ",")
+}
+
+// findByNaturalKey returns the object matching the natural key from a list of objects
+func (data {{camelCase .Name}}) findByNaturalKey(res gjson.Result) gjson.Result {
+	for _, v := range res.Get("items").Array() {
+		if {{$first := true}}{{range attributesByName .Attributes .NaturalKey}}{{if not .Reference}}{{if not $first}} && {{end}}{{$first = false}}v.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}").String() == data.{{toGoName .TfName}}.ValueString(){{end}}{{end}} {
+			return v
+		}
+	}
+	return gjson.Result{}
+}
+{{- end}}
+{{- if or (len .ReadEndpoints) (len .EnrichRead)}}
+
+// readEndpoints retrieves the attributes provided by additional endpoints and the referenced objects and
+// merges them into the object
+func (data {{camelCase .Name}}) readEndpoints(ctx context.Context, client *fmc.Client, res gjson.Result, reqMods ...func(*fmc.Req)) (gjson.Result, error) {
+	body := res.Raw
+	{{- range .ReadEndpoints}}
+	if r, err := client.Get(data.getPath() + "/" + data.Id.ValueString() + "{{.Path}}", reqMods...); err != nil {
+		{{- if .IgnoreErrors}}
+		tflog.Warn(ctx, fmt.Sprintf("%s: Failed to retrieve attributes from %s, got error: %s", data.Id.ValueString(), "{{.Path}}", err))
+		{{- else}}
+		return res, fmt.Errorf("failed to retrieve attributes from %s: %w", "{{.Path}}", err)
+		{{- end}}
+	} else {
+		{{- range attributesByName $.Attributes .Attributes}}
+		if value := r.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() {
+			body, _ = sjson.SetRaw(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", value.Raw)
+		}
+		{{- end}}
+	}
+	{{- end}}
+	{{- if len .EnrichRead}}
+	enricher := helpers.NewEnricher(client, reqMods...)
+	{{- range .EnrichRead}}
+	{{- if .ListPath}}
+	for i, v := range gjson.Get(body, "{{.ListPath}}").Array() {
+		if value, err := enricher.Get("{{.Endpoint}}", v.Get("{{.IdPath}}").String(), "{{.Field}}"); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("%s: Failed to retrieve %s of referenced object, got error: %s", data.Id.ValueString(), "{{.Attribute}}", err))
+		} else if value.Exists() {
+			body, _ = sjson.SetRaw(body, fmt.Sprintf("{{.ListPath}}.%d.{{.Path}}", i), value.Raw)
+		}
+	}
+	{{- else}}
+	if value, err := enricher.Get("{{.Endpoint}}", gjson.Get(body, "{{.IdPath}}").String(), "{{.Field}}"); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("%s: Failed to retrieve %s of referenced object, got error: %s", data.Id.ValueString(), "{{.Attribute}}", err))
+	} else if value.Exists() {
+		body, _ = sjson.SetRaw(body, "{{.Path}}", value.Raw)
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	return gjson.Parse(body), nil
+}
+{{- end}}
 //template:end getPath
 
 //template:begin toBody
 func (data {{camelCase .Name}}) toBody(ctx context.Context, state {{camelCase .Name}}) string {
 	body := ""
+	{{- range .Attributes}}
+	{{- if .AcceptLegacyName}}
+	if data.{{toGoName .TfName}}.IsNull() {
+		data.{{toGoName .TfName}} = data.{{toGoName .AcceptLegacyName}}
+	}
+	{{- end}}
+	{{- end}}
+	{{- $discriminator := discriminator .Attributes}}
+	{{- range .Attributes}}
+	{{- if len .DiscriminatorValues}}
+	if !helpers.Contains([]string{ {{range .DiscriminatorValues}}"{{.}}", {{end}} }, data.{{toGoName $discriminator.TfName}}.ValueString()) {
+		data.{{toGoName .TfName}} = {{if or (eq .Type "List") (eq .Type "Set")}}nil{{else if eq .Type "StringList"}}types.ListNull(types.StringType){{else if eq .Type "Map"}}types.MapNull(types.{{.ElementType}}Type){{else}}types.{{.Type}}Null(){{end}}
+	}
+	{{- end}}
+	{{- end}}
+	{{- if not (len .NaturalKey)}}
 	if data.Id.ValueString() != "" {
 		body, _ = sjson.Set(body, "id", data.Id.ValueString())
 	}
-	{{- range .Attributes}}
+	{{- end}}
+	{{- range writeOrder .Attributes}}
 	{{- if .Value}}
 	body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if eq .Type "String"}}"{{end}}{{.Value}}{{if eq .Type "String"}}"{{end}})
 	{{- else if .ResourceId}}
 	if state.{{toGoName .TfName}}.ValueString() != "" {
 		body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", state.{{toGoName .TfName}}.ValueString())
 	}
-	{{- else if not .Reference}}
+	{{- else if and (not .Reference) (not .EndpointParameter) (not .ComposedValue) (not .ReadEndpoint) (not .ParentAttribute) (not .QueryParameter) (not .Placement) (not .Computed)}}
 	{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-	if !data.{{toGoName .TfName}}.IsNull() {{if .WriteChangesOnly}}&& data.{{toGoName .TfName}} != state.{{toGoName .TfName}}{{end}} {
-		body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", data.{{toGoName .TfName}}.Value{{.Type}}())
-	}
+	if !data.{{toGoName .TfName}}.IsNull() {{if .AutoAssigned}}&& !data.{{toGoName .TfName}}.IsUnknown() {{end}}{{if .WriteChangesOnly}}&& data.{{toGoName .TfName}} != state.{{toGoName .TfName}}{{end}} {
+		body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if .Scale}}helpers.ScaleToBody(data.{{toGoName .TfName}}.Value{{.Type}}(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumToInteger(data.{{toGoName .TfName}}.ValueString(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else}}data.{{toGoName .TfName}}.Value{{.Type}}(){{end}})
+	}{{if .ExplicitNull}} else if {{if .WriteChangesOnly}}data.{{toGoName .TfName}}.IsNull() && {{end}}!state.{{toGoName .TfName}}.IsNull() {
+		body, _ = sjson.SetRaw(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", "null")
+	}{{end}}
 	{{- else if eq .Type "StringList"}}
 	if !data.{{toGoName .TfName}}.IsNull() {
 		var values []string
 		data.{{toGoName .TfName}}.ElementsAs(ctx, &values, false)
-		body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", values)
+		body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if .ScalarOrList}}helpers.ScalarOrList(values){{else}}values{{end}})
+	}{{if .ExplicitNull}} else if !state.{{toGoName .TfName}}.IsNull() {
+		body, _ = sjson.SetRaw(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", "null")
+	}{{end}}
+	{{- else if eq .Type "Map"}}
+	if !data.{{toGoName .TfName}}.IsNull() {
+		body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", helpers.MapToBody(data.{{toGoName .TfName}}))
 	}
 	{{- else if or (eq .Type "List") (eq .Type "Set")}}
+	{{- $mapKey := mapKey .}}
 	if len(data.{{toGoName .TfName}}) > 0 {
-		body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", []interface{}{})
+		body, _ = sjson.Set(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if $mapKey.TfName}}map[string]interface{}{}{{else}}[]interface{}{}{{end}})
 		for _, item := range data.{{toGoName .TfName}} {
 			itemBody := ""
-			{{- range .Attributes}}
-			{{- if .Value}}
+			{{- range writeOrder .Attributes}}
+			{{- if and $mapKey.TfName .Id}}
+			{{- else if .Value}}
 			itemBody, _ = sjson.Set(itemBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if eq .Type "String"}}"{{end}}{{.Value}}{{if eq .Type "String"}}"{{end}})
-			{{- else if not .Reference}}
+			{{- else if and (not .Reference) (not .ComputedMetadata) (not .IsLookupName)}}
 			{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
 			if !item.{{toGoName .TfName}}.IsNull() {
-				itemBody, _ = sjson.Set(itemBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", item.{{toGoName .TfName}}.Value{{.Type}}())
+				itemBody, _ = sjson.Set(itemBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if .Scale}}helpers.ScaleToBody(item.{{toGoName .TfName}}.Value{{.Type}}(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumToInteger(item.{{toGoName .TfName}}.ValueString(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else}}item.{{toGoName .TfName}}.Value{{.Type}}(){{end}})
 			}
 			{{- else if eq .Type "StringList"}}
 			if !item.{{toGoName .TfName}}.IsNull() {
 				var values []string
 				item.{{toGoName .TfName}}.ElementsAs(ctx, &values, false)
-				itemBody, _ = sjson.Set(itemBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", values)
+				itemBody, _ = sjson.Set(itemBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if .ScalarOrList}}helpers.ScalarOrList(values){{else}}values{{end}})
+			}
+			{{- else if eq .Type "Map"}}
+			if !item.{{toGoName .TfName}}.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", helpers.MapToBody(item.{{toGoName .TfName}}))
 			}
 			{{- else if or (eq .Type "List") (eq .Type "Set")}}
 			if len(item.{{toGoName .TfName}}) > 0 {
 				itemBody, _ = sjson.Set(itemBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", []interface{}{})
 				for _, childItem := range item.{{toGoName .TfName}} {
 					itemChildBody := ""
-					{{- range .Attributes}}
+					{{- range writeOrder .Attributes}}
 					{{- if .Value}}
 					itemChildBody, _ = sjson.Set(itemChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if eq .Type "String"}}"{{end}}{{.Value}}{{if eq .Type "String"}}"{{end}})
-					{{- else if not .Reference}}
+					{{- else if and (not .Reference) (not .ComputedMetadata)}}
 					{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
 					if !childItem.{{toGoName .TfName}}.IsNull() {
-						itemChildBody, _ = sjson.Set(itemChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", childItem.{{toGoName .TfName}}.Value{{.Type}}())
+						itemChildBody, _ = sjson.Set(itemChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if .Scale}}helpers.ScaleToBody(childItem.{{toGoName .TfName}}.Value{{.Type}}(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumToInteger(childItem.{{toGoName .TfName}}.ValueString(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else}}childItem.{{toGoName .TfName}}.Value{{.Type}}(){{end}})
 					}
 					{{- else if eq .Type "StringList"}}
 					if !childItem.{{toGoName .TfName}}.IsNull() {
 						var values []string
 						childItem.{{toGoName .TfName}}.ElementsAs(ctx, &values, false)
-						itemChildBody, _ = sjson.Set(itemChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", values)
+						itemChildBody, _ = sjson.Set(itemChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if .ScalarOrList}}helpers.ScalarOrList(values){{else}}values{{end}})
 					}
 					{{- else if or (eq .Type "List") (eq .Type "Set")}}
 					if len(childItem.{{toGoName .TfName}}) > 0 {
 						itemChildBody, _ = sjson.Set(itemChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", []interface{}{})
 						for _, childChildItem := range childItem.{{toGoName .TfName}} {
 							itemChildChildBody := ""
-							{{- range .Attributes}}
+							{{- range writeOrder .Attributes}}
 							{{- if .Value}}
 							itemChildChildBody, _ = sjson.Set(itemChildChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if eq .Type "String"}}"{{end}}{{.Value}}{{if eq .Type "String"}}"{{end}})
-							{{- else if not .Reference}}
+							{{- else if and (not .Reference) (not .ComputedMetadata)}}
 							{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
 							if !childChildItem.{{toGoName .TfName}}.IsNull() {
-								itemChildChildBody, _ = sjson.Set(itemChildChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", childChildItem.{{toGoName .TfName}}.Value{{.Type}}())
+								itemChildChildBody, _ = sjson.Set(itemChildChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if .Scale}}helpers.ScaleToBody(childChildItem.{{toGoName .TfName}}.Value{{.Type}}(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumToInteger(childChildItem.{{toGoName .TfName}}.ValueString(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else}}childChildItem.{{toGoName .TfName}}.Value{{.Type}}(){{end}})
 							}
 							{{- else if eq .Type "StringList"}}
 							if !childChildItem.{{toGoName .TfName}}.IsNull() {
 								var values []string
 								childChildItem.{{toGoName .TfName}}.ElementsAs(ctx, &values, false)
-								itemChildChildBody, _ = sjson.Set(itemChildChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", values)
+								itemChildChildBody, _ = sjson.Set(itemChildChildBody, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{if .ScalarOrList}}helpers.ScalarOrList(values){{else}}values{{end}})
 							}
 							{{- end}}
 							{{- end}}
@@ -240,7 +390,15 @@ func (data {{camelCase .Name}}) toBody(ctx context.Context, state {{camelCase .N
 			{{- end}}
 			{{- end}}
 			{{- end}}
+			{{- if $mapKey.TfName}}
+			// The items are an object keyed by {{$mapKey.TfName}} instead of an array
+			if itemBody == "" {
+				itemBody = "{}"
+			}
+			body, _ = sjson.SetRaw(body, "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}." + gjson.Escape(item.{{toGoName $mapKey.TfName}}.ValueString()), itemBody)
+			{{- else}}
 			body, _ = sjson.SetRaw(body, "{{range .DataPath}}{{.}}.{{end}}{{if .ModelName}}{{.ModelName}}.{{end}}-1", itemBody)
+			{{- end}}
 		}
 	}
 	{{- end}}
@@ -248,16 +406,136 @@ func (data {{camelCase .Name}}) toBody(ctx context.Context, state {{camelCase .N
 	{{- end}}
 	return body
 }
+{{- if .TwoPhaseCreate}}
+
+// toInitialBody returns the body of the first request of a two-phase create, which only contains the mandatory attributes
+func (data {{camelCase .Name}}) toInitialBody(ctx context.Context) string {
+	full := gjson.Parse(data.toBody(ctx, {{camelCase .Name}}{}))
+	body := ""
+	for _, path := range []string{ {{range writeOrder .Attributes}}{{if and (or .Mandatory .Value) (not .Reference) (not .Id)}}"{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{end}}{{end}} } {
+		if value := full.Get(path); value.Exists() {
+			body, _ = sjson.SetRaw(body, path, value.Raw)
+		}
+	}
+	return body
+}
+{{- end}}
+{{- if hasLookup .Attributes}}
+
+// resolveReferences sets the IDs of referenced objects configured by name, all names of the same
+// object type are resolved with a single list of the objects
+func (data *{{camelCase .Name}}) resolveReferences(ctx context.Context, client *fmc.Client, reqMods ...func(*fmc.Req)) error {
+	resolver := helpers.NewNameResolver()
+	{{- range .Attributes}}
+	{{- $list := toGoName .TfName}}
+	{{- range .Attributes}}
+	{{- if .LookupEndpoint}}
+	for _, item := range data.{{$list}} {
+		if item.{{toGoName .TfName}}.ValueString() == "" {
+			if item.{{toGoName .LookupName}}.ValueString() == "" {
+				return fmt.Errorf("either {{.TfName}} or {{.LookupName}} must be configured")
+			}
+			resolver.Add("{{.LookupEndpoint}}", item.{{toGoName .LookupName}}.ValueString())
+		}
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	if err := resolver.Resolve(client, reqMods...); err != nil {
+		return err
+	}
+	{{- range .Attributes}}
+	{{- $list := toGoName .TfName}}
+	{{- range .Attributes}}
+	{{- if .LookupEndpoint}}
+	for i, item := range data.{{$list}} {
+		if item.{{toGoName .TfName}}.ValueString() == "" {
+			data.{{$list}}[i].{{toGoName .TfName}} = types.StringValue(resolver.Id("{{.LookupEndpoint}}", item.{{toGoName .LookupName}}.ValueString()))
+		}
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	return nil
+}
+{{- end}}
+{{- if hasQueryParameter .Attributes}}
+
+// setQueryParameters adds the attributes which are sent as query parameters to the create request
+func (data {{camelCase .Name}}) setQueryParameters(req *fmc.Req) {
+	query := req.HttpReq.URL.Query()
+	{{- range .Attributes}}
+	{{- if or .QueryParameter .Placement}}
+	if !data.{{toGoName .TfName}}.IsNull(){{if .Placement}} && !data.{{toGoName .TfName}}.IsUnknown(){{end}} {
+		query.Set("{{.ModelName}}", {{if eq .Type "Int64"}}strconv.FormatInt(data.{{toGoName .TfName}}.ValueInt64(), 10){{else}}data.{{toGoName .TfName}}.ValueString(){{end}})
+	}
+	{{- end}}
+	{{- end}}
+	req.HttpReq.URL.RawQuery = query.Encode()
+}
+{{- end}}
+{{- if hasPlacement .Attributes}}
+
+// setPlacementParameters adds the category and section the object is placed in to the update request
+func (data {{camelCase .Name}}) setPlacementParameters(req *fmc.Req) {
+	query := req.HttpReq.URL.Query()
+	{{- range .Attributes}}
+	{{- if .Placement}}
+	if !data.{{toGoName .TfName}}.IsNull() && !data.{{toGoName .TfName}}.IsUnknown() {
+		query.Set("{{.ModelName}}", data.{{toGoName .TfName}}.ValueString())
+	}
+	{{- end}}
+	{{- end}}
+	req.HttpReq.URL.RawQuery = query.Encode()
+}
+{{- end}}
+{{- if eq .ContentType "multipart"}}
+
+// toMultipart builds the multipart form of the create request, the files are read from their local path
+func (data {{camelCase .Name}}) toMultipart(ctx context.Context) (*helpers.MultipartForm, error) {
+	form := helpers.NewMultipartForm()
+	{{- range .Attributes}}
+	{{- if .Value}}
+	if err := form.Field("{{.ModelName}}", "{{.Value}}"); err != nil {
+		return form, err
+	}
+	{{- else if and (not .Reference) (not .EndpointParameter) (not .ResourceId) (not .ComposedValue) (not .ReadEndpoint) (not .ParentAttribute) (not .Computed)}}
+	{{- if eq .Multipart "file"}}
+	if err := form.File("{{.ModelName}}", data.{{toGoName .TfName}}.ValueString()); err != nil {
+		return form, err
+	}
+	{{- else if eq .Type "StringList"}}
+	if !data.{{toGoName .TfName}}.IsNull() {
+		var values []string
+		data.{{toGoName .TfName}}.ElementsAs(ctx, &values, false)
+		for _, value := range values {
+			if err := form.Field("{{.ModelName}}", value); err != nil {
+				return form, err
+			}
+		}
+	}
+	{{- else}}
+	if !data.{{toGoName .TfName}}.IsNull() {
+		if err := form.Field("{{.ModelName}}", {{if eq .Type "String"}}data.{{toGoName .TfName}}.ValueString(){{else}}fmt.Sprint(data.{{toGoName .TfName}}.Value{{.Type}}()){{end}}); err != nil {
+			return form, err
+		}
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	return form, nil
+}
+{{- end}}
 //template:end toBody
 
 //template:begin fromBody
 func (data *{{camelCase .Name}}) fromBody(ctx context.Context, res gjson.Result) {
 	{{- range .Attributes}}
-	{{- if and (not .Value) (not .WriteOnly) (not .Reference)}}
+	{{- if and (not .Value) (not .WriteOnly) (not .Reference) (not .EndpointParameter)}}
 	{{- $cname := toGoName .TfName}}
 	{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() {
-		data.{{toGoName .TfName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if .TriState}} && value.Type != gjson.Null{{end}} {
+		data.{{toGoName .TfName}} = types.{{.Type}}Value({{if .Scale}}helpers.ScaleFromBody[{{if eq .Type "Int64"}}int64{{else}}float64{{end}}](value.Float(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumFromInteger(value.Int(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else if and .Placement (len .EnumValues)}}helpers.EnumFold(value.String(), {{range .EnumValues}}"{{.}}", {{end}}){{else}}value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}(){{end}})
 	} else {
 		{{- if .DefaultValue}}
 		data.{{toGoName .TfName}} = types.{{.Type}}Value({{if eq .Type "String"}}"{{end}}{{.DefaultValue}}{{if eq .Type "String"}}"{{end}})
@@ -269,19 +547,28 @@ func (data *{{camelCase .Name}}) fromBody(ctx context.Context, res gjson.Result)
 	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() {
 		data.{{toGoName .TfName}} = helpers.GetStringList(value.Array())
 	} else {
-		data.{{toGoName .TfName}} = types.ListNull(types.StringType)
+		data.{{toGoName .TfName}} = {{if .DefaultList}}helpers.StringListValue({{range .DefaultList}}"{{.}}", {{end}}){{else}}types.ListNull(types.StringType){{end}}
+	}
+	{{- else if eq .Type "Map"}}
+	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() {
+		data.{{toGoName .TfName}} = helpers.GetMap(value, types.{{.ElementType}}Type)
+	} else {
+		data.{{toGoName .TfName}} = types.MapNull(types.{{.ElementType}}Type)
 	}
 	{{- else if or (eq .Type "List") (eq .Type "Set")}}
+	{{- $mapKey := mapKey .}}
 	if value := res{{if .ModelName}}.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"){{end}}; value.Exists() {
 		data.{{toGoName .TfName}} = make([]{{$name}}{{toGoName .TfName}}, 0)
 		value.ForEach(func(k, v gjson.Result) bool {
 			item := {{$name}}{{toGoName .TfName}}{}
 			{{- range .Attributes}}
 			{{- $ccname := toGoName .TfName}}
-			{{- if and (not .Value) (not .WriteOnly) (not .Reference)}}
+			{{- if and $mapKey.TfName .Id}}
+			item.{{toGoName .TfName}} = types.StringValue(k.String())
+			{{- else if and (not .Value) (not .WriteOnly) (not .Reference)}}
 			{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-			if cValue := v.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); cValue.Exists() {
-				item.{{toGoName .TfName}} = types.{{.Type}}Value(cValue.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+			if cValue := v.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); cValue.Exists(){{if .TriState}} && cValue.Type != gjson.Null{{end}} {
+				item.{{toGoName .TfName}} = types.{{.Type}}Value({{if .Scale}}helpers.ScaleFromBody[{{if eq .Type "Int64"}}int64{{else}}float64{{end}}](cValue.Float(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumFromInteger(cValue.Int(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else}}cValue.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}(){{end}})
 			} else {
 				{{- if .DefaultValue}}
 				item.{{toGoName .TfName}} = types.{{.Type}}Value({{if eq .Type "String"}}"{{end}}{{.DefaultValue}}{{if eq .Type "String"}}"{{end}})
@@ -293,7 +580,13 @@ func (data *{{camelCase .Name}}) fromBody(ctx context.Context, res gjson.Result)
 			if cValue := v.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); cValue.Exists() {
 				item.{{toGoName .TfName}} = helpers.GetStringList(cValue.Array())
 			} else {
-				item.{{toGoName .TfName}} = types.ListNull(types.StringType)
+				item.{{toGoName .TfName}} = {{if .DefaultList}}helpers.StringListValue({{range .DefaultList}}"{{.}}", {{end}}){{else}}types.ListNull(types.StringType){{end}}
+			}
+			{{- else if eq .Type "Map"}}
+			if cValue := v.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); cValue.Exists() {
+				item.{{toGoName .TfName}} = helpers.GetMap(cValue, types.{{.ElementType}}Type)
+			} else {
+				item.{{toGoName .TfName}} = types.MapNull(types.{{.ElementType}}Type)
 			}
 			{{- else if or (eq .Type "List") (eq .Type "Set")}}
 			if cValue := v.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); cValue.Exists() {
@@ -303,8 +596,8 @@ func (data *{{camelCase .Name}}) fromBody(ctx context.Context, res gjson.Result)
 					{{- range .Attributes}}
 					{{- if and (not .Value) (not .WriteOnly) (not .Reference)}}
 					{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-					if ccValue := cv.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); ccValue.Exists() {
-						cItem.{{toGoName .TfName}} = types.{{.Type}}Value(ccValue.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+					if ccValue := cv.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); ccValue.Exists(){{if .TriState}} && ccValue.Type != gjson.Null{{end}} {
+						cItem.{{toGoName .TfName}} = types.{{.Type}}Value({{if .Scale}}helpers.ScaleFromBody[{{if eq .Type "Int64"}}int64{{else}}float64{{end}}](ccValue.Float(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumFromInteger(ccValue.Int(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else}}ccValue.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}(){{end}})
 					} else {
 						{{- if .DefaultValue}}
 						cItem.{{toGoName .TfName}} = types.{{.Type}}Value({{if eq .Type "String"}}"{{end}}{{.DefaultValue}}{{if eq .Type "String"}}"{{end}})
@@ -316,7 +609,7 @@ func (data *{{camelCase .Name}}) fromBody(ctx context.Context, res gjson.Result)
 					if ccValue := cv.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); ccValue.Exists() {
 						cItem.{{toGoName .TfName}} = helpers.GetStringList(ccValue.Array())
 					} else {
-						cItem.{{toGoName .TfName}} = types.ListNull(types.StringType)
+						cItem.{{toGoName .TfName}} = {{if .DefaultList}}helpers.StringListValue({{range .DefaultList}}"{{.}}", {{end}}){{else}}types.ListNull(types.StringType){{end}}
 					}
 					{{- else if or (eq .Type "List") (eq .Type "Set")}}
 					if ccValue := cv.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); ccValue.Exists() {
@@ -326,8 +619,8 @@ func (data *{{camelCase .Name}}) fromBody(ctx context.Context, res gjson.Result)
 							{{- range .Attributes}}
 							{{- if and (not .Value) (not .WriteOnly) (not .Reference)}}
 							{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-							if cccValue := ccv.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); cccValue.Exists() {
-								ccItem.{{toGoName .TfName}} = types.{{.Type}}Value(cccValue.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+							if cccValue := ccv.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); cccValue.Exists(){{if .TriState}} && cccValue.Type != gjson.Null{{end}} {
+								ccItem.{{toGoName .TfName}} = types.{{.Type}}Value({{if .Scale}}helpers.ScaleFromBody[{{if eq .Type "Int64"}}int64{{else}}float64{{end}}](cccValue.Float(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumFromInteger(cccValue.Int(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else}}cccValue.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}(){{end}})
 							} else {
 								{{- if .DefaultValue}}
 								ccItem.{{toGoName .TfName}} = types.{{.Type}}Value({{if eq .Type "String"}}"{{end}}{{.DefaultValue}}{{if eq .Type "String"}}"{{end}})
@@ -339,7 +632,7 @@ func (data *{{camelCase .Name}}) fromBody(ctx context.Context, res gjson.Result)
 							if cccValue := ccv.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); cccValue.Exists() {
 								ccItem.{{toGoName .TfName}} = helpers.GetStringList(cccValue.Array())
 							} else {
-								ccItem.{{toGoName .TfName}} = types.ListNull(types.StringType)
+								ccItem.{{toGoName .TfName}} = {{if .DefaultList}}helpers.StringListValue({{range .DefaultList}}"{{.}}", {{end}}){{else}}types.ListNull(types.StringType){{end}}
 							}
 							{{- end}}
 							{{- end}}
@@ -371,24 +664,44 @@ func (data *{{camelCase .Name}}) fromBody(ctx context.Context, res gjson.Result)
 //template:begin updateFromBody
 func (data *{{camelCase .Name}}) updateFromBody(ctx context.Context, res gjson.Result) {
 	{{- range .Attributes}}
-	{{- if and (not .Value) (not .WriteOnly) (not .Reference)}}
+	{{- if and (not .Value) (not .WriteOnly) (not .Reference) (not .EndpointParameter)}}
 	{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if not .ResourceId}} && !data.{{toGoName .TfName}}.IsNull(){{end}} {
-		data.{{toGoName .TfName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if .TriState}} && value.Type != gjson.Null{{end}}{{if not (or .ResourceId .ComposedValue .ReadEndpoint .Placement .Computed)}} && !data.{{toGoName .TfName}}.IsNull(){{end}} {
+		data.{{toGoName .TfName}} = types.{{.Type}}Value({{if .Scale}}helpers.ScaleFromBody[{{if eq .Type "Int64"}}int64{{else}}float64{{end}}](value.Float(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumFromInteger(value.Int(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else if and .Placement (len .EnumValues)}}helpers.EnumFold(value.String(), {{range .EnumValues}}"{{.}}", {{end}}){{else}}value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}(){{end}})
 	} else {{if .DefaultValue}}if data.{{toGoName .TfName}}.Value{{.Type}}() != {{if eq .Type "String"}}"{{end}}{{.DefaultValue}}{{if eq .Type "String"}}"{{end}} {{end}}{
 		data.{{toGoName .TfName}} = types.{{.Type}}Null()
 	}
+	{{- if .AcceptLegacyName}}
+	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{toGoName .AcceptLegacyName}}.IsNull() {
+		data.{{toGoName .AcceptLegacyName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+	} else {
+		data.{{toGoName .AcceptLegacyName}} = types.{{.Type}}Null()
+	}
+	{{- end}}
 	{{- else if eq .Type "StringList"}}
 	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{toGoName .TfName}}.IsNull() {
-		data.{{toGoName .TfName}} = helpers.GetStringList(value.Array())
-	} else {
+		data.{{toGoName .TfName}} = {{if .PreserveConfigOrder}}helpers.GetStringListInOrder(value.Array(), data.{{toGoName .TfName}}){{else}}helpers.GetStringList(value.Array()){{end}}
+	} else {{if .DefaultList}}if !data.{{toGoName .TfName}}.Equal(helpers.StringListValue({{range .DefaultList}}"{{.}}", {{end}})) {{end}}{
 		data.{{toGoName .TfName}} = types.ListNull(types.StringType)
 	}
+	{{- else if eq .Type "Map"}}
+	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{toGoName .TfName}}.IsNull() {
+		data.{{toGoName .TfName}} = helpers.GetMap(value, types.{{.ElementType}}Type)
+	} else {
+		data.{{toGoName .TfName}} = types.MapNull(types.{{.ElementType}}Type)
+	}
 	{{- else if or (eq .Type "List") (eq .Type "Set")}}
 	{{- $list := (toGoName .TfName)}}
+	{{- $mapKey := mapKey .}}
 	for i := range data.{{toGoName .TfName}} {
-		keys := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value))}}{{if or (eq .Type "Int64") (eq .Type "Bool") (eq .Type "String")}}"{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{end}}{{end}}{{end}} }
-		keyValues := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value))}}{{if eq .Type "Int64"}}strconv.FormatInt(data.{{$list}}[i].{{toGoName .TfName}}.ValueInt64(), 10), {{else if eq .Type "Bool"}}strconv.FormatBool(data.{{$list}}[i].{{toGoName .TfName}}.ValueBool()), {{else if eq .Type "String"}}data.{{$list}}[i].{{toGoName .TfName}}.Value{{.Type}}(), {{end}}{{end}}{{end}} }
+		{{- if $mapKey.TfName}}
+		r := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}." + gjson.Escape(data.{{$list}}[i].{{toGoName $mapKey.TfName}}.ValueString()))
+		if !r.Exists() {
+			data.{{$list}}[i].{{toGoName $mapKey.TfName}} = types.StringNull()
+		}
+		{{- else}}
+		keys := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value) (not .ComputedMetadata))}}{{if or (eq .Type "Int64") (eq .Type "Bool") (eq .Type "String")}}"{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{end}}{{end}}{{end}} }
+		keyValues := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value) (not .ComputedMetadata))}}{{if eq .Type "Int64"}}strconv.FormatInt(data.{{$list}}[i].{{toGoName .TfName}}.ValueInt64(), 10), {{else if eq .Type "Bool"}}strconv.FormatBool(data.{{$list}}[i].{{toGoName .TfName}}.ValueBool()), {{else if eq .Type "String"}}data.{{$list}}[i].{{toGoName .TfName}}.Value{{.Type}}(), {{end}}{{end}}{{end}} }
 
 		var r gjson.Result
 		res.{{if .ModelName}}Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}").{{end}}ForEach(
@@ -409,26 +722,34 @@ func (data *{{camelCase .Name}}) updateFromBody(ctx context.Context, res gjson.R
 				return true
 			},
 		)
+		{{- end}}
 
 		{{- range .Attributes}}
-		{{- if and (not .Value) (not .WriteOnly) (not .Reference)}}
+		{{- if and $mapKey.TfName .Id}}
+		{{- else if and (not .Value) (not .WriteOnly) (not .Reference)}}
 		{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-		if value := r.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{$list}}[i].{{toGoName .TfName}}.IsNull() {
-			data.{{$list}}[i].{{toGoName .TfName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+		if value := r.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if .TriState}} && value.Type != gjson.Null{{end}}{{if not .ComputedMetadata}} && !data.{{$list}}[i].{{toGoName .TfName}}.IsNull(){{end}} {
+			data.{{$list}}[i].{{toGoName .TfName}} = types.{{.Type}}Value({{if .Scale}}helpers.ScaleFromBody[{{if eq .Type "Int64"}}int64{{else}}float64{{end}}](value.Float(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumFromInteger(value.Int(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else if and .Placement (len .EnumValues)}}helpers.EnumFold(value.String(), {{range .EnumValues}}"{{.}}", {{end}}){{else}}value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}(){{end}})
 		} else {{if .DefaultValue}}if data.{{$list}}[i].{{toGoName .TfName}}.Value{{.Type}}() != {{if eq .Type "String"}}"{{end}}{{.DefaultValue}}{{if eq .Type "String"}}"{{end}} {{end}}{
 			data.{{$list}}[i].{{toGoName .TfName}} = types.{{.Type}}Null()
 		}
 		{{- else if eq .Type "StringList"}}
-		if value := r.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{$list}}[i].{{toGoName .TfName}}.IsNull() {
-			data.{{$list}}[i].{{toGoName .TfName}} = helpers.GetStringList(value.Array())
-		} else {
+		if value := r.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if not .ComputedMetadata}} && !data.{{$list}}[i].{{toGoName .TfName}}.IsNull(){{end}} {
+			data.{{$list}}[i].{{toGoName .TfName}} = {{if .PreserveConfigOrder}}helpers.GetStringListInOrder(value.Array(), data.{{$list}}[i].{{toGoName .TfName}}){{else}}helpers.GetStringList(value.Array()){{end}}
+		} else {{if .DefaultList}}if !data.{{$list}}[i].{{toGoName .TfName}}.Equal(helpers.StringListValue({{range .DefaultList}}"{{.}}", {{end}})) {{end}}{
 			data.{{$list}}[i].{{toGoName .TfName}} = types.ListNull(types.StringType)
 		}
+		{{- else if eq .Type "Map"}}
+		if value := r.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if not .ComputedMetadata}} && !data.{{$list}}[i].{{toGoName .TfName}}.IsNull(){{end}} {
+			data.{{$list}}[i].{{toGoName .TfName}} = helpers.GetMap(value, types.{{.ElementType}}Type)
+		} else {
+			data.{{$list}}[i].{{toGoName .TfName}} = types.MapNull(types.{{.ElementType}}Type)
+		}
 		{{- else if or (eq .Type "List") (eq .Type "Set")}}
 		{{- $clist := (toGoName .TfName)}}
 		for ci := range data.{{$list}}[i].{{toGoName .TfName}} {
-			keys := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value))}}{{if or (eq .Type "Int64") (eq .Type "Bool") (eq .Type "String")}}"{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{end}}{{end}}{{end}} }
-			keyValues := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value))}}{{if eq .Type "Int64"}}strconv.FormatInt(data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.ValueInt64(), 10), {{else if eq .Type "Bool"}}strconv.FormatBool(data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.ValueBool()), {{else if eq .Type "String"}}data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.Value{{.Type}}(), {{end}}{{end}}{{end}} }
+			keys := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value) (not .ComputedMetadata))}}{{if or (eq .Type "Int64") (eq .Type "Bool") (eq .Type "String")}}"{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{end}}{{end}}{{end}} }
+			keyValues := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value) (not .ComputedMetadata))}}{{if eq .Type "Int64"}}strconv.FormatInt(data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.ValueInt64(), 10), {{else if eq .Type "Bool"}}strconv.FormatBool(data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.ValueBool()), {{else if eq .Type "String"}}data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.Value{{.Type}}(), {{end}}{{end}}{{end}} }
 
 			var cr gjson.Result
 			r.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}").ForEach(
@@ -453,22 +774,22 @@ func (data *{{camelCase .Name}}) updateFromBody(ctx context.Context, res gjson.R
 			{{- range .Attributes}}
 			{{- if and (not .Value) (not .WriteOnly) (not .Reference)}}
 			{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-			if value := cr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.IsNull() {
-				data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+			if value := cr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if .TriState}} && value.Type != gjson.Null{{end}}{{if not .ComputedMetadata}} && !data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.IsNull(){{end}} {
+				data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}} = types.{{.Type}}Value({{if .Scale}}helpers.ScaleFromBody[{{if eq .Type "Int64"}}int64{{else}}float64{{end}}](value.Float(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumFromInteger(value.Int(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else if and .Placement (len .EnumValues)}}helpers.EnumFold(value.String(), {{range .EnumValues}}"{{.}}", {{end}}){{else}}value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}(){{end}})
 			} else {{if .DefaultValue}}if data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.Value{{.Type}}() != {{if eq .Type "String"}}"{{end}}{{.DefaultValue}}{{if eq .Type "String"}}"{{end}} {{end}}{
 				data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}} = types.{{.Type}}Null()
 			}
 			{{- else if eq .Type "StringList"}}
-			if value := cr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.IsNull() {
-				data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}} = helpers.GetStringList(value.Array())
-			} else {
+			if value := cr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if not .ComputedMetadata}} && !data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.IsNull(){{end}} {
+				data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}} = {{if .PreserveConfigOrder}}helpers.GetStringListInOrder(value.Array(), data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}){{else}}helpers.GetStringList(value.Array()){{end}}
+			} else {{if .DefaultList}}if !data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}}.Equal(helpers.StringListValue({{range .DefaultList}}"{{.}}", {{end}})) {{end}}{
 				data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}} = types.ListNull(types.StringType)
 			}
 			{{- else if or (eq .Type "List") (eq .Type "Set")}}
 			{{- $cclist := (toGoName .TfName)}}
 			for cci := range data.{{$list}}[i].{{$clist}}[ci].{{toGoName .TfName}} {
-				keys := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value))}}{{if or (eq .Type "Int64") (eq .Type "Bool") (eq .Type "String")}}"{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{end}}{{end}}{{end}} }
-				keyValues := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value))}}{{if eq .Type "Int64"}}strconv.FormatInt(data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.ValueInt64(), 10), {{else if eq .Type "Bool"}}strconv.FormatBool(data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.ValueBool()), {{else if eq .Type "String"}}data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.Value{{.Type}}(), {{end}}{{end}}{{end}} }
+				keys := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value) (not .ComputedMetadata))}}{{if or (eq .Type "Int64") (eq .Type "Bool") (eq .Type "String")}}"{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", {{end}}{{end}}{{end}} }
+				keyValues := [...]string{ {{$noId := not (hasId .Attributes)}}{{range .Attributes}}{{if or .Id (and $noId (not .Value) (not .ComputedMetadata))}}{{if eq .Type "Int64"}}strconv.FormatInt(data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.ValueInt64(), 10), {{else if eq .Type "Bool"}}strconv.FormatBool(data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.ValueBool()), {{else if eq .Type "String"}}data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.Value{{.Type}}(), {{end}}{{end}}{{end}} }
 
 				var ccr gjson.Result
 				cr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}").ForEach(
@@ -493,15 +814,15 @@ func (data *{{camelCase .Name}}) updateFromBody(ctx context.Context, res gjson.R
 				{{- range .Attributes}}
 				{{- if and (not .Value) (not .WriteOnly) (not .Reference)}}
 				{{- if or (eq .Type "String") (eq .Type "Int64") (eq .Type "Float64") (eq .Type "Bool")}}
-				if value := ccr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.IsNull() {
-					data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+				if value := ccr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if .TriState}} && value.Type != gjson.Null{{end}}{{if not .ComputedMetadata}} && !data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.IsNull(){{end}} {
+					data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}} = types.{{.Type}}Value({{if .Scale}}helpers.ScaleFromBody[{{if eq .Type "Int64"}}int64{{else}}float64{{end}}](value.Float(), {{.Scale}}){{else if len .EnumIntegers}}helpers.EnumFromInteger(value.Int(), []string{ {{range .EnumValues}}"{{.}}", {{end}} }, []int64{ {{range .EnumIntegers}}{{.}}, {{end}} }){{else if and .Placement (len .EnumValues)}}helpers.EnumFold(value.String(), {{range .EnumValues}}"{{.}}", {{end}}){{else}}value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}(){{end}})
 				} else {{if .DefaultValue}}if data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.Value{{.Type}}() != {{if eq .Type "String"}}"{{end}}{{.DefaultValue}}{{if eq .Type "String"}}"{{end}} {{end}}{
 					data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}} = types.{{.Type}}Null()
 				}
 				{{- else if eq .Type "StringList"}}
-				if value := ccr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() && !data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.IsNull() {
-					data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}} = helpers.GetStringList(value.Array())
-				} else {
+				if value := ccr.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists(){{if not .ComputedMetadata}} && !data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.IsNull(){{end}} {
+					data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}} = {{if .PreserveConfigOrder}}helpers.GetStringListInOrder(value.Array(), data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}){{else}}helpers.GetStringList(value.Array()){{end}}
+				} else {{if .DefaultList}}if !data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}}.Equal(helpers.StringListValue({{range .DefaultList}}"{{.}}", {{end}})) {{end}}{
 					data.{{$list}}[i].{{$clist}}[ci].{{$cclist}}[cci].{{toGoName .TfName}} = types.ListNull(types.StringType)
 				}
 				{{- end}}
@@ -525,8 +846,9 @@ func (data *{{camelCase .Name}}) updateFromBody(ctx context.Context, res gjson.R
 
 //template:begin isNull
 func (data *{{camelCase .Name}}) isNull(ctx context.Context, res gjson.Result) bool {
+	{{- $naturalKey := .NaturalKey}}
 	{{- range .Attributes}}
-	{{- if not .Value}}
+	{{- if and (not .Value) (not (contains $naturalKey .TfName))}}
 	{{- if or (eq .Type "List") (eq .Type "Set")}}
 	if len(data.{{toGoName .TfName}}) > 0 {
 		return false
@@ -536,8 +858,43 @@ func (data *{{camelCase .Name}}) isNull(ctx context.Context, res gjson.Result) b
 		return false
 	}
 	{{- end}}
+	{{- if .AcceptLegacyName}}
+	if !data.{{toGoName .AcceptLegacyName}}.IsNull() {
+		return false
+	}
+	{{- end}}
 	{{- end}}
 	{{- end}}
 	return true
 }
 //template:end isNull
+{{/* The getters of the attributes of a model type, rendered for the top level and each level of nested lists */ -}}
+{{define "getters"}}
+{{- range .Attributes}}
+{{- if not .Value}}
+
+// Get{{toGoName .TfName}} returns the value of {{.TfName}}, or the zero value if it is null or unknown
+func (data {{$.Type}}) Get{{toGoName .TfName}}() {{if eq .Type "String"}}string{{else if eq .Type "Int64"}}int64{{else if eq .Type "Float64"}}float64{{else if eq .Type "Bool"}}bool{{else if eq .Type "StringList"}}[]string{{else if eq .Type "Map"}}map[string]{{if eq .ElementType "Int64"}}int64{{else if eq .ElementType "Bool"}}bool{{else}}string{{end}}{{else}}[]{{$.Type}}{{toGoName .TfName}}{{end}} {
+	{{- if eq .Type "StringList"}}
+	if data.{{toGoName .TfName}}.IsNull() || data.{{toGoName .TfName}}.IsUnknown() {
+		return nil
+	}
+	var values []string
+	data.{{toGoName .TfName}}.ElementsAs(context.Background(), &values, false)
+	return values
+	{{- else if eq .Type "Map"}}
+	if data.{{toGoName .TfName}}.IsNull() || data.{{toGoName .TfName}}.IsUnknown() {
+		return nil
+	}
+	var values map[string]{{if eq .ElementType "Int64"}}int64{{else if eq .ElementType "Bool"}}bool{{else}}string{{end}}
+	data.{{toGoName .TfName}}.ElementsAs(context.Background(), &values, false)
+	return values
+	{{- else if or (eq .Type "List") (eq .Type "Set")}}
+	return data.{{toGoName .TfName}}
+	{{- else}}
+	return data.{{toGoName .TfName}}.Value{{.Type}}()
+	{{- end}}
+}
+{{- end}}
+{{- end}}
+{{- end -}}
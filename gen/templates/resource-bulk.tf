@@ -0,0 +1,11 @@
+resource "fmc_{{snakeCase .Name}}_bulk" "example" {
+  items = [
+    {
+      {{- range  .Attributes}}
+      {{- if and (not .ExcludeTest) (not .ExcludeExample) (not .Value) (ne .Type "List") (ne .Type "Set")}}
+      {{.TfName}} = {{if eq .Type "String"}}"{{.Example}}"{{else if eq .Type "StringList"}}["{{.Example}}"]{{else}}{{.Example}}{{end}}
+      {{- end}}
+      {{- end}}
+    }
+  ]
+}
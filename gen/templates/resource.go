@@ -28,6 +28,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -38,8 +39,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
 	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 )
@@ -50,350 +53,72 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &{{camelCase .Name}}Resource{}
 var _ resource.ResourceWithImportState = &{{camelCase .Name}}Resource{}
+{{- if or (hasComposedValue .Attributes) (hasNestingLimit .Attributes) (hasPreventCycles .Attributes) (hasExistsEndpoint .Attributes)}}
+var _ resource.ResourceWithModifyPlan = &{{camelCase .Name}}Resource{}
+{{- end}}
+{{- if or (discriminator .Attributes).Discriminator (hasImplies .Attributes)}}
+var _ resource.ResourceWithConfigValidators = &{{camelCase .Name}}Resource{}
+{{- end}}
 
 func New{{camelCase .Name}}Resource() resource.Resource {
 	return &{{camelCase .Name}}Resource{}
 }
+{{- range .PreviousResourceNames}}
+
+func New{{camelCase .}}Resource() resource.Resource {
+	return helpers.NewDeprecatedResource(&{{camelCase $.Name}}Resource{}, "{{snakeCase .}}", "{{snakeCase $.Name}}")
+}
+{{- end}}
 
 type {{camelCase .Name}}Resource struct {
-	client *fmc.Client
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
+	{{- if len .ChildEndpoints}}
+	forceDelete bool
+	{{- end}}
+	{{- if .ChangeComment}}
+	changeComment string
+	{{- end}}
+	{{- if .PreChangeSnapshot}}
+	preChangeSnapshot bool
+	{{- end}}
 }
 
 func (r *{{camelCase .Name}}Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_{{snakeCase .Name}}"
 }
+{{- if not .SplitFiles}}
+
+{{template "resourceSchema" .}}
+{{- end}}
 
-func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: helpers.NewAttributeDescription("{{.ResDescription}}").String,
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The id of the object",
-				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"domain": schema.StringAttribute{
-				MarkdownDescription: "The name of the FMC domain",
-				Optional:			true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-			{{- range  .Attributes}}
-			{{- if not .Value}}
-			"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}Attribute{
-				MarkdownDescription: helpers.NewAttributeDescription("{{.Description}}")
-					{{- if len .EnumValues -}}
-					.AddStringEnumDescription({{range .EnumValues}}"{{.}}", {{end}})
-					{{- end -}}
-					{{- if or (ne .MinInt 0) (ne .MaxInt 0) -}}
-					.AddIntegerRangeDescription({{.MinInt}}, {{.MaxInt}})
-					{{- end -}}
-					{{- if or (ne .MinFloat 0.0) (ne .MaxFloat 0.0) -}}
-					.AddFloatRangeDescription({{.MinFloat}}, {{.MaxFloat}})
-					{{- end -}}
-					{{- if .DefaultValue -}}
-					.AddDefaultValueDescription("{{.DefaultValue}}")
-					{{- end -}}
-					.String,
-				{{- if eq .Type "StringList"}}
-				ElementType:         types.StringType,
-				{{- end}}
-				{{- if or .Reference .Mandatory}}
-				Required:            true,
-				{{- else if not .ResourceId}}
-				Optional:            true,
-				{{- end}}
-				{{- if or (len .DefaultValue) .ResourceId}}
-				Computed:            true,
-				{{- end}}
-				{{- if len .EnumValues}}
-				Validators: []validator.String{
-					stringvalidator.OneOf({{range .EnumValues}}"{{.}}", {{end}}),
-				},
-				{{- else if or (len .StringPatterns) (ne .StringMinLength 0) (ne .StringMaxLength 0) }}
-				Validators: []validator.String{
-					{{- if or (ne .StringMinLength 0) (ne .StringMaxLength 0)}}
-					stringvalidator.LengthBetween({{.StringMinLength}}, {{.StringMaxLength}}),
-					{{- end}}
-					{{- range .StringPatterns}}
-					stringvalidator.RegexMatches(regexp.MustCompile(`{{.}}`), ""),
-					{{- end}}
-				},
-				{{- else if or (ne .MinInt 0) (ne .MaxInt 0)}}
-				Validators: []validator.Int64{
-					int64validator.Between({{.MinInt}}, {{.MaxInt}}),
-				},
-				{{- else if or (ne .MinFloat 0.0) (ne .MaxFloat 0.0)}}
-				Validators: []validator.Float64{
-					float64validator.Between({{.MinFloat}}, {{.MaxFloat}}),
-				},
-				{{- end}}
-				{{- if and (len .DefaultValue) (eq .Type "Int64")}}
-				Default:             int64default.StaticInt64({{.DefaultValue}}),
-				{{- else if and (len .DefaultValue) (eq .Type "Bool")}}
-				Default:             booldefault.StaticBool({{.DefaultValue}}),
-				{{- else if and (len .DefaultValue) (eq .Type "String")}}
-				Default:             stringdefault.StaticString("{{.DefaultValue}}"),
-				{{- end}}
-				{{- if or .Id .Reference .RequiresReplace}}
-				PlanModifiers: []planmodifier.{{if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}{
-					{{if eq .Type "StringList"}}list{{else}}{{snakeCase .Type}}{{end}}planmodifier.RequiresReplace(),
-				},
-				{{- end}}
-				{{- if or (eq .Type "List") (eq .Type "Set")}}
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						{{- range  .Attributes}}
-						{{- if not .Value}}
-						"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}Attribute{
-							MarkdownDescription: helpers.NewAttributeDescription("{{.Description}}")
-								{{- if len .EnumValues -}}
-								.AddStringEnumDescription({{range .EnumValues}}"{{.}}", {{end}})
-								{{- end -}}
-								{{- if or (ne .MinInt 0) (ne .MaxInt 0) -}}
-								.AddIntegerRangeDescription({{.MinInt}}, {{.MaxInt}})
-								{{- end -}}
-								{{- if or (ne .MinFloat 0.0) (ne .MaxFloat 0.0) -}}
-								.AddFloatRangeDescription({{.MinFloat}}, {{.MaxFloat}})
-								{{- end -}}
-								{{- if .DefaultValue -}}
-								.AddDefaultValueDescription("{{.DefaultValue}}")
-								{{- end -}}
-								.String,
-							{{- if eq .Type "StringList"}}
-							ElementType:         types.StringType,
-							{{- end}}
-							{{- if or .Reference .Mandatory}}
-							Required:            true,
-							{{- else}}
-							Optional:            true,
-							{{- end}}
-							{{- if len .DefaultValue}}
-							Computed:            true,
-							{{- end}}
-							{{- if len .EnumValues}}
-							Validators: []validator.String{
-								stringvalidator.OneOf({{range .EnumValues}}"{{.}}", {{end}}),
-							},
-							{{- else if or (len .StringPatterns) (ne .StringMinLength 0) (ne .StringMaxLength 0) }}
-							Validators: []validator.String{
-								{{- if or (ne .StringMinLength 0) (ne .StringMaxLength 0)}}
-								stringvalidator.LengthBetween({{.StringMinLength}}, {{.StringMaxLength}}),
-								{{- end}}
-								{{- range .StringPatterns}}
-								stringvalidator.RegexMatches(regexp.MustCompile(`{{.}}`), ""),
-								{{- end}}
-							},
-							{{- else if or (ne .MinInt 0) (ne .MaxInt 0)}}
-							Validators: []validator.Int64{
-								int64validator.Between({{.MinInt}}, {{.MaxInt}}),
-							},
-							{{- else if or (ne .MinFloat 0.0) (ne .MaxFloat 0.0)}}
-							Validators: []validator.Float64{
-								float64validator.Between({{.MinFloat}}, {{.MaxFloat}}),
-							},
-							{{- end}}
-							{{- if and (len .DefaultValue) (eq .Type "Int64")}}
-							Default:             int64default.StaticInt64({{.DefaultValue}}),
-							{{- else if and (len .DefaultValue) (eq .Type "Bool")}}
-							Default:             booldefault.StaticBool({{.DefaultValue}}),
-							{{- else if and (len .DefaultValue) (eq .Type "String")}}
-							Default:             stringdefault.StaticString("{{.DefaultValue}}"),
-							{{- end}}
-							{{- if .RequiresReplace}}
-							PlanModifiers: []planmodifier.{{if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}{
-								{{if eq .Type "StringList"}}list{{else}}{{snakeCase .Type}}{{end}}planmodifier.RequiresReplace(),
-							},
-							{{- end}}
-							{{- if or (eq .Type "List") (eq .Type "Set")}}
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									{{- range  .Attributes}}
-									{{- if not .Value}}
-									"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}Attribute{
-										MarkdownDescription: helpers.NewAttributeDescription("{{.Description}}")
-											{{- if len .EnumValues -}}
-											.AddStringEnumDescription({{range .EnumValues}}"{{.}}", {{end}})
-											{{- end -}}
-											{{- if or (ne .MinInt 0) (ne .MaxInt 0) -}}
-											.AddIntegerRangeDescription({{.MinInt}}, {{.MaxInt}})
-											{{- end -}}
-											{{- if or (ne .MinFloat 0.0) (ne .MaxFloat 0.0) -}}
-											.AddFloatRangeDescription({{.MinFloat}}, {{.MaxFloat}})
-											{{- end -}}
-											{{- if .DefaultValue -}}
-											.AddDefaultValueDescription("{{.DefaultValue}}")
-											{{- end -}}
-											.String,
-										{{- if eq .Type "StringList"}}
-										ElementType:         types.StringType,
-										{{- end}}
-										{{- if or .Reference .Mandatory}}
-										Required:            true,
-										{{- else}}
-										Optional:            true,
-										{{- end}}
-										{{- if len .DefaultValue}}
-										Computed:            true,
-										{{- end}}
-										{{- if len .EnumValues}}
-										Validators: []validator.String{
-											stringvalidator.OneOf({{range .EnumValues}}"{{.}}", {{end}}),
-										},
-										{{- else if or (len .StringPatterns) (ne .StringMinLength 0) (ne .StringMaxLength 0) }}
-										Validators: []validator.String{
-											{{- if or (ne .StringMinLength 0) (ne .StringMaxLength 0)}}
-											stringvalidator.LengthBetween({{.StringMinLength}}, {{.StringMaxLength}}),
-											{{- end}}
-											{{- range .StringPatterns}}
-											stringvalidator.RegexMatches(regexp.MustCompile(`{{.}}`), ""),
-											{{- end}}
-										},
-										{{- else if or (ne .MinInt 0) (ne .MaxInt 0)}}
-										Validators: []validator.Int64{
-											int64validator.Between({{.MinInt}}, {{.MaxInt}}),
-										},
-										{{- else if or (ne .MinFloat 0.0) (ne .MaxFloat 0.0)}}
-										Validators: []validator.Float64{
-											float64validator.Between({{.MinFloat}}, {{.MaxFloat}}),
-										},
-										{{- end}}
-										{{- if and (len .DefaultValue) (eq .Type "Int64")}}
-										Default:             int64default.StaticInt64({{.DefaultValue}}),
-										{{- else if and (len .DefaultValue) (eq .Type "Bool")}}
-										Default:             booldefault.StaticBool({{.DefaultValue}}),
-										{{- else if and (len .DefaultValue) (eq .Type "String")}}
-										Default:             stringdefault.StaticString("{{.DefaultValue}}"),
-										{{- end}}
-										{{- if .RequiresReplace}}
-										PlanModifiers: []planmodifier.{{if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}{
-											{{if eq .Type "StringList"}}list{{else}}{{snakeCase .Type}}{{end}}planmodifier.RequiresReplace(),
-										},
-										{{- end}}
-										{{- if or (eq .Type "List") (eq .Type "Set")}}
-										NestedObject: schema.NestedAttributeObject{
-											Attributes: map[string]schema.Attribute{
-												{{- range  .Attributes}}
-												{{- if not .Value}}
-												"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}Attribute{
-													MarkdownDescription: helpers.NewAttributeDescription("{{.Description}}")
-														{{- if len .EnumValues -}}
-														.AddStringEnumDescription({{range .EnumValues}}"{{.}}", {{end}})
-														{{- end -}}
-														{{- if or (ne .MinInt 0) (ne .MaxInt 0) -}}
-														.AddIntegerRangeDescription({{.MinInt}}, {{.MaxInt}})
-														{{- end -}}
-														{{- if or (ne .MinFloat 0.0) (ne .MaxFloat 0.0) -}}
-														.AddFloatRangeDescription({{.MinFloat}}, {{.MaxFloat}})
-														{{- end -}}
-														{{- if .DefaultValue -}}
-														.AddDefaultValueDescription("{{.DefaultValue}}")
-														{{- end -}}
-														.String,
-													{{- if eq .Type "StringList"}}
-													ElementType:         types.StringType,
-													{{- end}}
-													{{- if or .Reference .Mandatory}}
-													Required:            true,
-													{{- else}}
-													Optional:            true,
-													{{- end}}
-													{{- if len .DefaultValue}}
-													Computed:            true,
-													{{- end}}
-													{{- if len .EnumValues}}
-													Validators: []validator.String{
-														stringvalidator.OneOf({{range .EnumValues}}"{{.}}", {{end}}),
-													},
-													{{- else if or (len .StringPatterns) (ne .StringMinLength 0) (ne .StringMaxLength 0) }}
-													Validators: []validator.String{
-														{{- if or (ne .StringMinLength 0) (ne .StringMaxLength 0)}}
-														stringvalidator.LengthBetween({{.StringMinLength}}, {{.StringMaxLength}}),
-														{{- end}}
-														{{- range .StringPatterns}}
-														stringvalidator.RegexMatches(regexp.MustCompile(`{{.}}`), ""),
-														{{- end}}
-													},
-													{{- else if or (ne .MinInt 0) (ne .MaxInt 0)}}
-													Validators: []validator.Int64{
-														int64validator.Between({{.MinInt}}, {{.MaxInt}}),
-													},
-													{{- else if or (ne .MinFloat 0.0) (ne .MaxFloat 0.0)}}
-													Validators: []validator.Float64{
-														float64validator.Between({{.MinFloat}}, {{.MaxFloat}}),
-													},
-													{{- end}}
-													{{- if and (len .DefaultValue) (eq .Type "Int64")}}
-													Default:             int64default.StaticInt64({{.DefaultValue}}),
-													{{- else if and (len .DefaultValue) (eq .Type "Bool")}}
-													Default:             booldefault.StaticBool({{.DefaultValue}}),
-													{{- else if and (len .DefaultValue) (eq .Type "String")}}
-													Default:             stringdefault.StaticString("{{.DefaultValue}}"),
-													{{- end}}
-													{{- if .RequiresReplace}}
-													PlanModifiers: []planmodifier.{{if eq .Type "StringList"}}List{{else}}{{.Type}}{{end}}{
-														{{if eq .Type "StringList"}}list{{else}}{{snakeCase .Type}}{{end}}planmodifier.RequiresReplace(),
-													},
-													{{- end}}
-												},
-												{{- end}}
-												{{- end}}
-											},
-										},
-										{{- if or (ne .MinList 0) (ne .MaxList 0)}}
-										Validators: []validator.List{
-											{{- if ne .MinList 0}}
-											listvalidator.SizeAtLeast({{.MinList}}),
-											{{- end}}
-											{{- if ne .MaxList 0}}
-											listvalidator.SizeAtMost({{.MaxList}}),
-											{{- end}}
-										},
-										{{- end}}
-										{{- end}}
-									},
-									{{- end}}
-									{{- end}}
-								},
-							},
-							{{- if or (ne .MinList 0) (ne .MaxList 0)}}
-							Validators: []validator.List{
-								{{- if ne .MinList 0}}
-								listvalidator.SizeAtLeast({{.MinList}}),
-								{{- end}}
-								{{- if ne .MaxList 0}}
-								listvalidator.SizeAtMost({{.MaxList}}),
-								{{- end}}
-							},
-							{{- end}}
-							{{- end}}
-						},
-						{{- end}}
-						{{- end}}
-					},
-				},
-				{{- if or (ne .MinList 0) (ne .MaxList 0)}}
-				Validators: []validator.List{
-					{{- if ne .MinList 0}}
-					listvalidator.SizeAtLeast({{.MinList}}),
-					{{- end}}
-					{{- if ne .MaxList 0}}
-					listvalidator.SizeAtMost({{.MaxList}}),
-					{{- end}}
-				},
-				{{- end}}
-				{{- end}}
-			},
+{{- $discriminator := discriminator .Attributes}}
+{{- if or $discriminator.Discriminator (hasImplies .Attributes)}}
+
+func (r *{{camelCase .Name}}Resource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		{{- if $discriminator.Discriminator}}
+		helpers.DiscriminatorValidator("{{$discriminator.TfName}}", map[string][]string{
+			{{- range .Attributes}}
+			{{- if len .DiscriminatorValues}}
+			"{{.TfName}}": { {{range .DiscriminatorValues}}"{{.}}", {{end}} },
+			{{- end}}
 			{{- end}}
+		}{{range .Attributes}}{{if .RequiredForValues}}, "{{.TfName}}"{{end}}{{end}}),
+		{{- end}}
+		{{- if hasImplies .Attributes}}
+		helpers.ImpliesValidator(map[string][]string{
+			{{- range .Attributes}}
+			{{- if len .Implies}}
+			"{{.TfName}}": { {{range .Implies}}"{{.}}", {{end}} },
 			{{- end}}
-		},
+			{{- end}}
+		}),
+		{{- end}}
 	}
 }
+{{- end}}
 
 func (r *{{camelCase .Name}}Resource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -401,7 +126,162 @@ func (r *{{camelCase .Name}}Resource) Configure(_ context.Context, req resource.
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	r.logger = req.ProviderData.(*FmcProviderData).Logger{{with logRedactPatterns .Attributes}}.WithRedaction({{range .}}{{printf "%q" .}}, {{end}}){{end}}
+	{{- if len .ChildEndpoints}}
+	r.forceDelete = req.ProviderData.(*FmcProviderData).ForceDelete
+	{{- end}}
+	{{- if .ChangeComment}}
+	r.changeComment = req.ProviderData.(*FmcProviderData).ChangeComment
+	{{- end}}
+	{{- if .PreChangeSnapshot}}
+	r.preChangeSnapshot = req.ProviderData.(*FmcProviderData).PreChangeSnapshot
+	{{- end}}
+}
+{{- if or (hasComposedValue .Attributes) (hasNestingLimit .Attributes) (hasPreventCycles .Attributes) (hasExistsEndpoint .Attributes)}}
+
+func (r *{{camelCase .Name}}Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to predict when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	{{- range .Attributes}}
+	{{- if .NestingLimit}}
+
+	// Only the depth of existing member groups is known, unknown members and members of other types are skipped
+	var domain types.String
+	var members types.{{.Type}}
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("{{.TfName}}"), &members)...)
+	if r.client != nil && !resp.Diagnostics.HasError() && !domain.IsUnknown() {
+		reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+		if !domain.IsNull() && domain.ValueString() != "" {
+			reqMods = append(reqMods, fmc.DomainName(domain.ValueString()))
+		}
+		resolver := helpers.NewNestingResolver(r.clients.Client(r.client, domain.ValueString()), "{{$.RestEndpoint}}", "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", "id", "{{.GroupType}}", reqMods...)
+		depth := 1
+		for _, element := range members.Elements() {
+			attributes := element.(types.Object).Attributes()
+			id, ok := attributes["id"].(types.String)
+			typ, _ := attributes["type"].(types.String)
+			if !ok || id.IsUnknown() || id.IsNull() || !resolver.IsGroup(typ.ValueString()) {
+				continue
+			}
+			d, err := resolver.Depth(id.ValueString())
+			if err != nil {
+				r.logger.Trace(ctx, fmt.Sprintf("Skipping nesting check of {{.TfName}}: %s", err))
+				depth = 0
+				break
+			}
+			if d+1 > depth {
+				depth = d + 1
+			}
+		}
+		if depth > {{.NestingLimit}} {
+			resp.Diagnostics.AddAttributeWarning(path.Root("{{.TfName}}"), "Nesting Too Deep", fmt.Sprintf("The members of this group would nest groups %d levels deep, FMC supports at most {{.NestingLimit}} levels", depth))
+		}
+	}
+	{{- end}}
+	{{- end}}
+	{{- range .Attributes}}
+	{{- if .PreventCycles}}
+
+	// A group can not contain itself, the nested members are resolved to reject a cycle before FMC does, new
+	// groups can not be a member of existing groups yet
+	{
+		var id, domain types.String
+		var members types.{{.Type}}
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("{{.TfName}}"), &members)...)
+		if r.client != nil && !resp.Diagnostics.HasError() && !domain.IsUnknown() && !id.IsUnknown() && !id.IsNull() {
+			reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+			if !domain.IsNull() && domain.ValueString() != "" {
+				reqMods = append(reqMods, fmc.DomainName(domain.ValueString()))
+			}
+			resolver := helpers.NewNestingResolver(r.clients.Client(r.client, domain.ValueString()), "{{$.RestEndpoint}}", "{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}", "id", "{{.GroupType}}", reqMods...)
+			ids := make([]string, 0, len(members.Elements()))
+			for _, element := range members.Elements() {
+				attributes := element.(types.Object).Attributes()
+				member, ok := attributes["id"].(types.String)
+				typ, _ := attributes["type"].(types.String)
+				if ok && !member.IsUnknown() && !member.IsNull() && resolver.IsGroup(typ.ValueString()) {
+					ids = append(ids, member.ValueString())
+				}
+			}
+			cycle, err := resolver.Cycle(id.ValueString(), ids)
+			if err != nil {
+				resp.Diagnostics.AddAttributeWarning(path.Root("{{.TfName}}"), "Members Not Checked", fmt.Sprintf("Failed to check the members for a membership cycle, got error: %s", err))
+			} else if cycle != nil && len(cycle) == 0 {
+				resp.Diagnostics.AddAttributeError(path.Root("{{.TfName}}"), "Membership Cycle", "The group can not be a member of itself")
+			} else if cycle != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("{{.TfName}}"), "Membership Cycle", fmt.Sprintf("The group can not contain %s, which already contains this group: %s -> this group", cycle[0], strings.Join(cycle, " -> ")))
+			}
+		}
+	}
+	{{- end}}
+	{{- end}}
+	{{- range .Attributes}}
+	{{- if .ExistsEndpoint}}
+
+	// The value must exist on FMC, the check is skipped with a warning if the objects can not be listed
+	{
+		var plan {{camelCase $.Name}}
+		var state types.String
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if !req.State.Raw.IsNull() {
+			// Unchanged values are not checked again
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("{{.TfName}}"), &state)...)
+		}
+		if r.client != nil && !resp.Diagnostics.HasError() && !plan.Domain.IsUnknown() && !plan.{{toGoName .TfName}}.IsUnknown() && !plan.{{toGoName .TfName}}.IsNull() && !plan.{{toGoName .TfName}}.Equal(state){{range $.Attributes}}{{if .Reference}} && !plan.{{toGoName .TfName}}.IsUnknown(){{end}}{{end}} {
+			reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+			if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+				reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+			}
+			endpoint := {{if hasReference $.Attributes}}fmt.Sprintf("{{.ExistsEndpoint}}"{{range $.Attributes}}{{if .Reference}}, plan.{{toGoName .TfName}}.ValueString(){{end}}{{end}}){{else}}"{{.ExistsEndpoint}}"{{end}}
+			exists, err := helpers.ValueExists(r.clients.Client(r.client, plan.Domain.ValueString()), endpoint, "{{.ExistsField}}", plan.{{toGoName .TfName}}.ValueString(), reqMods...)
+			if err != nil {
+				resp.Diagnostics.AddAttributeWarning(path.Root("{{.TfName}}"), "Value Not Checked", fmt.Sprintf("Failed to check that '%s' exists, got error: %s", plan.{{toGoName .TfName}}.ValueString(), err))
+			} else if !exists {
+				resp.Diagnostics.AddAttributeError(path.Root("{{.TfName}}"), "Invalid Attribute Value", fmt.Sprintf("No object with {{.ExistsField}} '%s' found, the value must exist on FMC", plan.{{toGoName .TfName}}.ValueString()))
+			}
+		}
+	}
+	{{- end}}
+	{{- end}}
+	{{- if hasComposedValue .Attributes}}
+
+	var plan {{camelCase .Name}}
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compose computed values if all inputs are set, without an input there is no value and with an unknown
+	// input the value remains unknown
+	{{- range .Attributes}}
+	{{- if .ComposedValue}}
+	{{- $inputs := composedInputs $.Attributes .ComposedValue}}
+	{{- if len $inputs}}
+	if {{range $i, $e := $inputs}}{{if $i}} || {{end}}plan.{{toGoName $e.TfName}}.IsNull(){{end}} {
+		plan.{{toGoName .TfName}} = types.StringNull()
+	} else if {{range $i, $e := $inputs}}{{if $i}} && {{end}}!plan.{{toGoName $e.TfName}}.IsUnknown(){{end}} {
+		plan.{{toGoName .TfName}} = types.StringValue(fmt.Sprintf("{{composedFormat .ComposedValue}}"{{range $inputs}}, plan.{{toGoName .TfName}}.Value{{.Type}}(){{end}}))
+	}
+	{{- else}}
+	plan.{{toGoName .TfName}} = types.StringValue("{{.ComposedValue}}")
+	{{- end}}
+	{{- end}}
+	{{- end}}
+
+	diags = resp.Plan.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	{{- end}}
 }
+{{- end}}
 //template:end model
 
 //template:begin create
@@ -416,40 +296,244 @@ func (r *{{camelCase .Name}}Resource) Create(ctx context.Context, req resource.C
 	}
 
 	// Set request domain if provided
-	reqMods := [](func(*fmc.Req)){}
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+	{{- if hasEndpointParameter .Attributes}}
+
+	if err := plan.checkPath(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to build REST endpoint, got error: %s", err))
+		return
+	}
+	{{- end}}
+
+	{{- if .AutoCreateParent.Endpoint}}
+	{{- $parent := .AutoCreateParent}}
+
+	// Look up the parent by name, or create it if requested
+	parentOwned := false
+	if plan.{{toGoName $parent.Reference}}.ValueString() == "" {
+		if plan.{{toGoName $parent.NameAttribute}}.ValueString() == "" {
+			resp.Diagnostics.AddError("Client Error", "Either {{$parent.Reference}} or {{$parent.NameAttribute}} must be configured")
+			return
+		}
+		parentId, owned, err := helpers.EnsureParent(client, "{{$parent.Endpoint}}", plan.{{toGoName $parent.NameAttribute}}.ValueString(), `{{$parent.Body}}`, plan.{{toGoName $parent.FlagAttribute}}.ValueBool(), reqMods...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve parent object, got error: %s", err))
+			return
+		}
+		plan.{{toGoName $parent.Reference}} = types.StringValue(parentId)
+		parentOwned = owned
+	}
+	{{- end}}
 
 	// Create object
+	{{- if hasLookup .Attributes}}
+	if err := plan.resolveReferences(ctx, client, reqMods...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to resolve referenced objects, got error: %s", err))
+		return
+	}
+	{{- end}}
+	{{- if eq .ContentType "multipart"}}
+	form, err := plan.toMultipart(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to build multipart form, got error: %s", err))
+		return
+	}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request form with parts %s", plan.Id.ValueString(), form))
+	{{- else}}
 	body := plan.toBody(ctx, {{camelCase .Name}}{})
+	{{- if .ChangeComment}}
+	if r.changeComment != "" {
+		// FMC adds the comment to the comment history of the object
+		body, _ = sjson.Set(body, "newComments.-1", r.changeComment)
+	}
+	{{- end}}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	{{- end}}
 
-	{{- if .PutCreate}}
-	res, err := r.client.Put(plan.getPath(), body, reqMods...)
+	{{- if and (len .NaturalKey) .PutCreate}}
+	obj, err := r.lookup(ctx, client, plan, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, obj.String()))
+		return
+	}
+	if !obj.Exists() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to find object with key: %s", plan.naturalKey()))
+		return
+	}
+	body, _ = sjson.Set(body, "id", obj.Get("id").String())
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath() + "/" + obj.Get("id").String(), body, reqMods...)
+	})
+	{{- else if .PutCreate}}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath(), body, reqMods...)
+	})
+	{{- else if .TwoPhaseCreate}}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Post(plan.getPath(), plan.toInitialBody(ctx), {{if .IgnoreWarnings}}append(reqMods, helpers.IgnoreWarnings){{else}}reqMods{{end}}...)
+	})
+	{{- else if eq .ContentType "multipart"}}
+	res, err := helpers.PostMultipart(client, plan.getPath(), form, reqMods...)
+	{{- else if hasQueryParameter .Attributes}}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Post(plan.getPath(), body, append(reqMods, {{if .IgnoreWarnings}}helpers.IgnoreWarnings, {{end}}plan.setQueryParameters)...)
+	})
 	{{- else}}
-	res, err := r.client.Post(plan.getPath(), body, reqMods...)
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Post(plan.getPath(), body, {{if .IgnoreWarnings}}append(reqMods, helpers.IgnoreWarnings){{else}}reqMods{{end}}...)
+	})
+	{{- end}}
+	{{- if .IgnoreWarnings}}
+	// The object has been created despite the warnings, which are surfaced to the user
+	for _, warning := range fmcerrors.Warnings(err, res) {
+		r.logger.Warning(ctx, fmt.Sprintf("%s: Create returned warning: %s", res.Get("{{range .CreateDataPath}}{{.}}.{{end}}id").String(), warning))
+		resp.Diagnostics.AddWarning("FMC Warning", warning)
+		err = nil
+	}
 	{{- end}}
+	if err == nil {
+		// FMC reports failed asynchronous and bulk operations in the body of a successful response
+		err = fmcerrors.EmbeddedError(res)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %s, %s", err, res.String()))
+		{{- if .AutoCreateParent.Endpoint}}
+		// Roll back the parent created for this object, which would otherwise not be managed by Terraform
+		if parentOwned {
+			if res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+				return client.Delete("{{.AutoCreateParent.Endpoint}}/" + plan.{{toGoName .AutoCreateParent.Reference}}.ValueString(), reqMods...)
+			}); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to roll back parent object %s (DELETE), got error: %s, %s", plan.{{toGoName .AutoCreateParent.Reference}}.ValueString(), err, res.String()))
+			}
+		}
+		{{- end}}
+		return
+	}
+	{{- if len .NaturalKey}}
+	plan.Id = types.StringValue(plan.naturalKey())
+	{{- else}}
+	{{- if len .CreateDataPath}}
+	// The create response nests the ID differently than the read response
+	{{- end}}
+	plan.Id = types.StringValue(res.Get("{{range .CreateDataPath}}{{.}}.{{end}}id").String())
+	{{- end}}
+	{{- if .SurfaceWarnings}}
+	for _, warning := range fmcerrors.ResponseWarnings(res) {
+		r.logger.Warning(ctx, fmt.Sprintf("%s: Create returned warning: %s", plan.Id.ValueString(), warning))
+		resp.Diagnostics.AddWarning("FMC Warning", warning)
+	}
+	{{- end}}
+	{{- if .TwoPhaseCreate}}
+
+	// Apply the full configuration to the object reserved by the first request
+	body, _ = sjson.Set(body, "id", plan.Id.ValueString())
+	res, err = fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath() + "/" + plan.Id.ValueString(), body, reqMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		// Roll back the reserved object, which would otherwise not be managed by Terraform
+		if res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+			return client.Delete(plan.getPath() + "/" + plan.Id.ValueString(), reqMods...)
+		}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to roll back reserved object %s (DELETE), got error: %s, %s", plan.Id.ValueString(), err, res.String()))
+		}
+		return
+	}
+	{{- if .SurfaceWarnings}}
+	for _, warning := range fmcerrors.ResponseWarnings(res) {
+		r.logger.Warning(ctx, fmt.Sprintf("%s: Create returned warning: %s", plan.Id.ValueString(), warning))
+		resp.Diagnostics.AddWarning("FMC Warning", warning)
+	}
+	{{- end}}
+	{{- end}}
+	{{- range .Attributes}}
+	{{- if .AutoAssigned}}
+	if plan.{{toGoName .TfName}}.IsUnknown() {
+		// The value was left unset and is assigned by FMC
+		if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() {
+			plan.{{toGoName .TfName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else}}String{{end}}())
+		} else {
+			plan.{{toGoName .TfName}} = types.{{.Type}}Null()
+		}
+	}
+	{{- end}}
+	{{- end}}
+
+	{{- if and (or (hasResourceId .Attributes) (hasComputed .Attributes) (len .ReadEndpoints) (len .EnrichRead)) (len .NaturalKey)}}
+	res, err = r.lookup(ctx, client, plan, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+		{{- if or (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = plan.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
 		return
 	}
-	plan.Id = types.StringValue(res.Get("id").String())
+	{{- end}}
+	plan.updateFromBody(ctx, res)
+	{{- else if .SkipReadAfterCreate}}
 
-	{{- if hasResourceId .Attributes}}
-	res, err = r.client.Get(plan.getPath() + "/" + plan.Id.ValueString(), reqMods...)
+	// FMC may return stale data right after create, so the object is not read back, the state keeps the
+	// planned values and takes the computed IDs from the create response
+	{{- range .Attributes}}
+	{{- if or .ResourceId .Computed}}
+	if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() {
+		plan.{{toGoName .TfName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else if eq .Type "Float64"}}Float{{else}}{{.Type}}{{end}}())
+	} else {
+		plan.{{toGoName .TfName}} = types.{{.Type}}Null()
+	}
+	{{- end}}
+	{{- end}}
+	{{- else if or (hasResourceId .Attributes) (hasComputed .Attributes) (hasPlacement .Attributes) (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = client.Get(plan.getPath() + "/" + plan.Id.ValueString(), {{if .ReadExpanded}}append(reqMods, helpers.Expanded){{else}}reqMods{{end}}...)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
 		return
 	}
+		{{- if or (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = plan.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
+	{{- end}}
 	plan.updateFromBody(ctx, res)
 	{{- end}}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+	{{- if .PostApplyCheck.Field}}
+
+	// The apply only completes once the object reached the expected status, the object is kept in the state
+	// either way
+	checkCtx, cancel := context.WithTimeout(ctx, {{.PostApplyCheck.Timeout}}*time.Second)
+	defer cancel()
+	if _, err := helpers.WaitForStatus(checkCtx, client, plan.getPath()+"/"+plan.Id.ValueString()+"{{.PostApplyCheck.Path}}", "{{.PostApplyCheck.Field}}", "{{.PostApplyCheck.Value}}", reqMods...); err != nil {
+		resp.Diagnostics.AddError("Post-Apply Check Failed", fmt.Sprintf("%s: Object did not reach the expected status, got error: %s", plan.Id.ValueString(), err))
+	}
+	{{- end}}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	{{- if .AutoCreateParent.Endpoint}}
+	if parentOwned {
+		// Track the ownership of the parent, a pre-existing parent must not be deleted with this object
+		diags = resp.Private.SetKey(ctx, "parent_owned", []byte("true"))
+		resp.Diagnostics.Append(diags...)
+	}
+	{{- end}}
 }
 //template:end create
 
@@ -465,21 +549,68 @@ func (r *{{camelCase .Name}}Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Set request domain if provided
-	reqMods := [](func(*fmc.Req)){}
+	client := r.clients.Client(r.client, state.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+	{{- if hasEndpointParameter .Attributes}}
+
+	if err := state.checkPath(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to build REST endpoint, got error: %s", err))
+		return
+	}
+	{{- end}}
+{{- if len .NaturalKey}}
 
-	res, err := r.client.Get(state.getPath() + "/" + state.Id.ValueString(), reqMods...)
-	if err != nil && strings.Contains(err.Error(), "StatusCode 404") {
+	res, err := r.lookup(ctx, client, state, reqMods...)
+	if err == nil && !res.Exists() {
 		resp.State.RemoveResource(ctx)
 		return
 	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
 		return
 	}
+{{- else}}
+
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath() + "/" + state.Id.ValueString(), {{if .ReadExpanded}}append(reqMods, helpers.Expanded){{else}}reqMods{{end}}...)
+	})
+	{{- if .TrackByName}}
+	if fmcerrors.IsNotFound(err, res) {
+		// FMC may have assigned a new ID to the object, which is looked up again by its name
+		res, err = r.lookupByName(ctx, client, &state, reqMods...)
+		if err == nil && !res.Exists() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+	if err != nil {
+	{{- else}}
+	if fmcerrors.IsNotFound(err, res) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+	{{- end}}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+	{{- end}}
+	{{- if or (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = state.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
+	{{- end}}
+	{{- if .PartialRead}}
+
+	// Only the fields read by the attributes are kept from the large response
+	res = helpers.Project(res, {{range partialReadPaths .Attributes}}"{{.}}", {{end}})
+	{{- end}}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", state.Id.ValueString(), len(res.Raw)))
 
 	// If every attribute is set to null we are dealing with an import operation and therefore reading all attributes
 	if state.isNull(ctx, res) {
@@ -488,11 +619,51 @@ func (r *{{camelCase .Name}}Resource) Read(ctx context.Context, req resource.Rea
 		state.updateFromBody(ctx, res)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+{{- if .TrackByName}}
+
+// lookupByName retrieves the object with the name of the state and updates the ID of the state, the result
+// does not exist if there is no such object
+func (r *{{camelCase .Name}}Resource) lookupByName(ctx context.Context, client *fmc.Client, state *{{camelCase .Name}}, reqMods ...func(*fmc.Req)) (fmc.Res, error) {
+	resolver := helpers.NewNameResolver()
+	resolver.Add(state.getPath(), state.Name.ValueString())
+	if err := resolver.Resolve(client, reqMods...); errors.Is(err, helpers.ErrNameNotFound) {
+		return fmc.Res{}, nil
+	} else if err != nil {
+		return fmc.Res{}, err
+	}
+	id := resolver.Id(state.getPath(), state.Name.ValueString())
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Object not found, using ID %s of the object with name %s", state.Id.ValueString(), id, state.Name.ValueString()))
+	state.Id = types.StringValue(id)
+	return client.Get(state.getPath() + "/" + id, {{if .ReadExpanded}}append(reqMods, helpers.Expanded){{else}}reqMods{{end}}...)
+}
+{{- end}}
+{{- if len .NaturalKey}}
+
+// lookup retrieves the object matching the natural key, the result does not exist if there is no such object
+func (r *{{camelCase .Name}}Resource) lookup(ctx context.Context, client *fmc.Client, data {{camelCase .Name}}, reqMods ...func(*fmc.Req)) (gjson.Result, error) {
+	offset := 0
+	limit := 1000
+	for {
+		queryString := fmt.Sprintf("?limit=%d&offset=%d&expanded=true", limit, offset)
+		res, err := client.Get(data.getPath() + queryString, reqMods...)
+		if err != nil {
+			return res, err
+		}
+		if obj := data.findByNaturalKey(res); obj.Exists() {
+			return obj, nil
+		}
+		if !res.Get("paging.next.0").Exists() {
+			return gjson.Result{}, nil
+		}
+		offset += limit
+	}
+}
+{{- end}}
 //template:end read
 
 //template:begin update
@@ -512,33 +683,209 @@ func (r *{{camelCase .Name}}Resource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	{{- if not .NoUpdate}}
+
 	// Set request domain if provided
-	reqMods := [](func(*fmc.Req)){}
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
 	}
+	{{- end}}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+	{{- if hasEndpointParameter .Attributes}}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+	if err := plan.checkPath(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to build REST endpoint, got error: %s", err))
+		return
+	}
+	{{- end}}
 	{{- if not .NoUpdate}}
+	{{- if .PreChangeSnapshot}}
+
+	if r.preChangeSnapshot {
+		snapshotId, err := helpers.Snapshot(ctx, client, state.Id.ValueString(), reqMods...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create snapshot before update, got error: %s", err))
+			return
+		}
+		r.logger.Summary(ctx, fmt.Sprintf("%s: Created snapshot %s before update", state.Id.ValueString(), snapshotId))
+	}
+	{{- end}}
+	{{- if hasRecreateOnChange .Attributes}}
+	if {{$first := true}}{{range .Attributes}}{{if .RecreateOnChange}}{{if not $first}} || {{end}}{{$first = false}}!plan.{{toGoName .TfName}}.Equal(state.{{toGoName .TfName}}){{end}}{{end}} {
+		// The changed attributes can not be updated, the object is deleted and created again within the update
+		r.logger.Summary(ctx, fmt.Sprintf("%s: Recreating object", state.Id.ValueString()))
+		res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+			return client.Delete(state.getPath() + "/" + state.Id.ValueString(), reqMods...)
+		})
+		if err != nil && !fmcerrors.IsNotFound(err, res) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
+			return
+		}
+		createResp := resource.CreateResponse{State: tfsdk.State{Schema: resp.State.Schema, Raw: tftypes.NewValue(resp.State.Schema.Type().TerraformType(ctx), nil)}, Private: resp.Private}
+		r.Create(ctx, resource.CreateRequest{Config: req.Config, Plan: req.Plan, ProviderMeta: req.ProviderMeta}, &createResp)
+		resp.Diagnostics.Append(createResp.Diagnostics...)
+		if createResp.State.Raw.IsNull() {
+			// The object is gone, it is created again by the next apply
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.State = createResp.State
+		return
+	}
+	{{- end}}
+	{{- if hasLookup .Attributes}}
+	if err := plan.resolveReferences(ctx, client, reqMods...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to resolve referenced objects, got error: %s", err))
+		return
+	}
+	{{- end}}
 
 	body := plan.toBody(ctx, state)
-	res, err := r.client.Put(plan.getPath() + "/" + plan.Id.ValueString(), body, reqMods...)
+	{{- if .ChangeComment}}
+	if r.changeComment != "" {
+		// FMC adds the comment to the comment history of the object
+		body, _ = sjson.Set(body, "newComments.-1", r.changeComment)
+	}
+	{{- end}}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	{{- if len .NaturalKey}}
+	obj, err := r.lookup(ctx, client, state, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, obj.String()))
+		return
+	}
+	body, _ = sjson.Set(body, "id", obj.Get("id").String())
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath() + "/" + obj.Get("id").String(), body, reqMods...)
+	})
+	{{- else if (deltaUpdate .Attributes).TfName}}
+	{{- $delta := deltaUpdate .Attributes}}
+	var res fmc.Res
+	var err error
+	if added, removed, ok := helpers.MembershipDelta(body, state.toBody(ctx, state), "{{range $delta.DataPath}}{{.}}.{{end}}{{$delta.ModelName}}", "{{range $delta.Attributes}}{{if .Id}}{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}{{end}}{{end}}"); ok {
+		// Only the members changed, these are added and removed instead of configuring the whole object
+		r.logger.Trace(ctx, fmt.Sprintf("%s: Adding %d and removing %d members of {{$delta.TfName}}", plan.Id.ValueString(), len(added), len(removed)))
+		res, err = helpers.PatchMembers(client, plan.getPath() + "/" + plan.Id.ValueString(), "{{range $delta.DataPath}}{{.}}.{{end}}{{$delta.ModelName}}", added, removed, reqMods...)
+		if err == nil {
+			err = fmcerrors.EmbeddedError(res)
+		}
+		if err != nil {
+			// The FMC may not support changing the members, the whole object is configured instead
+			r.logger.Trace(ctx, fmt.Sprintf("%s: Changing members failed, configuring whole object: %s", plan.Id.ValueString(), err))
+			res, err = fmcerrors.Retry(client, func() (fmc.Res, error) {
+				return client.Put(plan.getPath() + "/" + plan.Id.ValueString(), body, reqMods...)
+			})
+		}
+	} else {
+		res, err = fmcerrors.Retry(client, func() (fmc.Res, error) {
+			return client.Put(plan.getPath() + "/" + plan.Id.ValueString(), body, reqMods...)
+		})
+	}
+	{{- else if .MoveEndpoint.Path}}
+	var res fmc.Res
+	var err error
+	if !plan.{{toGoName .MoveEndpoint.Attribute}}.IsNull() && !plan.{{toGoName .MoveEndpoint.Attribute}}.Equal(state.{{toGoName .MoveEndpoint.Attribute}}) {
+		// The object is moved to its new position instead of being recreated
+		r.logger.Trace(ctx, fmt.Sprintf("%s: Moving object to position %d", plan.Id.ValueString(), plan.{{toGoName .MoveEndpoint.Attribute}}.ValueInt64()))
+		res, err = fmcerrors.Retry(client, func() (fmc.Res, error) {
+			return client.Put(fmt.Sprintf("%s/%s{{.MoveEndpoint.Path}}?{{.MoveEndpoint.Parameter}}=%d", plan.getPath(), plan.Id.ValueString(), plan.{{toGoName .MoveEndpoint.Attribute}}.ValueInt64()), "{}", reqMods...)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to move object (PUT), got error: %s, %s", err, res.String()))
+			return
+		}
+	}
+	if body != state.toBody(ctx, state) {
+		res, err = fmcerrors.Retry(client, func() (fmc.Res, error) {
+			return client.Put(plan.getPath() + "/" + plan.Id.ValueString(), body, reqMods...)
+		})
+	}
+	{{- else if hasPlacement .Attributes}}
+	putMods := reqMods
+	if {{$first := true}}{{range .Attributes}}{{if .Placement}}{{if not $first}} || {{end}}{{$first = false}}!plan.{{toGoName .TfName}}.Equal(state.{{toGoName .TfName}}){{end}}{{end}} {
+		// The object is placed into its new category or section
+		putMods = append(putMods, plan.setPlacementParameters)
+	}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath() + "/" + plan.Id.ValueString(), body, putMods...)
+	})
+	{{- else}}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath() + "/" + plan.Id.ValueString(), body, reqMods...)
+	})
+	{{- end}}
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
 		return
 	}
+	{{- if .SurfaceWarnings}}
+	for _, warning := range fmcerrors.ResponseWarnings(res) {
+		r.logger.Warning(ctx, fmt.Sprintf("%s: Update returned warning: %s", plan.Id.ValueString(), warning))
+		resp.Diagnostics.AddWarning("FMC Warning", warning)
+	}
+	{{- end}}
+	{{- range .Attributes}}
+	{{- if .AutoAssigned}}
+	if plan.{{toGoName .TfName}}.IsUnknown() {
+		// The value was left unset and is assigned by FMC
+		if value := res.Get("{{range .DataPath}}{{.}}.{{end}}{{.ModelName}}"); value.Exists() {
+			plan.{{toGoName .TfName}} = types.{{.Type}}Value(value.{{if eq .Type "Int64"}}Int{{else}}String{{end}}())
+		} else {
+			plan.{{toGoName .TfName}} = types.{{.Type}}Null()
+		}
+	}
+	{{- end}}
+	{{- end}}
 
-	{{- if hasResourceId .Attributes}}
-	res, err = r.client.Get(plan.getPath() + "/" + plan.Id.ValueString(), reqMods...)
+	{{- if and (or (hasResourceId .Attributes) (hasComputed .Attributes) (len .ReadEndpoints) (len .EnrichRead)) (len .NaturalKey)}}
+	res, err = r.lookup(ctx, client, plan, reqMods...)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
 		return
 	}
+		{{- if or (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = plan.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
+	{{- end}}
 	plan.updateFromBody(ctx, res)
+	{{- else if or (hasResourceId .Attributes) (hasComputed .Attributes) (hasPlacement .Attributes) (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = client.Get(plan.getPath() + "/" + plan.Id.ValueString(), {{if .ReadExpanded}}append(reqMods, helpers.Expanded){{else}}reqMods{{end}}...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+		{{- if or (len .ReadEndpoints) (len .EnrichRead)}}
+	res, err = plan.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
+	{{- end}}
+	plan.updateFromBody(ctx, res)
+	{{- end}}
 	{{- end}}
+
+	{{- if .PostApplyCheck.Field}}
+
+	// The apply only completes once the object reached the expected status, the object is kept in the state
+	// either way
+	checkCtx, cancel := context.WithTimeout(ctx, {{.PostApplyCheck.Timeout}}*time.Second)
+	defer cancel()
+	if _, err := helpers.WaitForStatus(checkCtx, client, plan.getPath()+"/"+plan.Id.ValueString()+"{{.PostApplyCheck.Path}}", "{{.PostApplyCheck.Field}}", "{{.PostApplyCheck.Value}}", reqMods...); err != nil {
+		resp.Diagnostics.AddError("Post-Apply Check Failed", fmt.Sprintf("%s: Object did not reach the expected status, got error: %s", plan.Id.ValueString(), err))
+	}
 	{{- end}}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -557,22 +904,131 @@ func (r *{{camelCase .Name}}Resource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Set request domain if provided
-	reqMods := [](func(*fmc.Req)){}
+	{{- if or (not .NoDelete) .SoftDelete}}
+	client := r.clients.Client(r.client, state.Domain.ValueString())
+	{{- end}}
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+	{{- if hasEndpointParameter .Attributes}}
+
+	if err := state.checkPath(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to build REST endpoint, got error: %s", err))
+		return
+	}
+	{{- end}}
+	{{- if and .PreChangeSnapshot (or (not .NoDelete) .SoftDelete)}}
+
+	if r.preChangeSnapshot {
+		snapshotId, err := helpers.Snapshot(ctx, client, state.Id.ValueString(), reqMods...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create snapshot before delete, got error: %s", err))
+			return
+		}
+		r.logger.Summary(ctx, fmt.Sprintf("%s: Created snapshot %s before delete", state.Id.ValueString(), snapshotId))
+	}
+	{{- end}}
+
+	{{- if .SoftDelete}}
 
-	{{- if not .NoDelete}}
-	res, err := r.client.Delete(state.getPath() + "/" + state.Id.ValueString(), reqMods...)
+	// The object is disabled instead of deleted and only removed from the state
+	disabled := state
+	disabled.{{toGoName .SoftDelete}} = types.BoolValue(false)
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(state.getPath() + "/" + state.Id.ValueString(), disabled.toBody(ctx, state), reqMods...)
+	})
+	if err != nil && !fmcerrors.IsNotFound(err, res) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to disable object (PUT), got error: %s, %s", err, res.String()))
+		return
+	}
+	{{- else if not .NoDelete}}
+	{{- if len .ChildEndpoints}}
+
+	if r.forceDelete {
+		// Child objects need to be deleted first, otherwise FMC refuses to delete the object
+		for _, childPath := range []string{ {{range .ChildEndpoints}}"{{.}}", {{end}} } {
+			childPath = state.getPath() + "/" + state.Id.ValueString() + childPath
+			var childIds []string
+			offset := 0
+			limit := 1000
+			for {
+				queryString := fmt.Sprintf("?limit=%d&offset=%d", limit, offset)
+				res, err := client.Get(childPath + queryString, reqMods...)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve child objects (GET), got error: %s, %s", err, res.String()))
+					return
+				}
+				res.Get("items").ForEach(func(k, v gjson.Result) bool {
+					childIds = append(childIds, v.Get("id").String())
+					return true
+				})
+				if !res.Get("paging.next.0").Exists() {
+					break
+				}
+				offset += limit
+			}
+			for _, childId := range childIds {
+				r.logger.Warning(ctx, fmt.Sprintf("%s: Force delete of child object %s", state.Id.ValueString(), childPath + "/" + childId))
+				res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+					return client.Delete(childPath + "/" + childId, reqMods...)
+				})
+				if err != nil && !fmcerrors.IsNotFound(err, res) {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete child object (DELETE), got error: %s, %s", err, res.String()))
+					return
+				}
+			}
+		}
+	}
+
+	{{- end}}
+	{{- if len .NaturalKey}}
+	obj, err := r.lookup(ctx, client, state, reqMods...)
 	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, obj.String()))
+		return
+	}
+	if !obj.Exists() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Delete(state.getPath() + "/" + obj.Get("id").String(), reqMods...)
+	})
+	{{- else if .DeleteEndpoint}}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Delete(fmt.Sprintf("{{.DeleteEndpoint}}"{{range .Attributes}}{{if .Reference}}, state.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}, state.Id.ValueString()), reqMods...)
+	})
+	{{- else}}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Delete(state.getPath() + "/" + state.Id.ValueString(), reqMods...)
+	})
+	{{- end}}
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil && !fmcerrors.IsNotFound(err, res) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
 		return
 	}
+	{{- if .AutoCreateParent.Endpoint}}
+
+	// Only a parent created for this object is deleted with it
+	if owned, _ := req.Private.GetKey(ctx, "parent_owned"); string(owned) == "true" {
+		res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+			return client.Delete("{{.AutoCreateParent.Endpoint}}/" + state.{{toGoName .AutoCreateParent.Reference}}.ValueString(), reqMods...)
+		})
+		if err != nil && !fmcerrors.IsNotFound(err, res) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete parent object (DELETE), got error: %s, %s", err, res.String()))
+			return
+		}
+	}
+	{{- end}}
 	{{- end}}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
 
 	resp.State.RemoveResource(ctx)
 }
@@ -580,6 +1036,77 @@ func (r *{{camelCase .Name}}Resource) Delete(ctx context.Context, req resource.D
 
 //template:begin import
 func (r *{{camelCase .Name}}Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	{{- if len .NaturalKey}}
+	{{- $keys := attributesByName .Attributes .NaturalKey}}
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != {{len $keys}}{{range $i, $e := $keys}} || idParts[{{$i}}] == ""{{end}} {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: {{range $i, $e := $keys}}{{if $i}},{{end}}<{{$e.TfName}}>{{end}}. Got: %q", req.ID),
+		)
+		return
+	}
+	{{- range $i, $e := $keys}}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("{{$e.TfName}}"), idParts[{{$i}}])...)
+	{{- end}}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	{{- else if or (hasEndpointParameter .Attributes) (hasReference .Attributes)}}
+	// The REST endpoint depends on the IDs of parent objects and on endpoint parameters, which are part of the
+	// import identifier
+	parameters := []string{ {{range .Attributes}}{{if or .EndpointParameter .Reference}}"{{.TfName}}", {{end}}{{end}} }
+	idParts := strings.Split(req.ID, ",")
+
+	valid := len(idParts) == len(parameters)+1
+	for _, part := range idParts {
+		if part == "" {
+			valid = false
+		}
+	}
+	if !valid {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: {{range .Attributes}}{{if or .EndpointParameter .Reference}}<{{.TfName}}>,{{end}}{{end}}<id>. Got: %q", req.ID),
+		)
+		return
+	}
+	for i, parameter := range parameters {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parameter), idParts[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[len(parameters)])...)
+	{{- else}}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	{{- end}}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object is retrieved right away, so a missing object is reported with the import identifier instead
+	// of failing the refresh after the import
+	var state {{camelCase .Name}}
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.clients.Client(r.client, "")
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+	{{- if len .NaturalKey}}
+	res, err := r.lookup(ctx, client, state, reqMods...)
+	if err == nil && !res.Exists() {
+		resp.Diagnostics.AddError("Object Not Found", fmt.Sprintf("Object with key %s not found, it can not be imported", req.ID))
+		return
+	}
+	{{- else}}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath() + "/" + state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
+		resp.Diagnostics.AddError("Object Not Found", fmt.Sprintf("Object with id %s not found, it can not be imported", state.Id.ValueString()))
+		return
+	}
+	{{- end}}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+	}
 }
 //template:end import
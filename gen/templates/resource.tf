@@ -1,6 +1,6 @@
 resource "fmc_{{snakeCase .Name}}" "example" {
 {{- range  .Attributes}}
-{{- if and (not .ExcludeTest) (not .ExcludeExample) (not .Value) (not .ResourceId)}}
+{{- if and (not .ExcludeTest) (not .ExcludeExample) (not .Value) (not .ResourceId) (not .ComposedValue)}}
 {{- if or (eq .Type "List") (eq .Type "Set")}}
   {{.TfName}} = [
     {
@@ -35,9 +35,20 @@ resource "fmc_{{snakeCase .Name}}" "example" {
       {{- end}}
     }
   ]
+{{- else if $.ExampleVariabilize}}
+  {{.TfName}} = var.{{.TfName}}
 {{- else}}
   {{.TfName}} = {{if eq .Type "String"}}"{{.Example}}"{{else if eq .Type "StringList"}}["{{.Example}}"]{{else}}{{.Example}}{{end}}
 {{- end}}
 {{- end}}
 {{- end}}
 }
+
+output "{{snakeCase .Name}}" {
+  value = {
+    id = fmc_{{snakeCase .Name}}.example.id
+    {{- range exampleOutputs .Attributes}}
+    {{.TfName}} = fmc_{{snakeCase $.Name}}.example.{{.TfName}}
+    {{- end}}
+  }
+}
@@ -0,0 +1,423 @@
+//go:build ignore
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+)
+//template:end imports
+
+//template:begin model
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &{{camelCase .Name}}BulkResource{}
+
+func New{{camelCase .Name}}BulkResource() resource.Resource {
+	return &{{camelCase .Name}}BulkResource{}
+}
+
+type {{camelCase .Name}}BulkResource struct {
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
+}
+
+type {{camelCase .Name}}Bulk struct {
+	Id     types.String `tfsdk:"id"`
+	Domain types.String `tfsdk:"domain"`
+	Items  []{{camelCase .Name}}BulkItems `tfsdk:"items"`
+}
+
+type {{camelCase .Name}}BulkItems struct {
+	Id types.String `tfsdk:"id"`
+{{- range .Attributes}}
+{{- if not .Value}}
+{{- if or (eq .Type "List") (eq .Type "Set")}}
+	{{toGoName .TfName}} []{{camelCase $.Name}}{{toGoName .TfName}} `tfsdk:"{{.TfName}}"`
+{{- else if eq .Type "StringList"}}
+	{{toGoName .TfName}} types.List `tfsdk:"{{.TfName}}"`
+{{- else}}
+	{{toGoName .TfName}} types.{{.Type}} `tfsdk:"{{.TfName}}"`
+{{- end}}
+{{- end}}
+{{- end}}
+}
+
+// object returns the item as single object, whose model builds and reads the bodies of the bulk requests
+func (item {{camelCase .Name}}BulkItems) object() {{camelCase .Name}} {
+	return {{camelCase .Name}}{
+		Id: item.Id,
+		{{- range .Attributes}}
+		{{- if not .Value}}
+		{{toGoName .TfName}}: item.{{toGoName .TfName}},
+		{{- end}}
+		{{- end}}
+	}
+}
+
+// fromObject sets the attributes of the item from the single object
+func (item *{{camelCase .Name}}BulkItems) fromObject(object {{camelCase .Name}}) {
+	item.Id = object.Id
+	{{- range .Attributes}}
+	{{- if not .Value}}
+	item.{{toGoName .TfName}} = object.{{toGoName .TfName}}
+	{{- end}}
+	{{- end}}
+}
+
+func (r *{{camelCase .Name}}BulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_{{snakeCase .Name}}_bulk"
+}
+
+func (r *{{camelCase .Name}}BulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The items have the attributes of the resource of a single object
+	single := resource.SchemaResponse{}
+	(&{{camelCase .Name}}Resource{}).Schema(ctx, resource.SchemaRequest{}, &single)
+	attributes := map[string]schema.Attribute{}
+	for name, attribute := range single.Schema.Attributes {
+		if name != "domain" {
+			attributes[name] = attribute
+		}
+	}
+	// Items are matched by name, the ID of an item at the same position may change
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The id of the object",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource manages many {{toLower .Name}} objects with bulk requests, the items are identified by their name. Objects which FMC fails to delete are kept in the state.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the first object created by the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the FMC domain",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The objects, the attributes are the ones of the `fmc_{{snakeCase .Name}}` resource.").String,
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *{{camelCase .Name}}BulkResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	r.logger = req.ProviderData.(*FmcProviderData).Logger{{with logRedactPatterns .Attributes}}.WithRedaction({{range .}}{{printf "%q" .}}, {{end}}){{end}}
+}
+
+// create creates the given items with a single request and returns the items FMC created, with their IDs
+func (r *{{camelCase .Name}}BulkResource) create(ctx context.Context, client *fmc.Client, items []{{camelCase .Name}}BulkItems, reqMods ...func(*fmc.Req)) ([]{{camelCase .Name}}BulkItems, error) {
+	bodies := make([]string, 0, len(items))
+	for _, item := range items {
+		bodies = append(bodies, item.object().toBody(ctx, {{camelCase .Name}}{}))
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, helpers.BulkTimeout)
+	defer cancel()
+	ids, err := helpers.BulkCreate(waitCtx, client, {{camelCase .Name}}{}.getPath(), bodies, reqMods...)
+	created := make([]{{camelCase .Name}}BulkItems, 0, len(items))
+	var missing []string
+	for _, item := range items {
+		if id := ids[item.Name.ValueString()]; id != "" {
+			item.Id = types.StringValue(id)
+			created = append(created, item)
+		} else {
+			missing = append(missing, item.Name.ValueString())
+		}
+	}
+	if err == nil && len(missing) > 0 {
+		err = fmt.Errorf("objects not created: %s", strings.Join(missing, ", "))
+	}
+	return created, err
+}
+
+// delete deletes the given items with a single request and returns the items which still exist
+func (r *{{camelCase .Name}}BulkResource) delete(ctx context.Context, client *fmc.Client, items []{{camelCase .Name}}BulkItems, reqMods ...func(*fmc.Req)) ([]{{camelCase .Name}}BulkItems, error) {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id.ValueString())
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, helpers.BulkTimeout)
+	defer cancel()
+	deleted, err := helpers.BulkDelete(waitCtx, client, {{camelCase .Name}}{}.getPath(), ids, reqMods...)
+	gone := make(map[string]bool)
+	for _, id := range deleted {
+		gone[id] = true
+	}
+	kept := make([]{{camelCase .Name}}BulkItems, 0)
+	for _, item := range items {
+		if !gone[item.Id.ValueString()] {
+			kept = append(kept, item)
+		}
+	}
+	return kept, err
+}
+//template:end model
+
+//template:begin create
+func (r *{{camelCase .Name}}BulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan {{camelCase .Name}}Bulk
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("Beginning Create of %d objects", len(plan.Items)))
+
+	created, err := r.create(ctx, client, plan.Items, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create objects (POST), got error: %s", err))
+		if len(created) == 0 {
+			return
+		}
+	}
+	// The objects created are kept in the state even if others failed
+	plan.Items = created
+	plan.Id = created[0].Id
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Create finished, %d objects created", plan.Id.ValueString(), len(created)))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+//template:end create
+
+//template:begin read
+func (r *{{camelCase .Name}}BulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state {{camelCase .Name}}Bulk
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	res, err := helpers.GetAllPages(client, {{camelCase .Name}}{}.getPath()+"?expanded=true", reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve objects (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+	objects := make(map[string]gjson.Result)
+	res.Get("items").ForEach(func(_, v gjson.Result) bool {
+		objects[v.Get("id").String()] = v
+		return true
+	})
+
+	// Objects deleted outside of Terraform are removed from the items
+	items := make([]{{camelCase .Name}}BulkItems, 0, len(state.Items))
+	for _, item := range state.Items {
+		if body, ok := objects[item.Id.ValueString()]; ok {
+			object := item.object()
+			object.updateFromBody(ctx, body)
+			item.fromObject(object)
+			items = append(items, item)
+		}
+	}
+	state.Items = items
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully, %d objects", state.Id.ValueString(), len(items)))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+//template:end read
+
+//template:begin update
+func (r *{{camelCase .Name}}BulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state {{camelCase .Name}}Bulk
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read state
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	// Items are matched with the state by name, removed items are deleted and new items are created
+	current := make(map[string]{{camelCase .Name}}BulkItems)
+	for _, item := range state.Items {
+		current[item.Name.ValueString()] = item
+	}
+	planned := make(map[string]bool)
+	var added []{{camelCase .Name}}BulkItems
+	for _, item := range plan.Items {
+		planned[item.Name.ValueString()] = true
+		if _, ok := current[item.Name.ValueString()]; !ok {
+			added = append(added, item)
+		}
+	}
+	var removed []{{camelCase .Name}}BulkItems
+	for _, item := range state.Items {
+		if !planned[item.Name.ValueString()] {
+			removed = append(removed, item)
+		}
+	}
+
+	kept, err := r.delete(ctx, client, removed, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete objects (DELETE), got error: %s", err))
+	}
+	created, err := r.create(ctx, client, added, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create objects (POST), got error: %s", err))
+	}
+	ids := make(map[string]types.String)
+	for _, item := range created {
+		ids[item.Name.ValueString()] = item.Id
+	}
+
+	items := make([]{{camelCase .Name}}BulkItems, 0, len(plan.Items)+len(kept))
+	for _, item := range plan.Items {
+		if existing, ok := current[item.Name.ValueString()]; ok {
+			item.Id = existing.Id
+			body := item.object().toBody(ctx, existing.object())
+			if body != existing.object().toBody(ctx, existing.object()) {
+				res, err := client.Put({{camelCase .Name}}{}.getPath()+"/"+item.Id.ValueString(), body, reqMods...)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object %s (PUT), got error: %s, %s", item.Id.ValueString(), err, res.String()))
+					item = existing
+				}
+			}
+		} else if id, ok := ids[item.Name.ValueString()]; ok {
+			item.Id = id
+		} else {
+			continue
+		}
+		items = append(items, item)
+	}
+	// Objects which could not be deleted are kept in the state, so a later apply deletes them again
+	plan.Items = append(items, kept...)
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Update finished, %d objects", plan.Id.ValueString(), len(plan.Items)))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+//template:end update
+
+//template:begin delete
+func (r *{{camelCase .Name}}BulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state {{camelCase .Name}}Bulk
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){ {{- if logRedactPatterns $.Attributes}}fmc.NoLogPayload{{end -}} }
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Delete of %d objects", state.Id.ValueString(), len(state.Items)))
+
+	kept, err := r.delete(ctx, client, state.Items, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete objects (DELETE), got error: %s", err))
+		if len(kept) > 0 {
+			// Only the objects FMC confirmed deleted are removed from the state
+			state.Items = kept
+			diags = resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+		}
+		return
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+//template:end delete
@@ -38,14 +38,14 @@ func TestAccFmc{{camelCase .Name}}(t *testing.T) {
 	var checks []resource.TestCheckFunc
 	{{- $name := .Name }}
 	{{- range  .Attributes}}
-	{{- if and (not .WriteOnly) (not .ExcludeTest) (not .Value) (not .TestValue) (not .ResourceId)}}
+	{{- if and (not .WriteOnly) (not .ExcludeTest) (not .Value) (not .TestValue) (not .ResourceId) (ne .Type "Map")}}
 	{{- if or (eq .Type "List") (eq .Type "Set")}}
 	{{- $list := .TfName }}
 	{{- if len .TestTags}}
 	if {{range $i, $e := .TestTags}}{{if $i}} || {{end}}os.Getenv("{{$e}}") != ""{{end}} {
 	{{- end}}
 	{{- range  .Attributes}}
-	{{- if and (not .WriteOnly) (not .ExcludeTest) (not .Value) (not .TestValue)}}
+	{{- if and (not .WriteOnly) (not .ExcludeTest) (not .Value) (not .TestValue) (ne .Type "Map")}}
 	{{- if or (eq .Type "List") (eq .Type "Set")}}
 	{{- $clist := .TfName }}
 	{{- if len .TestTags}}
@@ -113,30 +113,109 @@ func TestAccFmc{{camelCase .Name}}(t *testing.T) {
 	{{- end}}
 
 	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
 	{{- if not .SkipMinimumTest}}
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
 			Config: {{if .TestPrerequisites}}testAccFmc{{camelCase .Name}}PrerequisitesConfig+{{end}}testAccFmc{{camelCase .Name}}Config_minimum(),
 		})
+		{{- $action := testUpdateAction .Attributes}}
+		{{- if eq $action "Update"}}
+		// Attributes which do not require replacement must be updated in place
+		{{- else if eq $action "Replace"}}
+		// The full configuration changes attributes which require replacement
+		{{- end}}
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_{{snakeCase $name}}.test", plancheck.ResourceAction{{$action}}))
 	}
 	{{- end}}
+	{{- range .Attributes}}
+	{{- if .ComposedValue}}
+	planChecks = append(planChecks, testAccExpectPlannedValue("fmc_{{snakeCase $name}}.test", "{{.TfName}}", "{{.Example}}"))
+	{{- end}}
+	{{- end}}
 	steps = append(steps, resource.TestStep{
 		Config: {{if .TestPrerequisites}}testAccFmc{{camelCase .Name}}PrerequisitesConfig+{{end}}testAccFmc{{camelCase .Name}}Config_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
 		Check: resource.ComposeTestCheckFunc(checks...),
 	})
-	{{- if not (hasReference .Attributes)}}
 	steps = append(steps, resource.TestStep{
 		ResourceName:  "fmc_{{snakeCase $name}}.test",
 		ImportState:   true,
+		{{- if and (not (len .NaturalKey)) (or (hasEndpointParameter .Attributes) (hasReference .Attributes))}}
+		ImportStateIdFunc: func(s *terraform.State) (string, error) {
+			attributes := s.RootModule().Resources["fmc_{{snakeCase $name}}.test"].Primary.Attributes
+			return strings.Join([]string{ {{range .Attributes}}{{if or .EndpointParameter .Reference}}attributes["{{.TfName}}"], {{end}}{{end}}attributes["id"]}, ","), nil
+		},
+		{{- end}}
+	})
+	{{- if .TestDisappears}}
+	// Delete the object out-of-band, the next plan must recreate it
+	steps = append(steps, resource.TestStep{
+		Config: {{if .TestPrerequisites}}testAccFmc{{camelCase .Name}}PrerequisitesConfig+{{end}}testAccFmc{{camelCase .Name}}Config_all(),
+		Check: testAccCheckDisappears("fmc_{{snakeCase $name}}.test", func(attributes map[string]string) string {
+			{{- if hasReference .Attributes}}
+			return fmt.Sprintf("{{.RestEndpoint}}"{{range .Attributes}}{{if .Reference}}, attributes["{{.TfName}}"]{{end}}{{end}}) + "/" + attributes["id"]
+			{{- else}}
+			return "{{.RestEndpoint}}/" + attributes["id"]
+			{{- end}}
+		}),
+		ExpectNonEmptyPlan: true,
+	})
+	steps = append(steps, resource.TestStep{
+		Config: {{if .TestPrerequisites}}testAccFmc{{camelCase .Name}}PrerequisitesConfig+{{end}}testAccFmc{{camelCase .Name}}Config_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{plancheck.ExpectResourceAction("fmc_{{snakeCase $name}}.test", plancheck.ResourceActionCreate)},
+		},
 	})
 	{{- end}}
 	
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { {{if .MinimumVersion}}testAccPreCheckMinimumVersion(t, "{{.MinimumVersion}}"){{else}}testAccPreCheck(t){{end}} },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: steps,
 	})
 }
+{{- if len .RelatedResources}}
+
+// Create the object together with its related objects, check the relationship after reading the objects
+// back and that destroying them in dependency order removes all of them
+func TestAccFmc{{camelCase .Name}}Related(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { {{if .MinimumVersion}}testAccPreCheckMinimumVersion(t, "{{.MinimumVersion}}"){{else}}testAccPreCheck(t){{end}} },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmc{{camelCase .Name}}PrerequisitesConfig+testAccFmc{{camelCase .Name}}Config_all(),
+				Check: resource.ComposeTestCheckFunc(
+					{{- range .RelatedResources}}
+					resource.TestCheckResourceAttrPair("fmc_{{snakeCase $name}}.test", "{{.Attribute}}", "fmc_{{snakeCase .Resource}}.test", "id"),
+					{{- $resource := .Resource}}
+					{{- range $attribute, $related := .Checks}}
+					resource.TestCheckResourceAttrPair("fmc_{{snakeCase $name}}.test", "{{$attribute}}", "fmc_{{snakeCase $resource}}.test", "{{$related}}"),
+					{{- end}}
+					{{- end}}
+				),
+			},
+		},
+		CheckDestroy: testAccCheckDestroyed(map[string]func(attributes map[string]string) string{
+			"fmc_{{snakeCase $name}}.test": func(attributes map[string]string) string {
+				{{- if hasReference .Attributes}}
+				return fmt.Sprintf("{{.RestEndpoint}}"{{range .Attributes}}{{if .Reference}}, attributes["{{.TfName}}"]{{end}}{{end}}) + "/" + attributes["id"]
+				{{- else}}
+				return "{{.RestEndpoint}}/" + attributes["id"]
+				{{- end}}
+			},
+			{{- range .RelatedResources}}
+			"fmc_{{snakeCase .Resource}}.test": func(attributes map[string]string) string {
+				return "{{.Endpoint}}/" + attributes["id"]
+			},
+			{{- end}}
+		}),
+	})
+}
+{{- end}}
 //template:end testAcc
 
 //template:begin testPrerequisites
@@ -236,7 +315,7 @@ func testAccFmc{{camelCase .Name}}Config_minimum() string {
 func testAccFmc{{camelCase .Name}}Config_all() string {
 	config := `resource "fmc_{{snakeCase $name}}" "test" {` + "\n"
 	{{- range  .Attributes}}
-	{{- if and (not .ExcludeTest) (not .Value) (not .ResourceId)}}
+	{{- if and (not .ExcludeTest) (not .Value) (not .ResourceId) (not .ComposedValue)}}
 	{{- if or (eq .Type "List") (eq .Type "Set")}}
 	{{- if len .TestTags}}
 	if {{range $i, $e := .TestTags}}{{if $i}} || {{end}}os.Getenv("{{$e}}") != ""{{end}} {
@@ -0,0 +1,12 @@
+{{- range $i, $e := exampleVariables .Attributes}}
+{{- if $i}}{{"\n\n"}}{{end -}}
+variable "{{.TfName}}" {
+  {{- if .Description}}
+  description = {{printf "%q" .Description}}
+  {{- end}}
+  type        = {{tfVariableType .Type}}
+  {{- if not .Mandatory}}
+  default     = null
+  {{- end}}
+}
+{{- end}}
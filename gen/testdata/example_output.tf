@@ -0,0 +1,11 @@
+resource "fmc_example_output" "example" {
+  name = "NAME1"
+}
+
+output "example_output" {
+  value = {
+    id = fmc_example_output.example.id
+    rules_id = fmc_example_output.example.rules_id
+    kind = fmc_example_output.example.kind
+  }
+}
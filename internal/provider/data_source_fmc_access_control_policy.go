@@ -29,8 +29,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/tidwall/gjson"
 )
 
@@ -49,7 +50,9 @@ func NewAccessControlPolicyDataSource() datasource.DataSource {
 }
 
 type AccessControlPolicyDataSource struct {
-	client *fmc.Client
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
 }
 
 func (d *AccessControlPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -104,6 +107,10 @@ func (d *AccessControlPolicyDataSource) Schema(ctx context.Context, req datasour
 				MarkdownDescription: "Indicating whether the device will send events to a syslog server.",
 				Computed:            true,
 			},
+			"base_policy_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the base policy this policy inherits from.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -122,6 +129,8 @@ func (d *AccessControlPolicyDataSource) Configure(_ context.Context, req datasou
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	d.logger = req.ProviderData.(*FmcProviderData).Logger
 }
 
 //template:end model
@@ -138,18 +147,19 @@ func (d *AccessControlPolicyDataSource) Read(ctx context.Context, req datasource
 	}
 
 	// Set request domain if provided
+	client := d.clients.Client(d.client, config.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
 	if config.Id.IsNull() && !config.Name.IsNull() {
 		offset := 0
 		limit := 1000
 		for page := 1; ; page++ {
 			queryString := fmt.Sprintf("?limit=%d&offset=%d", limit, offset)
-			res, err := d.client.Get(config.getPath()+queryString, reqMods...)
+			res, err := client.Get(config.getPath()+queryString, reqMods...)
 			if err != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve objects, got error: %s", err))
 				return
@@ -158,7 +168,7 @@ func (d *AccessControlPolicyDataSource) Read(ctx context.Context, req datasource
 				value.ForEach(func(k, v gjson.Result) bool {
 					if config.Name.ValueString() == v.Get("name").String() {
 						config.Id = types.StringValue(v.Get("id").String())
-						tflog.Debug(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.String(), config.Name.ValueString(), config.Id.String()))
+						d.logger.Summary(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.String(), config.Name.ValueString(), config.Id.String()))
 						return false
 					}
 					return true
@@ -176,15 +186,23 @@ func (d *AccessControlPolicyDataSource) Read(ctx context.Context, req datasource
 		}
 	}
 
-	res, err := d.client.Get(config.getPath()+"/"+config.Id.ValueString(), reqMods...)
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(config.getPath()+"/"+config.Id.ValueString(), reqMods...)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+	res, err = config.readEndpoints(ctx, client, res, reqMods...)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
 		return
 	}
+	d.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", config.Id.ValueString(), len(res.Raw)))
 
 	config.fromBody(ctx, res)
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -29,8 +29,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/tidwall/gjson"
 )
 
@@ -49,7 +50,9 @@ func NewAccessControlPolicyCategoryDataSource() datasource.DataSource {
 }
 
 type AccessControlPolicyCategoryDataSource struct {
-	client *fmc.Client
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
 }
 
 func (d *AccessControlPolicyCategoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -98,6 +101,8 @@ func (d *AccessControlPolicyCategoryDataSource) Configure(_ context.Context, req
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	d.logger = req.ProviderData.(*FmcProviderData).Logger
 }
 
 //template:end model
@@ -106,26 +111,34 @@ func (d *AccessControlPolicyCategoryDataSource) Configure(_ context.Context, req
 func (d *AccessControlPolicyCategoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config AccessControlPolicyCategory
 
-	// Read config
-	diags := req.Config.Get(ctx, &config)
+	// Read config, the schema of the data source differs from the model as it exposes last_modified or omits
+	// write-only attributes which FMC never returns
+	var object types.Object
+	diags := req.Config.Get(ctx, &object)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = helpers.ObjectAs(ctx, object, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Set request domain if provided
+	client := d.clients.Client(d.client, config.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
 	if config.Id.IsNull() && !config.Name.IsNull() {
 		offset := 0
 		limit := 1000
 		for page := 1; ; page++ {
 			queryString := fmt.Sprintf("?limit=%d&offset=%d", limit, offset)
-			res, err := d.client.Get(config.getPath()+queryString, reqMods...)
+			res, err := client.Get(config.getPath()+queryString, reqMods...)
 			if err != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve objects, got error: %s", err))
 				return
@@ -134,7 +147,7 @@ func (d *AccessControlPolicyCategoryDataSource) Read(ctx context.Context, req da
 				value.ForEach(func(k, v gjson.Result) bool {
 					if config.Name.ValueString() == v.Get("name").String() {
 						config.Id = types.StringValue(v.Get("id").String())
-						tflog.Debug(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.String(), config.Name.ValueString(), config.Id.String()))
+						d.logger.Summary(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.String(), config.Name.ValueString(), config.Id.String()))
 						return false
 					}
 					return true
@@ -152,17 +165,25 @@ func (d *AccessControlPolicyCategoryDataSource) Read(ctx context.Context, req da
 		}
 	}
 
-	res, err := d.client.Get(config.getPath()+"/"+config.Id.ValueString(), reqMods...)
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(config.getPath()+"/"+config.Id.ValueString(), reqMods...)
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
 		return
 	}
+	d.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", config.Id.ValueString(), len(res.Raw)))
 
 	config.fromBody(ctx, res)
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	diags = resp.State.Set(ctx, &config)
+	object, diags = helpers.ObjectFrom(ctx, object.AttributeTypes(ctx), config, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, object)
 	resp.Diagnostics.Append(diags...)
 }
 
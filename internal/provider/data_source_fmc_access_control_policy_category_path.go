@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+//template:end imports
+
+//template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AccessControlPolicyCategoryPathDataSource{}
+	_ datasource.DataSourceWithConfigure = &AccessControlPolicyCategoryPathDataSource{}
+)
+
+func NewAccessControlPolicyCategoryPathDataSource() datasource.DataSource {
+	return &AccessControlPolicyCategoryPathDataSource{}
+}
+
+type AccessControlPolicyCategoryPathDataSource struct {
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
+}
+
+func (d *AccessControlPolicyCategoryPathDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_control_policy_category_path"
+}
+
+func (d *AccessControlPolicyCategoryPathDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source resolves the access control policy category ID from the names of the objects along its path.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the FMC domain",
+				Optional:            true,
+			},
+			"access_control_policy_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the access control policy.",
+				Required:            true,
+			},
+			"access_control_policy_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the access control policy.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the access control policy category.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (d *AccessControlPolicyCategoryPathDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	d.logger = req.ProviderData.(*FmcProviderData).Logger
+}
+
+//template:end model
+
+//template:begin read
+func (d *AccessControlPolicyCategoryPathDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AccessControlPolicyCategoryPath
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := d.clients.Client(d.client, config.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+	}
+
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+
+	ids, err := helpers.ResolvePath(client, []helpers.PathSegment{
+		{Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies", Name: config.AccessControlPolicyName.ValueString()},
+		{Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories", Name: config.Name.ValueString()},
+	}, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to resolve path, got error: %s", err))
+		return
+	}
+	config.AccessControlPolicyId = types.StringValue(ids[0])
+	config.Id = types.StringValue(ids[1])
+
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+//template:end read
@@ -0,0 +1,195 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+//template:end imports
+
+//template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AccessControlPolicyDiffDataSource{}
+	_ datasource.DataSourceWithConfigure = &AccessControlPolicyDiffDataSource{}
+)
+
+func NewAccessControlPolicyDiffDataSource() datasource.DataSource {
+	return &AccessControlPolicyDiffDataSource{}
+}
+
+type AccessControlPolicyDiffDataSource struct {
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
+}
+
+type AccessControlPolicyDiff struct {
+	Id          types.String                         `tfsdk:"id"`
+	Domain      types.String                         `tfsdk:"domain"`
+	FirstId     types.String                         `tfsdk:"first_id"`
+	SecondId    types.String                         `tfsdk:"second_id"`
+	Differences []AccessControlPolicyDiffDifferences `tfsdk:"differences"`
+}
+
+type AccessControlPolicyDiffDifferences struct {
+	Attribute   types.String `tfsdk:"attribute"`
+	FirstValue  types.String `tfsdk:"first_value"`
+	SecondValue types.String `tfsdk:"second_value"`
+}
+
+func (d *AccessControlPolicyDiffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_control_policy_diff"
+}
+
+func (d *AccessControlPolicyDiffDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source compares two access control policy objects and returns the attributes with different values, the attributes are the ones of the `fmc_access_control_policy` data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the FMC domain",
+				Optional:            true,
+			},
+			"first_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the first object.",
+				Required:            true,
+			},
+			"second_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the second object.",
+				Required:            true,
+			},
+			"differences": schema.ListNestedAttribute{
+				MarkdownDescription: "List of attributes with different values, ordered by attribute path.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute": schema.StringAttribute{
+							MarkdownDescription: "Path of the attribute, e.g. `objects[0].id`, list elements are compared by position.",
+							Computed:            true,
+						},
+						"first_value": schema.StringAttribute{
+							MarkdownDescription: "Value of the attribute in the first object, null if it is not set.",
+							Computed:            true,
+						},
+						"second_value": schema.StringAttribute{
+							MarkdownDescription: "Value of the attribute in the second object, null if it is not set.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccessControlPolicyDiffDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	d.logger = req.ProviderData.(*FmcProviderData).Logger
+}
+
+//template:end model
+
+//template:begin read
+func (d *AccessControlPolicyDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AccessControlPolicyDiff
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := d.clients.Client(d.client, config.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+	}
+
+	d.logger.Summary(ctx, fmt.Sprintf("%s, %s: Beginning Read", config.FirstId.String(), config.SecondId.String()))
+
+	// Both objects are read as by the data source of a single object and compared in its schema
+	schemaResp := datasource.SchemaResponse{}
+	(&AccessControlPolicyDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	attrTypes := schemaResp.Schema.Type().(types.ObjectType).AttrTypes
+	objects := make([]types.Object, 2)
+	for i, id := range []string{config.FirstId.ValueString(), config.SecondId.ValueString()} {
+		object := AccessControlPolicy{Id: types.StringValue(id), Domain: config.Domain}
+		res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+			return client.Get(object.getPath()+"/"+id, reqMods...)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object %s, got error: %s", id, err))
+			return
+		}
+		res, err = object.readEndpoints(ctx, client, res, reqMods...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object %s, got error: %s", id, err))
+			return
+		}
+		d.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", id, len(res.Raw)))
+
+		object.fromBody(ctx, res)
+		objects[i], diags = helpers.ObjectFrom(ctx, attrTypes, object, nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	config.Differences = []AccessControlPolicyDiffDifferences{}
+	for _, difference := range helpers.Diff(objects[0], objects[1], "id", "domain") {
+		config.Differences = append(config.Differences, AccessControlPolicyDiffDifferences{
+			Attribute:   types.StringValue(difference.Attribute),
+			FirstValue:  types.StringPointerValue(difference.First),
+			SecondValue: types.StringPointerValue(difference.Second),
+		})
+	}
+
+	d.logger.Summary(ctx, fmt.Sprintf("%s, %s: Read finished successfully, %d differences", config.FirstId.String(), config.SecondId.String(), len(config.Differences)))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+//template:end read
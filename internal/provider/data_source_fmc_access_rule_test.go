@@ -0,0 +1,107 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcAccessRule(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_access_rule.test", "name", "RULE1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_access_rule.test", "action", "ALLOW"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_access_rule.test", "enabled", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_access_rule.test", "log_begin", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_access_rule.test", "log_end", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_access_rule.test", "send_events_to_fmc", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_access_rule.test", "source_network_objects.0.type", "Network"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_access_rule.test", "destination_network_objects.0.type", "Network"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcAccessRulePrerequisitesConfig + testAccDataSourceFmcAccessRuleConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+const testAccDataSourceFmcAccessRulePrerequisitesConfig = `
+resource "fmc_access_control_policy" "test" {
+  name           = "POLICY1"
+  default_action = "BLOCK"
+}
+
+resource "fmc_access_control_policy_category" "test" {
+  access_control_policy_id = fmc_access_control_policy.test.id
+  name                     = "Category1"
+}
+
+resource "fmc_network" "test" {
+  name   = "NET1"
+  prefix = "10.1.2.0/24"
+}
+
+`
+
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcAccessRuleConfig() string {
+	config := `resource "fmc_access_rule" "test" {` + "\n"
+	config += `	access_control_policy_id = fmc_access_control_policy.test.id` + "\n"
+	config += `	name = "RULE1"` + "\n"
+	config += `	action = "ALLOW"` + "\n"
+	config += `	enabled = true` + "\n"
+	config += `	category = fmc_access_control_policy_category.test.name` + "\n"
+	config += `	log_begin = true` + "\n"
+	config += `	log_end = true` + "\n"
+	config += `	send_events_to_fmc = true` + "\n"
+	config += `	source_network_objects = [{` + "\n"
+	config += `	  id = fmc_network.test.id` + "\n"
+	config += `	  type = "Network"` + "\n"
+	config += `	}]` + "\n"
+	config += `	destination_network_objects = [{` + "\n"
+	config += `	  id = fmc_network.test.id` + "\n"
+	config += `	  type = "Network"` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_access_rule" "test" {
+			id = fmc_access_rule.test.id
+			access_control_policy_id = fmc_access_control_policy.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
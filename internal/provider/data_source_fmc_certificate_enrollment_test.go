@@ -0,0 +1,76 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcCertificateEnrollment(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_certificate_enrollment.test", "name", "ENROLLMENT1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_certificate_enrollment.test", "description", "My certificate enrollment"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_certificate_enrollment.test", "enrollment_type", "SCEP"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_certificate_enrollment.test", "scep_enrollment_url", "http://10.1.1.10/certsrv/mscep/mscep.dll"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_certificate_enrollment.test", "scep_retry_period", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_certificate_enrollment.test", "scep_retry_count", "10"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcCertificateEnrollmentConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcCertificateEnrollmentConfig() string {
+	config := `resource "fmc_certificate_enrollment" "test" {` + "\n"
+	config += `	name = "ENROLLMENT1"` + "\n"
+	config += `	description = "My certificate enrollment"` + "\n"
+	config += `	enrollment_type = "SCEP"` + "\n"
+	config += `	scep_enrollment_url = "http://10.1.1.10/certsrv/mscep/mscep.dll"` + "\n"
+	config += `	scep_retry_period = 1` + "\n"
+	config += `	scep_retry_count = 10` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_certificate_enrollment" "test" {
+			id = fmc_certificate_enrollment.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
@@ -0,0 +1,97 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFmcDevicesDataSourcePaging(t *testing.T) {
+	devicesPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/devices/devicerecords"
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != devicesPath || r.URL.Query().Get("expanded") != "true" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			fmt.Fprint(w, `{
+			  "items": [
+			    {"id": "DEVICE-1", "name": "FTD1", "model": "Cisco Firepower Threat Defense for VMware", "sw_version": "7.4.1",
+			     "metadata": {"isPartOfContainer": true, "containerDetails": {"id": "HA-1", "name": "HA1", "type": "DeviceHAPair", "role": "PRIMARY"}}},
+			    {"id": "DEVICE-2", "name": "FTD2", "model": "Cisco Firepower Threat Defense for VMware", "sw_version": "7.4.1",
+			     "metadata": {"isPartOfContainer": true, "containerDetails": {"id": "HA-1", "name": "HA1", "type": "DeviceHAPair", "role": "SECONDARY"}}}
+			  ],
+			  "paging": {"offset": 0, "limit": 1000, "count": 3, "pages": 2, "next": ["https://fmc/next"]}
+			}`)
+		case "1000":
+			fmt.Fprint(w, `{
+			  "items": [
+			    {"id": "DEVICE-3", "name": "FTD3", "model": "Cisco Secure Firewall 3110", "sw_version": "7.6.0", "metadata": {"isPartOfContainer": false}}
+			  ],
+			  "paging": {"offset": 1000, "limit": 1000, "count": 3, "pages": 2}
+			}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	d := &DevicesDataSource{client: client}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	config.SetAttribute(ctx, path.Root("domain"), types.StringNull())
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state Devices
+	resp.State.Get(ctx, &state)
+	expected := [][]string{
+		{"DEVICE-1", "FTD1", "7.4.1", "PRIMARY", "DeviceHAPair", "HA1"},
+		{"DEVICE-2", "FTD2", "7.4.1", "SECONDARY", "DeviceHAPair", "HA1"},
+		{"DEVICE-3", "FTD3", "7.6.0", "", "", ""},
+	}
+	if len(state.Devices) != len(expected) {
+		t.Fatalf("expected %d devices, got %d", len(expected), len(state.Devices))
+	}
+	for i, device := range state.Devices {
+		got := []string{device.Id.ValueString(), device.Name.ValueString(), device.Version.ValueString(), device.Role.ValueString(), device.ContainerType.ValueString(), device.ContainerName.ValueString()}
+		if fmt.Sprint(got) != fmt.Sprint(expected[i]) {
+			t.Errorf("unexpected device %d: %v", i, got)
+		}
+	}
+	if !state.Devices[2].Role.IsNull() {
+		t.Errorf("expected no role for a standalone device, got: %s", state.Devices[2].Role)
+	}
+}
@@ -25,12 +25,14 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/tidwall/gjson"
 )
 
@@ -49,7 +51,9 @@ func NewHostDataSource() datasource.DataSource {
 }
 
 type HostDataSource struct {
-	client *fmc.Client
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
 }
 
 func (d *HostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -84,10 +88,34 @@ func (d *HostDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "IP of the host.",
 				Computed:            true,
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the object, this value is always `Host`.",
+				Computed:            true,
+			},
 			"overridable": schema.BoolAttribute{
 				MarkdownDescription: "Whether the object values can be overridden.",
 				Computed:            true,
 			},
+			"tags": schema.ListNestedAttribute{
+				MarkdownDescription: "Tags of the object, each tag is identified by its name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the tag.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the tag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the last modification of the object in RFC 3339 format, null if not provided by the FMC.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -106,6 +134,8 @@ func (d *HostDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	d.logger = req.ProviderData.(*FmcProviderData).Logger
 }
 
 //template:end model
@@ -114,26 +144,34 @@ func (d *HostDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config Host
 
-	// Read config
-	diags := req.Config.Get(ctx, &config)
+	// Read config, the schema of the data source differs from the model as it exposes last_modified or omits
+	// write-only attributes which FMC never returns
+	var object types.Object
+	diags := req.Config.Get(ctx, &object)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = helpers.ObjectAs(ctx, object, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Set request domain if provided
+	client := d.clients.Client(d.client, config.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
 	if config.Id.IsNull() && !config.Name.IsNull() {
 		offset := 0
 		limit := 1000
 		for page := 1; ; page++ {
 			queryString := fmt.Sprintf("?limit=%d&offset=%d", limit, offset)
-			res, err := d.client.Get(config.getPath()+queryString, reqMods...)
+			res, err := client.Get(config.getPath()+queryString, reqMods...)
 			if err != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve objects, got error: %s", err))
 				return
@@ -142,7 +180,7 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 				value.ForEach(func(k, v gjson.Result) bool {
 					if config.Name.ValueString() == v.Get("name").String() {
 						config.Id = types.StringValue(v.Get("id").String())
-						tflog.Debug(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.String(), config.Name.ValueString(), config.Id.String()))
+						d.logger.Summary(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.String(), config.Name.ValueString(), config.Id.String()))
 						return false
 					}
 					return true
@@ -160,17 +198,25 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	}
 
-	res, err := d.client.Get(config.getPath()+"/"+config.Id.ValueString(), reqMods...)
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(config.getPath()+"/"+config.Id.ValueString(), reqMods...)
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
 		return
 	}
+	d.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", config.Id.ValueString(), len(res.Raw)))
 
 	config.fromBody(ctx, res)
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+	d.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	diags = resp.State.Set(ctx, &config)
+	object, diags = helpers.ObjectFrom(ctx, object.AttributeTypes(ctx), config, map[string]attr.Value{"last_modified": helpers.LastModified(res)})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, object)
 	resp.Diagnostics.Append(diags...)
 }
 
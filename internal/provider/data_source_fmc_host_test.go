@@ -34,7 +34,10 @@ func TestAccDataSourceFmcHost(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_host.test", "name", "HOST1"))
 	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_host.test", "description", "My host object"))
 	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_host.test", "ip", "10.1.1.1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_host.test", "type", "Host"))
 	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_host.test", "overridable", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_host.test", "tags.0.name", "environment"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_host.test", "tags.0.value", "production"))
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -59,6 +62,10 @@ func testAccDataSourceFmcHostConfig() string {
 	config += `	description = "My host object"` + "\n"
 	config += `	ip = "10.1.1.1"` + "\n"
 	config += `	overridable = true` + "\n"
+	config += `	tags = [{` + "\n"
+	config += `	  name = "environment"` + "\n"
+	config += `	  value = "production"` + "\n"
+	config += `	}]` + "\n"
 	config += `}` + "\n"
 
 	config += `
@@ -0,0 +1,74 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcICMPv4Object(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_icmpv4_object.test", "name", "ICMP1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_icmpv4_object.test", "description", "My ICMPv4 object"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_icmpv4_object.test", "icmp_type", "DESTINATION_UNREACHABLE"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_icmpv4_object.test", "code", "0"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_icmpv4_object.test", "overridable", "true"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcICMPv4ObjectConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcICMPv4ObjectConfig() string {
+	config := `resource "fmc_icmpv4_object" "test" {` + "\n"
+	config += `	name = "ICMP1"` + "\n"
+	config += `	description = "My ICMPv4 object"` + "\n"
+	config += `	icmp_type = "DESTINATION_UNREACHABLE"` + "\n"
+	config += `	code = 0` + "\n"
+	config += `	overridable = true` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_icmpv4_object" "test" {
+			id = fmc_icmpv4_object.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
@@ -0,0 +1,78 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcIKEv2Policy(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_ikev2_policy.test", "name", "IKEV2_POLICY1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_ikev2_policy.test", "description", "My IKEv2 policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_ikev2_policy.test", "priority", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_ikev2_policy.test", "lifetime", "86400"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_ikev2_policy.test", "encryption_algorithms.0", "AES-GCM-256"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_ikev2_policy.test", "integrity_algorithms.0", "SHA512"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_ikev2_policy.test", "prf_integrity_algorithms.0", "SHA512"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcIKEv2PolicyConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcIKEv2PolicyConfig() string {
+	config := `resource "fmc_ikev2_policy" "test" {` + "\n"
+	config += `	name = "IKEV2_POLICY1"` + "\n"
+	config += `	description = "My IKEv2 policy"` + "\n"
+	config += `	priority = 10` + "\n"
+	config += `	lifetime = 86400` + "\n"
+	config += `	encryption_algorithms = ["AES-GCM-256"]` + "\n"
+	config += `	integrity_algorithms = ["SHA512"]` + "\n"
+	config += `	prf_integrity_algorithms = ["SHA512"]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_ikev2_policy" "test" {
+			id = fmc_ikev2_policy.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
@@ -0,0 +1,129 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcNetworkGroup(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_group.test", "name", "NETGRP1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_group.test", "description", "My network group"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_group.test", "overridable", "true"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcNetworkGroupPrerequisitesConfig + testAccDataSourceFmcNetworkGroupConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+const testAccDataSourceFmcNetworkGroupPrerequisitesConfig = `
+resource "fmc_network" "test" {
+  name   = "NET1"
+  prefix = "10.1.2.0/24"
+}
+
+`
+
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcNetworkGroupConfig() string {
+	config := `resource "fmc_network_group" "test" {` + "\n"
+	config += `	name = "NETGRP1"` + "\n"
+	config += `	description = "My network group"` + "\n"
+	config += `	overridable = true` + "\n"
+	config += `	objects = [{` + "\n"
+	config += `	  id = fmc_network.test.id` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_network_group" "test" {
+			id = fmc_network_group.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
+
+func TestFmcNetworkGroupDataSourceExpanded(t *testing.T) {
+	objectPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/networkgroups/76d24097-41c4-4558-a4d0-a8c07ac08470"
+	requests := 0
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != objectPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("expanded") != "true" {
+			fmt.Fprint(w, `{"name": "NETGRP1", "objects": [{"id": "0050568a-3d4f-0ed3-0000-004294967346"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"name": "NETGRP1", "objects": [{"id": "0050568a-3d4f-0ed3-0000-004294967346", "name": "NET1", "type": "Network"}]}`)
+	})
+
+	ctx := context.Background()
+	d := &NetworkGroupDataSource{client: client}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	config.SetAttribute(ctx, path.Root("id"), "76d24097-41c4-4558-a4d0-a8c07ac08470")
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state NetworkGroup
+	resp.State.Get(ctx, &state)
+	if len(state.Objects) != 1 || state.Objects[0].Name.ValueString() != "NET1" || state.Objects[0].Type.ValueString() != "Network" {
+		t.Errorf("expected expanded object details, got: %v", state.Objects)
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+}
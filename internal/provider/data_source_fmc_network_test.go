@@ -21,8 +21,14 @@ package provider
 
 //template:begin imports
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -70,3 +76,42 @@ func testAccDataSourceFmcNetworkConfig() string {
 }
 
 //template:end testAccDataSourceConfig
+
+func TestFmcNetworkDataSourceLastModified(t *testing.T) {
+	objectPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/networks/76d24097-41c4-4558-a4d0-a8c07ac08470"
+	tests := []struct {
+		name         string
+		body         string
+		lastModified types.String
+	}{
+		{"metadata", `{"name": "NET1", "value": "10.1.2.0/24", "metadata": {"timestamp": 1704067200000}}`, types.StringValue("2024-01-01T00:00:00Z")},
+		{"no metadata", `{"name": "NET1", "value": "10.1.2.0/24"}`, types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			d := &NetworkDataSource{client: testMockClient(t, map[string]string{objectPath: tt.body})}
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			config.SetAttribute(ctx, path.Root("id"), "76d24097-41c4-4558-a4d0-a8c07ac08470")
+			req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var prefix, lastModified types.String
+			resp.State.GetAttribute(ctx, path.Root("prefix"), &prefix)
+			resp.State.GetAttribute(ctx, path.Root("last_modified"), &lastModified)
+			if prefix.ValueString() != "10.1.2.0/24" {
+				t.Errorf("expected prefix 10.1.2.0/24, got %s", prefix)
+			}
+			if !lastModified.Equal(tt.lastModified) {
+				t.Errorf("expected last_modified %s, got %s", tt.lastModified, lastModified)
+			}
+		})
+	}
+}
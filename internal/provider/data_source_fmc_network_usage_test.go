@@ -0,0 +1,83 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFmcNetworkUsageDataSource(t *testing.T) {
+	usagePath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/operational/usage"
+	// The referencing objects are returned on two pages
+	pages := map[string]string{
+		"filter=uuid:76d24097-41c4-4558-a4d0-a8c07ac08470;type:Network&limit=1000&offset=0": `{
+		  "items": [{"id": "0050568a-3d4f-0ed3-0000-004294967346", "name": "NETGRP1", "type": "NetworkGroup"}],
+		  "paging": {"offset": 0, "limit": 1000, "count": 1001, "pages": 2, "next": ["next"]}
+		}`,
+		"filter=uuid:76d24097-41c4-4558-a4d0-a8c07ac08470;type:Network&limit=1000&offset=1000": `{
+		  "items": [{"id": "0050568a-3d4f-0ed3-0000-004294967400", "name": "POLICY1", "type": "AccessPolicy"}],
+		  "paging": {"offset": 1000, "limit": 1000, "count": 1001, "pages": 2}
+		}`,
+	}
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.RawQuery]
+		if r.URL.Path != usagePath || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, page)
+	})
+
+	ctx := context.Background()
+	d := &NetworkUsageDataSource{client: client}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	config.SetAttribute(ctx, path.Root("network_id"), "76d24097-41c4-4558-a4d0-a8c07ac08470")
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state NetworkUsage
+	resp.State.Get(ctx, &state)
+	expected := [][]string{
+		{"0050568a-3d4f-0ed3-0000-004294967346", "NETGRP1", "NetworkGroup"},
+		{"0050568a-3d4f-0ed3-0000-004294967400", "POLICY1", "AccessPolicy"},
+	}
+	if len(state.Objects) != len(expected) {
+		t.Fatalf("expected %d referencing objects, got %d", len(expected), len(state.Objects))
+	}
+	for i, object := range state.Objects {
+		if object.Id.ValueString() != expected[i][0] || object.Name.ValueString() != expected[i][1] || object.Type.ValueString() != expected[i][2] {
+			t.Errorf("unexpected referencing object %d: %s, %s, %s", i, object.Id.ValueString(), object.Name.ValueString(), object.Type.ValueString())
+		}
+	}
+}
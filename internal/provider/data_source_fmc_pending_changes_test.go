@@ -0,0 +1,80 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+// The pending changes depend on the state of the FMC, therefore the data source
+// is tested against a recorded response of the deployable devices endpoint.
+const testPendingChangesResponse = `{
+  "items": [
+    {
+      "version": "1700000000000",
+      "name": "FTD1",
+      "type": "DeployableDevice",
+      "canBeDeployed": true,
+      "upToDate": false,
+      "device": {"id": "76d24097-41c4-4558-a4d0-a8c07ac08470", "type": "Device", "name": "FTD1"}
+    },
+    {
+      "version": "1700000000001",
+      "name": "FTD2",
+      "type": "DeployableDevice",
+      "canBeDeployed": false,
+      "upToDate": false,
+      "device": {"id": "0050568a-3d4f-0ed3-0000-004294967346", "type": "Device", "name": "FTD2"}
+    }
+  ],
+  "paging": {"offset": 0, "limit": 1000, "count": 2, "pages": 1}
+}`
+
+func TestFmcPendingChangesFromBody(t *testing.T) {
+	var data PendingChanges
+	data.fromBody(context.Background(), gjson.Parse(testPendingChangesResponse))
+
+	if len(data.Devices) != 2 {
+		t.Fatalf("expected 2 devices with pending changes, got %d", len(data.Devices))
+	}
+	device := data.Devices[0]
+	if device.Id.ValueString() != "76d24097-41c4-4558-a4d0-a8c07ac08470" {
+		t.Errorf("unexpected device id: %s", device.Id.ValueString())
+	}
+	if device.Name.ValueString() != "FTD1" {
+		t.Errorf("unexpected device name: %s", device.Name.ValueString())
+	}
+	if device.Version.ValueString() != "1700000000000" {
+		t.Errorf("unexpected version: %s", device.Version.ValueString())
+	}
+	if !device.CanBeDeployed.ValueBool() || data.Devices[1].CanBeDeployed.ValueBool() {
+		t.Errorf("unexpected can_be_deployed values: %v, %v", device.CanBeDeployed.ValueBool(), data.Devices[1].CanBeDeployed.ValueBool())
+	}
+}
+
+func TestFmcPendingChangesFromBodyEmpty(t *testing.T) {
+	var data PendingChanges
+	data.fromBody(context.Background(), gjson.Parse(`{"paging": {"offset": 0, "limit": 1000, "count": 0, "pages": 0}}`))
+
+	if data.Devices != nil {
+		t.Errorf("expected no devices with pending changes, got %d", len(data.Devices))
+	}
+}
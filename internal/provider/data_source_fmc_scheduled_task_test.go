@@ -0,0 +1,78 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcScheduledTask(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_scheduled_task.test", "name", "BACKUP1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_scheduled_task.test", "description", "My scheduled task"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_scheduled_task.test", "job_type", "BACKUP"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_scheduled_task.test", "recurrence_frequency", "WEEKLY"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_scheduled_task.test", "recurrence_interval", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_scheduled_task.test", "recurrence_start_time", "02:30"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_scheduled_task.test", "recurrence_weekdays.0", "MON"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcScheduledTaskConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcScheduledTaskConfig() string {
+	config := `resource "fmc_scheduled_task" "test" {` + "\n"
+	config += `	name = "BACKUP1"` + "\n"
+	config += `	description = "My scheduled task"` + "\n"
+	config += `	job_type = "BACKUP"` + "\n"
+	config += `	recurrence_frequency = "WEEKLY"` + "\n"
+	config += `	recurrence_interval = 1` + "\n"
+	config += `	recurrence_start_time = "02:30"` + "\n"
+	config += `	recurrence_weekdays = ["MON"]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_scheduled_task" "test" {
+			id = fmc_scheduled_task.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
@@ -0,0 +1,82 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcTimeRange(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_time_range.test", "name", "TR1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_time_range.test", "description", "My time range object"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_time_range.test", "start_date_time", "2025-01-01T08:00"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_time_range.test", "end_date_time", "2025-12-31T17:00"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_time_range.test", "recurrences.0.recurrence_type", "DAILY_INTERVAL"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_time_range.test", "recurrences.0.daily_start_time", "08:00"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_time_range.test", "recurrences.0.daily_end_time", "17:00"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_time_range.test", "recurrences.0.daily_days.0", "MON"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcTimeRangeConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcTimeRangeConfig() string {
+	config := `resource "fmc_time_range" "test" {` + "\n"
+	config += `	name = "TR1"` + "\n"
+	config += `	description = "My time range object"` + "\n"
+	config += `	start_date_time = "2025-01-01T08:00"` + "\n"
+	config += `	end_date_time = "2025-12-31T17:00"` + "\n"
+	config += `	recurrences = [{` + "\n"
+	config += `	  recurrence_type = "DAILY_INTERVAL"` + "\n"
+	config += `	  daily_start_time = "08:00"` + "\n"
+	config += `	  daily_end_time = "17:00"` + "\n"
+	config += `	  daily_days = ["MON"]` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_time_range" "test" {
+			id = fmc_time_range.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcVariableSet(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_variable_set.test", "name", "VARSET1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_variable_set.test", "description", "My variable set"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_variable_set.test", "variables.0.name", "HOME_NET"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcVariableSetPrerequisitesConfig + testAccDataSourceFmcVariableSetConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+const testAccDataSourceFmcVariableSetPrerequisitesConfig = `
+resource "fmc_network" "test" {
+  name   = "NET1"
+  prefix = "10.1.2.0/24"
+}
+
+`
+
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcVariableSetConfig() string {
+	config := `resource "fmc_variable_set" "test" {` + "\n"
+	config += `	name = "VARSET1"` + "\n"
+	config += `	description = "My variable set"` + "\n"
+	config += `	variables = [{` + "\n"
+	config += `	  name = "HOME_NET"` + "\n"
+	config += `	  network_id = fmc_network.test.id` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_variable_set" "test" {
+			id = fmc_variable_set.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
+
+func TestFmcVariableSetDataSourceWriteOnly(t *testing.T) {
+	ctx := context.Background()
+	networkName := path.Root("variables").AtListIndex(0).AtName("network_name")
+	if _, diags := testResourceSchema(NewVariableSetResource()).TypeAtPath(ctx, networkName); diags.HasError() {
+		t.Fatalf("expected write-only attribute network_name in resource schema: %v", diags)
+	}
+	d := &VariableSetDataSource{}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if _, diags := schemaResp.Schema.TypeAtPath(ctx, networkName); !diags.HasError() {
+		t.Fatalf("expected write-only attribute network_name to be absent from data source schema")
+	}
+
+	// The data source state is converted from the model, which still holds the write-only attribute
+	d.client = testMockClient(t, map[string]string{
+		"/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/variablesets/VARSET-1": `{
+		  "id": "VARSET-1",
+		  "name": "VARSET1",
+		  "variables": [{"name": "HOME_NET", "value": {"id": "NET-1"}}]
+		}`,
+	})
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	config.SetAttribute(ctx, path.Root("id"), "VARSET-1")
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var networkId types.String
+	resp.State.GetAttribute(ctx, path.Root("variables").AtListIndex(0).AtName("network_id"), &networkId)
+	if networkId.ValueString() != "NET-1" {
+		t.Errorf("expected network_id NET-1, got: %s", networkId)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+//template:end imports
+
+//template:begin testAccDataSource
+func TestAccDataSourceFmcVPNS2S(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_vpn_s2s.test", "name", "MyVPN1"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_vpn_s2s.test", "network_topology", "POINT_TO_POINT"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_vpn_s2s.test", "route_based", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_vpn_s2s.test", "ikev1", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_vpn_s2s.test", "ikev2", "true"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcVPNS2SConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+//template:end testAccDataSource
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccDataSourceConfig
+func testAccDataSourceFmcVPNS2SConfig() string {
+	config := `resource "fmc_vpn_s2s" "test" {` + "\n"
+	config += `	name = "MyVPN1"` + "\n"
+	config += `	network_topology = "POINT_TO_POINT"` + "\n"
+	config += `	route_based = false` + "\n"
+	config += `	ikev1 = false` + "\n"
+	config += `	ikev2 = true` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_vpn_s2s" "test" {
+			id = fmc_vpn_s2s.test.id
+		}
+	`
+	return config
+}
+
+//template:end testAccDataSourceConfig
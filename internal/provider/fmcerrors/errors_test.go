@@ -0,0 +1,262 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package fmcerrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		body string
+		want Category
+	}{
+		{
+			name: "no error",
+			want: Unknown,
+		},
+		{
+			name: "not found",
+			err:  errors.New("HTTP Request failed: StatusCode 404"),
+			body: `{"error":{"category":"FRAMEWORK","messages":[{"description":"Resource not found."}],"severity":"ERROR"}}`,
+			want: NotFound,
+		},
+		{
+			name: "not found without body",
+			err:  errors.New("HTTP Request failed: StatusCode 404"),
+			want: NotFound,
+		},
+		{
+			name: "conflict",
+			err:  errors.New("HTTP Request failed: StatusCode 409"),
+			want: Conflict,
+		},
+		{
+			name: "duplicate name",
+			err:  errors.New("HTTP Request failed: StatusCode 400"),
+			body: `{"error":{"category":"FRAMEWORK","messages":[{"description":"The object name net1 already exists. Enter a new name."}],"severity":"ERROR"}}`,
+			want: Conflict,
+		},
+		{
+			name: "referenced object missing",
+			err:  errors.New("HTTP Request failed: StatusCode 400"),
+			body: `{"error":{"category":"FRAMEWORK","messages":[{"description":"UUID 76d24097-41c4-4558-a4d0-a8c07ac08470 does not exist."}],"severity":"ERROR"}}`,
+			want: Validation,
+		},
+		{
+			name: "rate limited",
+			err:  errors.New("HTTP Request failed: StatusCode 429"),
+			want: RateLimited,
+		},
+		{
+			name: "auth expired",
+			err:  errors.New("HTTP Request failed: StatusCode 401"),
+			body: `{"error":{"category":"FRAMEWORK","messages":[{"description":"Access token invalid."}],"severity":"ERROR"}}`,
+			want: AuthExpired,
+		},
+		{
+			name: "invalid value",
+			err:  errors.New("HTTP Request failed: StatusCode 400"),
+			body: `{"error":{"category":"FRAMEWORK","messages":[{"description":"Invalid IP Address (10.1.1.256) in value"}],"severity":"ERROR"}}`,
+			want: Validation,
+		},
+		{
+			name: "unprocessable entity",
+			err:  errors.New("HTTP Request failed: StatusCode 422"),
+			body: `{"error":{"category":"FRAMEWORK","messages":[{"description":"Invalid input."}],"severity":"ERROR"}}`,
+			want: Validation,
+		},
+		{
+			name: "error in successful response",
+			err:  errors.New(`JSON error: {"description":"Invalid port range"}`),
+			body: `{"error":{"category":"FRAMEWORK","messages":[{"description":"Invalid port range"}],"severity":"ERROR"}}`,
+			want: Validation,
+		},
+		{
+			name: "server error",
+			err:  errors.New("HTTP Request failed: StatusCode 500"),
+			want: Unknown,
+		},
+		{
+			name: "connection error",
+			err:  errors.New("dial tcp 10.0.0.1:443: connect: connection refused"),
+			want: Unknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.err, gjson.Parse(tt.body)); got != tt.want {
+				t.Errorf("Classify() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	body := `{"error":{"messages":[{"description":"First"},{"code":"X"},{"description":"Second"}]}}`
+	if got := Message(gjson.Parse(body)); got != "First, Second" {
+		t.Errorf("Message() = %q, want %q", got, "First, Second")
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	body := `{"id":"1","error":{"messages":[{"description":"Topology has no endpoints"}],"severity":"WARNING"}}`
+	if got := Warnings(errors.New(`JSON error: {"description":"Topology has no endpoints"}`), gjson.Parse(body)); len(got) != 1 || got[0] != "Topology has no endpoints" {
+		t.Errorf("Warnings() = %v, want [Topology has no endpoints]", got)
+	}
+	if got := Warnings(errors.New("HTTP Request failed: StatusCode 400"), gjson.Parse(body)); got != nil {
+		t.Errorf("Warnings() = %v for failed request, want nil", got)
+	}
+	body = `{"error":{"messages":[{"description":"Invalid port range"}],"severity":"ERROR"}}`
+	if got := Warnings(errors.New(`JSON error: {"description":"Invalid port range"}`), gjson.Parse(body)); got != nil {
+		t.Errorf("Warnings() = %v for error, want nil", got)
+	}
+}
+
+func TestResponseWarnings(t *testing.T) {
+	body := `{"id":"1","metadata":{"warnings":["Interface is not assigned to a zone",{"description":"Object is not used"},{"code":"X"}]}}`
+	if got := ResponseWarnings(gjson.Parse(body)); len(got) != 2 || got[0] != "Interface is not assigned to a zone" || got[1] != "Object is not used" {
+		t.Errorf("ResponseWarnings() = %v, want [Interface is not assigned to a zone Object is not used]", got)
+	}
+	if got := ResponseWarnings(gjson.Parse(`{"id":"1","metadata":{}}`)); got != nil {
+		t.Errorf("ResponseWarnings() = %v without warnings, want nil", got)
+	}
+}
+
+func TestEmbeddedError(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "success",
+			body: `{"id":"1","metadata":{"task":{"id":"T1","status":"PENDING"}}}`,
+		},
+		{
+			name: "failed task",
+			body: `{"id":"1","metadata":{"task":{"id":"T1","status":"Failed","message":"Deployment is in progress"}}}`,
+			want: "task T1 failed with status FAILED: Deployment is in progress",
+		},
+		{
+			name: "failed bulk items",
+			body: `{"items":[{"id":"1"},{"name":"OBJ2","error":{"severity":"ERROR","messages":[{"description":"Duplicate name"}]}},{"name":"OBJ3","error":{"severity":"WARNING","messages":[{"description":"Unused"}]}}]}`,
+			want: "1 of 3 items failed: Duplicate name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := EmbeddedError(gjson.Parse(tt.body))
+			if tt.want == "" && err != nil {
+				t.Errorf("EmbeddedError() = %v, want nil", err)
+			}
+			if tt.want != "" && (err == nil || err.Error() != tt.want) {
+				t.Errorf("EmbeddedError() = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+// testClient returns an FMC client talking to a mock server, which responds to
+// all requests with the given handler.
+func testClient(t *testing.T, handler http.HandlerFunc) *fmc.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create mock client: %s", err)
+	}
+	client.AuthToken = "expired"
+	client.LastRefresh = time.Now()
+	return &client
+}
+
+func TestRetryAuthExpired(t *testing.T) {
+	logins := 0
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+			logins++
+			w.Header().Set("X-auth-access-token", "renewed")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Header.Get("X-auth-access-token") != "renewed" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"id":"1"}`)
+	})
+
+	res, err := Retry(client, func() (fmc.Res, error) { return client.Get("/object") })
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.Get("id").String() != "1" || logins != 1 {
+		t.Errorf("got id %q after %d logins, want id \"1\" after 1 login", res.Get("id").String(), logins)
+	}
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	requests := 0
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	// The client retries rate limited requests itself, with no retries configured the request is sent once
+	_, err := Retry(client, func() (fmc.Res, error) { return client.Get("/object") })
+	if Classify(err, gjson.Result{}) != RateLimited || requests != 1 {
+		t.Errorf("got %v after %d requests, want a rate limit error after 1 request", err, requests)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	requests := 0
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := Retry(client, func() (fmc.Res, error) { return client.Get("/object") })
+	if Classify(err, gjson.Result{}) != Validation || requests != 1 {
+		t.Errorf("got %v after %d requests, want a validation error after 1 request", err, requests)
+	}
+}
+
+func TestRetryNonJSONError(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, `{"error":{"severity":"ERROR","messages":[{"description":"FMC returned a non-JSON error, status 502: Bad Gateway"}]}}`)
+	})
+
+	_, err := Retry(client, func() (fmc.Res, error) { return client.Get("/object") })
+	if err == nil || err.Error() != "HTTP Request failed: StatusCode 502, FMC returned a non-JSON error, status 502: Bad Gateway" || StatusCode(err) != 502 {
+		t.Errorf("got %v, want the message of the non-JSON error added to the error", err)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRunFunction(f function.Function, args ...string) (attr.Value, *function.FuncError) {
+	values := make([]attr.Value, len(args))
+	for i, arg := range args {
+		values[i] = types.StringValue(arg)
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(values)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestIsValidCIDRFunction(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"10.1.1.0/24", true},
+		{"10.1.1.1", true},
+		{"2001:db8::/32", true},
+		{"10.1.1.0/33", false},
+		{"10.1.1.0-10.1.1.9", false},
+		{"host", false},
+	}
+	for _, tt := range tests {
+		result, err := testRunFunction(NewIsValidCIDRFunction(), tt.value)
+		if err != nil || !result.Equal(types.BoolValue(tt.valid)) {
+			t.Errorf("expected is_valid_cidr('%s') to return %v, got: %v, %v", tt.value, tt.valid, result, err)
+		}
+	}
+}
+
+func TestIPInRangeFunction(t *testing.T) {
+	tests := []struct {
+		ip     string
+		r      string
+		within bool
+		err    string
+	}{
+		{"10.1.1.5", "10.1.1.0/24", true, ""},
+		{"10.1.1.5", "10.1.1.1-10.1.1.9", true, ""},
+		{"10.1.2.5", "10.1.1.0/24", false, ""},
+		{"10.1.1.10", "10.1.1.1-10.1.1.9", false, ""},
+		{"10.1.1", "10.1.1.0/24", false, `invalid IP address "10.1.1"`},
+		{"10.1.1.5", "10.1.1.0/33", false, `invalid prefix "10.1.1.0/33"`},
+		{"10.1.1.5", "10.1.1.9-10.1.1.1", false, `invalid address range "10.1.1.9-10.1.1.1"`},
+	}
+	for _, tt := range tests {
+		result, err := testRunFunction(NewIPInRangeFunction(), tt.ip, tt.r)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected ip_in_range('%s', '%s') to fail with '%s', got: %v", tt.ip, tt.r, tt.err, err)
+			}
+			continue
+		}
+		if err != nil || !result.Equal(types.BoolValue(tt.within)) {
+			t.Errorf("expected ip_in_range('%s', '%s') to return %v, got: %v, %v", tt.ip, tt.r, tt.within, result, err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// BulkTimeout is the maximum time waited for the task of a bulk request to finish
+var BulkTimeout = 30 * time.Minute
+
+// BulkCreate creates the objects of the given bodies with a single request and returns the IDs of the created
+// objects by name. A bulk create running as an asynchronous task is polled until it is finished, the IDs of
+// objects missing in the response are looked up by name afterwards.
+func BulkCreate(ctx context.Context, client *fmc.Client, endpoint string, bodies []string, mods ...func(*fmc.Req)) (map[string]string, error) {
+	ids := make(map[string]string)
+	if len(bodies) == 0 {
+		return ids, nil
+	}
+	res, err := client.Post(endpoint+"?bulk=true", "["+strings.Join(bodies, ",")+"]", mods...)
+	if err != nil {
+		return ids, fmt.Errorf("failed to create objects (POST), got error: %w, %s", err, res.String())
+	}
+	if taskId := res.Get("metadata.task.id").String(); taskId != "" {
+		task, err := WaitForTask(ctx, client, taskId, mods...)
+		if err != nil {
+			return ids, err
+		}
+		if TaskFailed(task.Get("status").String()) {
+			return ids, fmt.Errorf("task %s failed to create objects: %s", taskId, task.Get("message").String())
+		}
+	}
+	res.Get("items").ForEach(func(_, v gjson.Result) bool {
+		ids[v.Get("name").String()] = v.Get("id").String()
+		return true
+	})
+	if len(ids) < len(bodies) {
+		res, err := GetAllPages(client, endpoint, mods...)
+		if err != nil {
+			return ids, fmt.Errorf("failed to retrieve created objects (GET), got error: %w", err)
+		}
+		names := make(map[string]bool)
+		for _, body := range bodies {
+			names[gjson.Get(body, "name").String()] = true
+		}
+		res.Get("items").ForEach(func(_, v gjson.Result) bool {
+			if name := v.Get("name").String(); names[name] && ids[name] == "" {
+				ids[name] = v.Get("id").String()
+			}
+			return true
+		})
+	}
+	return ids, nil
+}
+
+// BulkDelete deletes the objects with the given IDs with a single request and returns the IDs of the objects
+// FMC confirms deleted. A bulk delete running as an asynchronous task is polled until it is finished, the
+// context bounds the time waited for the task. If the task fails for some of the objects, the objects which
+// still exist afterwards are not deleted and an error with the message of the task is returned.
+func BulkDelete(ctx context.Context, client *fmc.Client, endpoint string, ids []string, mods ...func(*fmc.Req)) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	res, err := client.Delete(endpoint+"?bulk=true&filter="+url.QueryEscape("ids:"+strings.Join(ids, ",")), mods...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete objects (DELETE), got error: %w, %s", err, res.String())
+	}
+	taskId := res.Get("metadata.task.id").String()
+	if taskId == "" {
+		return ids, nil
+	}
+	task, err := WaitForTask(ctx, client, taskId, mods...)
+	if err != nil {
+		return nil, err
+	}
+	failed := TaskFailed(task.Get("status").String())
+	task.Get("subTasks").ForEach(func(_, v gjson.Result) bool {
+		failed = failed || TaskFailed(v.Get("status").String())
+		return true
+	})
+	if !failed {
+		return ids, nil
+	}
+
+	// The task does not tell reliably which objects failed, only the objects gone afterwards are deleted
+	res, err = GetAllPages(client, endpoint, mods...)
+	if err != nil {
+		return nil, fmt.Errorf("task %s failed, the remaining objects could not be retrieved (GET), got error: %w", taskId, err)
+	}
+	remaining := make(map[string]bool)
+	res.Get("items").ForEach(func(_, v gjson.Result) bool {
+		remaining[v.Get("id").String()] = true
+		return true
+	})
+	var deleted, kept []string
+	for _, id := range ids {
+		if remaining[id] {
+			kept = append(kept, id)
+		} else {
+			deleted = append(deleted, id)
+		}
+	}
+	if len(kept) > 0 {
+		return deleted, fmt.Errorf("task %s failed to delete %s: %s", taskId, strings.Join(kept, ", "), task.Get("message").String())
+	}
+	return deleted, nil
+}
@@ -0,0 +1,98 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+func testBulkClient(t *testing.T, handler http.HandlerFunc) *fmc.Client {
+	interval := TaskPollInterval
+	TaskPollInterval = 0
+	t.Cleanup(func() { TaskPollInterval = interval })
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create mock client: %s", err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+	client.DomainUUID = "e276abec-e0f2-11e3-8169-6d9ed49b625f"
+	return &client
+}
+
+func TestBulkCreateTask(t *testing.T) {
+	client := testBulkClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost:
+			fmt.Fprint(w, `{"metadata": {"task": {"id": "TASK1"}}}`)
+		case r.URL.Path == "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/job/taskstatuses/TASK1":
+			fmt.Fprint(w, `{"status": "SUCCESS"}`)
+		default:
+			fmt.Fprint(w, `{"items": [{"id": "ID1", "name": "NAME1"}, {"id": "ID3", "name": "OTHER"}]}`)
+		}
+	})
+
+	ids, err := BulkCreate(context.Background(), client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks", []string{`{"name":"NAME1"}`})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 1 || ids["NAME1"] != "ID1" {
+		t.Errorf("expected the ID of the created object to be looked up by name, got: %v", ids)
+	}
+}
+
+func TestBulkDeleteTask(t *testing.T) {
+	tests := []struct {
+		status  string
+		deleted int
+		err     bool
+	}{
+		{`{"status": "SUCCESS", "subTasks": [{"status": "SUCCESS"}, {"status": "SUCCESS"}]}`, 2, false},
+		{`{"status": "SUCCESS", "subTasks": [{"status": "SUCCESS"}, {"status": "FAILED"}]}`, 1, true},
+		{`{"status": "FAILED", "message": "Objects are in use"}`, 1, true},
+	}
+	for _, tt := range tests {
+		client := testBulkClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodDelete:
+				fmt.Fprint(w, `{"metadata": {"task": {"id": "TASK1"}}}`)
+			case r.URL.Path == "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/job/taskstatuses/TASK1":
+				fmt.Fprint(w, tt.status)
+			default:
+				fmt.Fprint(w, `{"items": [{"id": "ID2"}]}`)
+			}
+		})
+
+		deleted, err := BulkDelete(context.Background(), client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks", []string{"ID1", "ID2"})
+		if (err != nil) != tt.err || len(deleted) != tt.deleted || deleted[0] != "ID1" {
+			t.Errorf("task %s: expected %d deleted objects and error %v, got: %v, %v", tt.status, tt.deleted, tt.err, deleted, err)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type deprecatedResource struct {
+	resource.ResourceWithImportState
+	typeName    string
+	newTypeName string
+}
+
+var _ resource.ResourceWithConfigure = &deprecatedResource{}
+var _ resource.ResourceWithModifyPlan = &deprecatedResource{}
+var _ resource.ResourceWithValidateConfig = &deprecatedResource{}
+
+// NewDeprecatedResource wraps a resource to be registered under its previous type name, e.g. "access_rule"
+// for a resource renamed to "access_policy_rule", emitting a deprecation warning whenever it is used
+func NewDeprecatedResource(r resource.ResourceWithImportState, typeName, newTypeName string) resource.Resource {
+	return &deprecatedResource{ResourceWithImportState: r, typeName: typeName, newTypeName: newTypeName}
+}
+
+func (r *deprecatedResource) deprecationMessage() string {
+	return fmt.Sprintf("The fmc_%s resource has been renamed to fmc_%s and will be removed in a future release.", r.typeName, r.newTypeName)
+}
+
+func (r *deprecatedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *deprecatedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.ResourceWithImportState.Schema(ctx, req, resp)
+	resp.Schema.DeprecationMessage = r.deprecationMessage()
+}
+
+func (r *deprecatedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if c, ok := r.ResourceWithImportState.(resource.ResourceWithConfigure); ok {
+		c.Configure(ctx, req, resp)
+	}
+}
+
+func (r *deprecatedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if m, ok := r.ResourceWithImportState.(resource.ResourceWithModifyPlan); ok {
+		m.ModifyPlan(ctx, req, resp)
+	}
+}
+
+func (r *deprecatedResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.AddWarning("Deprecated Resource", r.deprecationMessage())
+	if v, ok := r.ResourceWithImportState.(resource.ResourceWithValidateConfig); ok {
+		v.ValidateConfig(ctx, req, resp)
+	}
+}
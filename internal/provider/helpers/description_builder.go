@@ -30,6 +30,16 @@ func NewAttributeDescription(s string) *AttributeDescription {
 	return &AttributeDescription{s}
 }
 
+func (d *AttributeDescription) AddExperimentalDescription(kind string) *AttributeDescription {
+	d.String = fmt.Sprintf("~> **Experimental:** This %s is experimental, its attributes and behavior may change in future releases.\n\n%s", kind, d.String)
+	return d
+}
+
+func (d *AttributeDescription) AddSoftDeleteDescription(attribute string) *AttributeDescription {
+	d.String = fmt.Sprintf("%s\n\n~> **Note:** Destroying this resource does not delete the object on FMC, the object is disabled by setting `%s` to `false` and only removed from the Terraform state.", d.String, attribute)
+	return d
+}
+
 func (d *AttributeDescription) AddMinimumVersionDescription(minimumVersion string) *AttributeDescription {
 	d.String = fmt.Sprintf("%s\n  - Minimum FMC version: `%s`", d.String, minimumVersion)
 	return d
@@ -58,3 +68,70 @@ func (d *AttributeDescription) AddFloatRangeDescription(min, max float64) *Attri
 	d.String = fmt.Sprintf("%s\n  - Range: `%v`-`%v`", d.String, min, max)
 	return d
 }
+
+func (d *AttributeDescription) AddFormatDescription(format string) *AttributeDescription {
+	d.String = fmt.Sprintf("%s\n  - Format: `%s`", d.String, format)
+	return d
+}
+
+func (d *AttributeDescription) AddMinimumAttributeDescription(attribute string) *AttributeDescription {
+	d.String = fmt.Sprintf("%s\n  - Must be at least the value of: `%s`", d.String, attribute)
+	return d
+}
+
+func (d *AttributeDescription) AddAfterAttributeDescription(attribute string) *AttributeDescription {
+	d.String = fmt.Sprintf("%s\n  - Must be later than the value of: `%s`", d.String, attribute)
+	return d
+}
+
+func (d *AttributeDescription) AddRecreateOnChangeDescription() *AttributeDescription {
+	d.String = fmt.Sprintf("%s\n  - Changing this value deletes the object and creates it again with a new id", d.String)
+	return d
+}
+
+func (d *AttributeDescription) AddDiscriminatorDescription(discriminator string, values ...string) *AttributeDescription {
+	v := make([]string, len(values))
+	for i, value := range values {
+		v[i] = fmt.Sprintf("`%s`", value)
+	}
+	d.String = fmt.Sprintf("%s\n  - Only valid if `%s` is one of: %s", d.String, discriminator, strings.Join(v, ", "))
+	return d
+}
+
+func (d *AttributeDescription) AddRequiredDiscriminatorDescription(discriminator string, values ...string) *AttributeDescription {
+	v := make([]string, len(values))
+	for i, value := range values {
+		v[i] = fmt.Sprintf("`%s`", value)
+	}
+	d.String = fmt.Sprintf("%s\n  - Required and only valid if `%s` is one of: %s", d.String, discriminator, strings.Join(v, ", "))
+	return d
+}
+
+func (d *AttributeDescription) AddImpliesDescription(attributes ...string) *AttributeDescription {
+	v := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		v[i] = fmt.Sprintf("`%s`", attribute)
+	}
+	d.String = fmt.Sprintf("%s\n  - Requires: %s", d.String, strings.Join(v, ", "))
+	return d
+}
+
+func (d *AttributeDescription) AddReservedNamesDescription(additional ...string) *AttributeDescription {
+	names := append(append([]string{}, ReservedNames...), additional...)
+	v := make([]string, len(names))
+	for i, name := range names {
+		v[i] = fmt.Sprintf("`%s`", name)
+	}
+	d.String = fmt.Sprintf("%s\n  - Reserved names: %s", d.String, strings.Join(v, ", "))
+	return d
+}
+
+func (d *AttributeDescription) AddWithinCidrDescription(cidr string) *AttributeDescription {
+	d.String = fmt.Sprintf("%s\n  - Must be within: `%s`", d.String, cidr)
+	return d
+}
+
+func (d *AttributeDescription) AddWithinCidrAttributeDescription(attribute string) *AttributeDescription {
+	d.String = fmt.Sprintf("%s\n  - Must be within the prefix of: `%s`", d.String, attribute)
+	return d
+}
@@ -0,0 +1,40 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddExperimentalDescription(t *testing.T) {
+	description := NewAttributeDescription("This resource can manage a Network.").AddExperimentalDescription("resource").String
+	if !strings.HasPrefix(description, "~> **Experimental:** This resource is experimental") {
+		t.Errorf("expected the description to start with the experimental note, got: %s", description)
+	}
+	if !strings.HasSuffix(description, "\n\nThis resource can manage a Network.") {
+		t.Errorf("expected the original description after the experimental note, got: %s", description)
+	}
+}
+
+func TestAddSoftDeleteDescription(t *testing.T) {
+	description := NewAttributeDescription("This resource can manage a Rule.").AddSoftDeleteDescription("enabled").String
+	if !strings.HasPrefix(description, "This resource can manage a Rule.\n\n~> **Note:** Destroying this resource does not delete the object") || !strings.Contains(description, "`enabled` to `false`") {
+		t.Errorf("expected the soft delete note after the original description, got: %s", description)
+	}
+}
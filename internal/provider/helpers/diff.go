@@ -0,0 +1,118 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Difference is an attribute with different values in two compared objects, the value is nil if the
+// attribute is not set in the object
+type Difference struct {
+	Attribute string
+	First     *string
+	Second    *string
+}
+
+// Diff compares two objects attribute by attribute and returns the differences ordered by attribute path,
+// e.g. "objects[0].id", top-level attributes with one of the ignored names are not compared
+func Diff(first, second attr.Value, ignore ...string) []Difference {
+	firstValues := make(map[string]string)
+	secondValues := make(map[string]string)
+	flatten("", first, firstValues)
+	flatten("", second, secondValues)
+
+	paths := make([]string, 0, len(firstValues)+len(secondValues))
+	for path := range firstValues {
+		paths = append(paths, path)
+	}
+	for path := range secondValues {
+		if _, ok := firstValues[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var differences []Difference
+	for _, path := range paths {
+		if ignored(path, ignore) {
+			continue
+		}
+		firstValue, inFirst := firstValues[path]
+		secondValue, inSecond := secondValues[path]
+		if inFirst && inSecond && firstValue == secondValue {
+			continue
+		}
+		difference := Difference{Attribute: path}
+		if inFirst {
+			difference.First = &firstValue
+		}
+		if inSecond {
+			difference.Second = &secondValue
+		}
+		differences = append(differences, difference)
+	}
+	return differences
+}
+
+// flatten collects the values of all set primitive attributes by their path, list and set elements are
+// identified by their position
+func flatten(path string, value attr.Value, values map[string]string) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return
+	}
+	switch v := value.(type) {
+	case types.Object:
+		for name, attribute := range v.Attributes() {
+			if path == "" {
+				flatten(name, attribute, values)
+			} else {
+				flatten(path+"."+name, attribute, values)
+			}
+		}
+	case types.List:
+		for i, element := range v.Elements() {
+			flatten(fmt.Sprintf("%s[%d]", path, i), element, values)
+		}
+	case types.Set:
+		for i, element := range v.Elements() {
+			flatten(fmt.Sprintf("%s[%d]", path, i), element, values)
+		}
+	case types.String:
+		values[path] = v.ValueString()
+	case types.Float64:
+		values[path] = strconv.FormatFloat(v.ValueFloat64(), 'f', -1, 64)
+	default:
+		values[path] = value.String()
+	}
+}
+
+func ignored(path string, ignore []string) bool {
+	for _, name := range ignore {
+		if path == name || strings.HasPrefix(path, name+".") || strings.HasPrefix(path, name+"[") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiff(t *testing.T) {
+	memberTypes := map[string]attr.Type{"id": types.StringType}
+	attrTypes := map[string]attr.Type{
+		"id":      types.StringType,
+		"name":    types.StringType,
+		"enabled": types.BoolType,
+		"mtu":     types.Int64Type,
+		"members": types.ListType{ElemType: types.ObjectType{AttrTypes: memberTypes}},
+	}
+	member := func(id string) attr.Value {
+		return types.ObjectValueMust(memberTypes, map[string]attr.Value{"id": types.StringValue(id)})
+	}
+	first := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"id":      types.StringValue("ID1"),
+		"name":    types.StringValue("NAME1"),
+		"enabled": types.BoolValue(true),
+		"mtu":     types.Int64Null(),
+		"members": types.ListValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{member("M1")}),
+	})
+	second := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"id":      types.StringValue("ID2"),
+		"name":    types.StringValue("NAME1"),
+		"enabled": types.BoolValue(false),
+		"mtu":     types.Int64Value(1500),
+		"members": types.ListValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{member("M1"), member("M2")}),
+	})
+
+	value := func(v *string) string {
+		if v == nil {
+			return "<null>"
+		}
+		return *v
+	}
+	var differences []string
+	for _, difference := range Diff(first, second, "id") {
+		differences = append(differences, fmt.Sprintf("%s: %s -> %s", difference.Attribute, value(difference.First), value(difference.Second)))
+	}
+	expected := "enabled: true -> false, members[1].id: <null> -> M2, mtu: <null> -> 1500"
+	if strings.Join(differences, ", ") != expected {
+		t.Errorf("expected differences %s, got: %s", expected, strings.Join(differences, ", "))
+	}
+	if differences := Diff(first, first); len(differences) != 0 {
+		t.Errorf("expected no differences of identical objects, got: %v", differences)
+	}
+}
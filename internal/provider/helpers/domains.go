@@ -0,0 +1,63 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"net/http/cookiejar"
+	"sync"
+
+	"github.com/netascode/go-fmc"
+)
+
+// DomainClients caches one client per FMC domain, every client authenticates on its own and keeps the
+// token of its domain, so that switching between domains does not use a token of another domain
+type DomainClients struct {
+	mu      sync.Mutex
+	clients map[string]*fmc.Client
+}
+
+func NewDomainClients() *DomainClients {
+	return &DomainClients{clients: make(map[string]*fmc.Client)}
+}
+
+// Client returns the cached client of the domain, which is derived from the base client on first use,
+// the base client is returned for the default domain or if no cache is configured
+func (d *DomainClients) Client(base *fmc.Client, domain string) *fmc.Client {
+	if d == nil || domain == "" {
+		return base
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if client, ok := d.clients[domain]; ok {
+		return client
+	}
+
+	// The HTTP client is copied to keep the session cookies of the domains apart, the rate limit of FMC
+	// applies to all domains and remains shared
+	client := *base
+	httpClient := *base.HttpClient
+	httpClient.Jar, _ = cookiejar.New(nil)
+	client.HttpClient = &httpClient
+	client.AuthenticationMutex = &sync.Mutex{}
+	client.AuthToken = ""
+	client.RefreshToken = ""
+	client.RefreshCount = 0
+	client.Domains = nil
+	d.clients[domain] = &client
+	return &client
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// MaxEnrichRequests is the maximum number of follow-up requests of a single read, which retrieve the
+// objects referenced by the object to populate computed display attributes
+var MaxEnrichRequests = 20
+
+// ErrEnrichLimit is returned if a follow-up request would exceed MaxEnrichRequests
+var ErrEnrichLimit = errors.New("maximum number of follow-up requests reached")
+
+// Enricher retrieves fields of referenced objects, each object is retrieved at most once
+type Enricher struct {
+	client   *fmc.Client
+	mods     []func(*fmc.Req)
+	objects  map[string]gjson.Result
+	requests int
+}
+
+func NewEnricher(client *fmc.Client, mods ...func(*fmc.Req)) *Enricher {
+	return &Enricher{client: client, mods: mods, objects: make(map[string]gjson.Result)}
+}
+
+// Get returns the field of the object with the given ID below the endpoint, the result does not exist if
+// the ID is empty
+func (e *Enricher) Get(endpoint, id, field string) (gjson.Result, error) {
+	if id == "" {
+		return gjson.Result{}, nil
+	}
+	path := endpoint + "/" + id
+	if object, ok := e.objects[path]; ok {
+		return object.Get(field), nil
+	}
+	if e.requests >= MaxEnrichRequests {
+		return gjson.Result{}, fmt.Errorf("%w (%d), %s not retrieved", ErrEnrichLimit, MaxEnrichRequests, path)
+	}
+	e.requests++
+	res, err := e.client.Get(path, e.mods...)
+	if err != nil {
+		return gjson.Result{}, fmt.Errorf("failed to retrieve %s, got error: %w", path, err)
+	}
+	e.objects[path] = res
+	return res.Get(field), nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+func TestEnricher(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		id := path.Base(r.URL.Path)
+		fmt.Fprintf(w, `{"id": "%s", "name": "NAME-%s"}`, id, id)
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+
+	defer func(limit int) { MaxEnrichRequests = limit }(MaxEnrichRequests)
+	MaxEnrichRequests = 2
+	enricher := NewEnricher(&client)
+	for _, id := range []string{"ID1", "ID2", "ID1"} {
+		if value, err := enricher.Get("/object/networks", id, "name"); err != nil || value.String() != "NAME-"+id {
+			t.Errorf("expected name of %s, got: %s, %v", id, value.String(), err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected each object to be retrieved once, got %d requests", requests)
+	}
+	if value, err := enricher.Get("/object/networks", "", "name"); err != nil || value.Exists() {
+		t.Errorf("expected no request for an empty ID, got: %s, %v", value.String(), err)
+	}
+	if _, err := enricher.Get("/object/networks", "ID3", "name"); !errors.Is(err, ErrEnrichLimit) {
+		t.Errorf("expected the number of requests to be bounded, got: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected no request beyond the limit, got %d requests", requests)
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// jsonSchemaKeywords are the keywords of a JSON Schema supported by ValidateJSONSchema, the annotations
+// $schema, $id, $comment, title, description, default and examples are accepted without effect
+var jsonSchemaKeywords = []string{"type", "enum", "const", "properties", "required", "additionalProperties", "items", "minItems", "maxItems", "minLength", "maxLength", "pattern", "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum"}
+
+var jsonSchemaAnnotations = []string{"$schema", "$id", "$comment", "title", "description", "default", "examples"}
+
+// ValidateJSONSchema validates a JSON document against a JSON Schema and returns the violations, each
+// prefixed with the path of the violating value, e.g. "$.rules[0].port". An error is returned if the schema
+// itself is invalid or uses a keyword which is not supported, e.g. oneOf or $ref.
+func ValidateJSONSchema(schema, document string) ([]string, error) {
+	var s interface{}
+	if err := json.Unmarshal([]byte(schema), &s); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	if err := checkJSONSchemaKeywords(s, "$"); err != nil {
+		return nil, err
+	}
+	var d interface{}
+	if err := json.Unmarshal([]byte(document), &d); err != nil {
+		return []string{fmt.Sprintf("$: is not valid JSON: %s", err)}, nil
+	}
+	var violations []string
+	if err := validateJSONValue(s, d, "$", &violations); err != nil {
+		return nil, err
+	}
+	return violations, nil
+}
+
+// checkJSONSchemaKeywords returns an error naming the first unsupported keyword of the schema and its nested
+// schemas, the path is the location of the schema within the document
+func checkJSONSchemaKeywords(schema interface{}, path string) error {
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	keywords := make([]string, 0, len(s))
+	for keyword := range s {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	for _, keyword := range keywords {
+		if !Contains(jsonSchemaKeywords, keyword) && !Contains(jsonSchemaAnnotations, keyword) {
+			return fmt.Errorf("unsupported JSON schema keyword %s at %s", keyword, path)
+		}
+	}
+	if properties, ok := s["properties"].(map[string]interface{}); ok {
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := checkJSONSchemaKeywords(properties[name], path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+	if err := checkJSONSchemaKeywords(s["additionalProperties"], path+".*"); err != nil {
+		return err
+	}
+	return checkJSONSchemaKeywords(s["items"], path+"[]")
+}
+
+func validateJSONValue(schema, value interface{}, path string, violations *[]string) error {
+	if b, ok := schema.(bool); ok {
+		if !b {
+			*violations = append(*violations, fmt.Sprintf("%s: is not allowed", path))
+		}
+		return nil
+	}
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid JSON schema at %s: must be an object or a boolean", path)
+	}
+	violation := func(format string, args ...interface{}) {
+		*violations = append(*violations, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	if t, ok := s["type"]; ok {
+		var types []string
+		switch t := t.(type) {
+		case string:
+			types = []string{t}
+		case []interface{}:
+			for _, v := range t {
+				types = append(types, fmt.Sprint(v))
+			}
+		default:
+			return fmt.Errorf("invalid JSON schema at %s: type must be a string or an array", path)
+		}
+		matched := false
+		for _, t := range types {
+			if jsonTypeMatches(t, value) {
+				matched = true
+			}
+		}
+		if !matched {
+			violation("must be of type %s, got %s", strings.Join(types, " or "), jsonTypeName(value))
+			return nil
+		}
+	}
+	if enum, ok := s["enum"].([]interface{}); ok {
+		found := false
+		for _, e := range enum {
+			if reflect.DeepEqual(e, value) {
+				found = true
+			}
+		}
+		if !found {
+			values, _ := json.Marshal(enum)
+			violation("must be one of %s", values)
+		}
+	}
+	if c, ok := s["const"]; ok && !reflect.DeepEqual(c, value) {
+		expected, _ := json.Marshal(c)
+		violation("must be %s", expected)
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties, _ := s["properties"].(map[string]interface{})
+		if required, ok := s["required"].([]interface{}); ok {
+			for _, name := range required {
+				if _, ok := v[fmt.Sprint(name)]; !ok {
+					violation("missing required property %q", name)
+				}
+			}
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if property, ok := properties[name]; ok {
+				if err := validateJSONValue(property, v[name], path+"."+name, violations); err != nil {
+					return err
+				}
+			} else if additional, ok := s["additionalProperties"]; ok {
+				if err := validateJSONValue(additional, v[name], path+"."+name, violations); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		if min, ok := s["minItems"].(float64); ok && float64(len(v)) < min {
+			violation("must have at least %v items, got %d", min, len(v))
+		}
+		if max, ok := s["maxItems"].(float64); ok && float64(len(v)) > max {
+			violation("must have at most %v items, got %d", max, len(v))
+		}
+		if items, ok := s["items"]; ok {
+			for i, item := range v {
+				if err := validateJSONValue(items, item, fmt.Sprintf("%s[%d]", path, i), violations); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		length := len([]rune(v))
+		if min, ok := s["minLength"].(float64); ok && float64(length) < min {
+			violation("must be at least %v characters long", min)
+		}
+		if max, ok := s["maxLength"].(float64); ok && float64(length) > max {
+			violation("must be at most %v characters long", max)
+		}
+		if pattern, ok := s["pattern"].(string); ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return fmt.Errorf("invalid JSON schema at %s: invalid pattern: %w", path, err)
+			}
+			if !re.MatchString(v) {
+				violation("must match pattern %q", pattern)
+			}
+		}
+	case float64:
+		if min, ok := s["minimum"].(float64); ok && v < min {
+			violation("must be at least %v, got %v", min, v)
+		}
+		if max, ok := s["maximum"].(float64); ok && v > max {
+			violation("must be at most %v, got %v", max, v)
+		}
+		if min, ok := s["exclusiveMinimum"].(float64); ok && v <= min {
+			violation("must be greater than %v, got %v", min, v)
+		}
+		if max, ok := s["exclusiveMaximum"].(float64); ok && v >= max {
+			violation("must be less than %v, got %v", max, v)
+		}
+	}
+	return nil
+}
+
+func jsonTypeMatches(t string, value interface{}) bool {
+	switch t {
+	case "integer":
+		v, ok := value.(float64)
+		return ok && v == math.Trunc(v)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	}
+	return jsonTypeName(value) == t
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	}
+	return "object"
+}
+
+type jsonSchemaValidator struct {
+	schema string
+}
+
+// JSONSchemaValidator validates that a string is a JSON document valid against the given JSON Schema, see
+// ValidateJSONSchema for the supported keywords
+func JSONSchemaValidator(schema string) validator.String {
+	return jsonSchemaValidator{schema: schema}
+}
+
+func (v jsonSchemaValidator) Description(ctx context.Context) string {
+	return "value must be a JSON document valid against the JSON schema of the attribute"
+}
+
+func (v jsonSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonSchemaValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	violations, err := ValidateJSONSchema(v.schema, req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON Schema", fmt.Sprintf("The JSON schema of attribute %s is invalid: %s", req.Path, err))
+		return
+	}
+	if len(violations) > 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s does not match its JSON schema:\n%s", req.Path, strings.Join(violations, "\n")))
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"strings"
+	"testing"
+)
+
+const testJSONSchema = `{
+  "type": "object",
+  "required": ["name", "rules"],
+  "additionalProperties": false,
+  "properties": {
+    "name": {"type": "string", "minLength": 1, "pattern": "^[A-Za-z0-9_-]+$"},
+    "mode": {"enum": ["strict", "relaxed"]},
+    "rules": {
+      "type": "array",
+      "minItems": 1,
+      "items": {
+        "type": "object",
+        "required": ["port"],
+        "properties": {
+          "port": {"type": "integer", "minimum": 1, "maximum": 65535},
+          "protocol": {"type": ["string", "null"]}
+        }
+      }
+    }
+  }
+}`
+
+func TestValidateJSONSchema(t *testing.T) {
+	tests := []struct {
+		document   string
+		violations []string
+	}{
+		{`{"name": "web", "mode": "strict", "rules": [{"port": 443, "protocol": "tcp"}, {"port": 53, "protocol": null}]}`, nil},
+		{`{"name": "web", "rules": [{"port": 0}, {"port": 8.5}, {}]}`, []string{
+			"$.rules[0].port: must be at least 1, got 0",
+			"$.rules[1].port: must be of type integer, got number",
+			`$.rules[2]: missing required property "port"`,
+		}},
+		{`{"name": "web server", "mode": "loose", "rules": [], "extra": true}`, []string{
+			"$.extra: is not allowed",
+			`$.mode: must be one of ["strict","relaxed"]`,
+			`$.name: must match pattern "^[A-Za-z0-9_-]+$"`,
+			"$.rules: must have at least 1 items, got 0",
+		}},
+		{`["web"]`, []string{"$: must be of type object, got array"}},
+		{`{"name": `, []string{"$: is not valid JSON: unexpected end of JSON input"}},
+	}
+	for _, tt := range tests {
+		violations, err := ValidateJSONSchema(testJSONSchema, tt.document)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Join(violations, "\n") != strings.Join(tt.violations, "\n") {
+			t.Errorf("document %s: expected violations:\n%s\ngot:\n%s", tt.document, strings.Join(tt.violations, "\n"), strings.Join(violations, "\n"))
+		}
+	}
+	if _, err := ValidateJSONSchema(`{"type": 1}`, `{}`); err == nil {
+		t.Error("expected error for invalid schema")
+	}
+	for _, tt := range []struct {
+		schema string
+		err    string
+	}{
+		{`{"oneOf": [{"type": "string"}, {"type": "integer"}]}`, "unsupported JSON schema keyword oneOf at $"},
+		{`{"properties": {"rules": {"items": {"$ref": "#/definitions/rule"}}}}`, "unsupported JSON schema keyword $ref at $.rules[]"},
+		{`{"additionalProperties": {"allOf": []}}`, "unsupported JSON schema keyword allOf at $.*"},
+		{`{"$schema": "http://json-schema.org/draft-07/schema#", "title": "Settings", "properties": {"name": {"description": "Name"}}}`, ""},
+	} {
+		_, err := ValidateJSONSchema(tt.schema, `{}`)
+		if (err == nil) != (tt.err == "") || (err != nil && err.Error() != tt.err) {
+			t.Errorf("schema %s: expected error '%s', got: %v", tt.schema, tt.err, err)
+		}
+	}
+}
+
+func TestJSONSchemaValidator(t *testing.T) {
+	if !validateString(JSONSchemaValidator(testJSONSchema), `{"name": "web", "rules": [{"port": 443}]}`) {
+		t.Error("expected valid JSON to be accepted")
+	}
+	if validateString(JSONSchemaValidator(testJSONSchema), `{"name": "web", "rules": [{"port": "443"}]}`) {
+		t.Error("expected JSON violating the schema to be rejected")
+	}
+}
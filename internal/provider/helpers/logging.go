@@ -0,0 +1,120 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogLevel defines how much the resources and data sources log.
+type LogLevel int
+
+const (
+	// LogLevelError only logs errors and warnings.
+	LogLevelError LogLevel = iota
+	// LogLevelSummary additionally logs a summary of each operation.
+	LogLevelSummary
+	// LogLevelTrace additionally logs details of each request, e.g. the object ID and the size of the payloads.
+	LogLevelTrace
+)
+
+// LogLevels contains the names of all log levels in ascending verbosity.
+var LogLevels = []string{"error", "summary", "trace"}
+
+// ParseLogLevel converts the name of a log level to a LogLevel.
+func ParseLogLevel(s string) (LogLevel, error) {
+	for i, name := range LogLevels {
+		if name == s {
+			return LogLevel(i), nil
+		}
+	}
+	return LogLevelError, fmt.Errorf("invalid log level '%s', expected one of %v", s, LogLevels)
+}
+
+// RedactedValue replaces the redacted parts of log messages.
+const RedactedValue = "***"
+
+// Logger gates the log output of resources and data sources by the configured log level.
+type Logger struct {
+	Level  LogLevel
+	redact []*regexp.Regexp
+}
+
+// WithRedaction returns a copy of the logger which redacts the matches of the regular expressions in all
+// messages, if an expression has capturing groups only the groups are redacted, e.g. the password of a URL.
+// The FMC client logs the payloads on its own, requests with such payloads need fmc.NoLogPayload.
+func (l Logger) WithRedaction(patterns ...string) Logger {
+	redact := make([]*regexp.Regexp, len(l.redact), len(l.redact)+len(patterns))
+	copy(redact, l.redact)
+	for _, pattern := range patterns {
+		redact = append(redact, regexp.MustCompile(pattern))
+	}
+	l.redact = redact
+	return l
+}
+
+// Redact replaces the matches of the regular expressions in a message with RedactedValue, if an expression
+// has capturing groups only the groups are replaced.
+func Redact(msg string, patterns ...*regexp.Regexp) string {
+	for _, re := range patterns {
+		var b []byte
+		last := 0
+		for _, match := range re.FindAllStringSubmatchIndex(msg, -1) {
+			spans := match[2:]
+			if len(spans) == 0 {
+				spans = match[:2]
+			}
+			for i := 0; i < len(spans); i += 2 {
+				// Unmatched optional groups have negative offsets
+				if spans[i] < last {
+					continue
+				}
+				b = append(b, msg[last:spans[i]]...)
+				b = append(b, RedactedValue...)
+				last = spans[i+1]
+			}
+		}
+		if b != nil {
+			msg = string(append(b, msg[last:]...))
+		}
+	}
+	return msg
+}
+
+// Summary logs a summary of an operation, e.g. the beginning and end of a create.
+func (l Logger) Summary(ctx context.Context, msg string) {
+	if l.Level >= LogLevelSummary {
+		tflog.Debug(ctx, Redact(msg, l.redact...))
+	}
+}
+
+// Trace logs details of an operation, e.g. the size of the payloads sent to and received from the FMC.
+func (l Logger) Trace(ctx context.Context, msg string) {
+	if l.Level >= LogLevelTrace {
+		tflog.Trace(ctx, Redact(msg, l.redact...))
+	}
+}
+
+// Warning logs a warning, e.g. returned by the FMC for a request which succeeded nevertheless.
+func (l Logger) Warning(ctx context.Context, msg string) {
+	tflog.Warn(ctx, Redact(msg, l.redact...))
+}
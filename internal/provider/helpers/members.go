@@ -0,0 +1,90 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// MembershipDelta compares the request bodies of the planned and the prior object, if they only differ in
+// the members of the list at path, the added and removed members (identified by key) are returned as raw
+// JSON and ok is true, otherwise the object has to be configured as a whole
+func MembershipDelta(plan, state, path, key string) (added, removed []string, ok bool) {
+	planOther, _ := sjson.Delete(plan, path)
+	stateOther, _ := sjson.Delete(state, path)
+	if gjson.Get(planOther, "@ugly").Raw != gjson.Get(stateOther, "@ugly").Raw {
+		return nil, nil, false
+	}
+
+	prior := make(map[string]string)
+	for _, member := range gjson.Get(state, path).Array() {
+		prior[member.Get(key).String()] = gjson.Get(member.Raw, "@ugly").Raw
+	}
+	for _, member := range gjson.Get(plan, path).Array() {
+		k := member.Get(key).String()
+		raw, exists := prior[k]
+		if !exists {
+			added = append(added, member.Raw)
+			continue
+		}
+		// Members with changed attributes cannot be expressed as a delta
+		if raw != gjson.Get(member.Raw, "@ugly").Raw {
+			return nil, nil, false
+		}
+		delete(prior, k)
+	}
+	for _, member := range gjson.Get(state, path).Array() {
+		if _, exists := prior[member.Get(key).String()]; exists {
+			removed = append(removed, member.Raw)
+		}
+	}
+	return added, removed, len(added) > 0 || len(removed) > 0
+}
+
+// PatchMembers adds and removes members of the list at path of an object with a PATCH request each, instead
+// of configuring the whole object with a PUT request. Each request is retried on its own after authenticating
+// again, as repeating a delta that was already applied could fail.
+func PatchMembers(client *fmc.Client, objectPath, path string, added, removed []string, mods ...func(*fmc.Req)) (fmc.Res, error) {
+	var res fmc.Res
+	for _, delta := range []struct {
+		action  string
+		members []string
+	}{{"add", added}, {"remove", removed}} {
+		if len(delta.members) == 0 {
+			continue
+		}
+		body, _ := sjson.SetRaw("", path, "["+strings.Join(delta.members, ",")+"]")
+		var err error
+		res, err = fmcerrors.Retry(client, func() (fmc.Res, error) {
+			if err := client.Authenticate(); err != nil {
+				return fmc.Res{}, err
+			}
+			return client.Do(client.NewReq("PATCH", objectPath+"?action="+delta.action, strings.NewReader(body), mods...))
+		})
+		if err != nil {
+			return res, fmt.Errorf("failed to %s members (PATCH), got error: %w", delta.action, err)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,113 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/tidwall/gjson"
+)
+
+// MultipartForm builds the multipart/form-data body of a request uploading files to the FMC
+type MultipartForm struct {
+	body   bytes.Buffer
+	writer *multipart.Writer
+	parts  []string
+}
+
+func NewMultipartForm() *MultipartForm {
+	form := &MultipartForm{}
+	form.writer = multipart.NewWriter(&form.body)
+	return form
+}
+
+// Field adds a form field with the given value
+func (f *MultipartForm) Field(name, value string) error {
+	f.parts = append(f.parts, name)
+	return f.writer.WriteField(name, value)
+}
+
+// File adds the content of a local file as file part, the base name of the path is sent as file name
+func (f *MultipartForm) File(name, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file of '%s', got error: %w", name, err)
+	}
+	part, err := f.writer.CreateFormFile(name, filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	f.parts = append(f.parts, fmt.Sprintf("%s=@%s (%d bytes)", name, filepath.Base(path), len(content)))
+	_, err = part.Write(content)
+	return err
+}
+
+// String lists the parts of the form for logging, without the values of the fields and the content of the files
+func (f *MultipartForm) String() string {
+	return strings.Join(f.parts, ", ")
+}
+
+// PostMultipart sends the form with a POST request. The request is built by the client to resolve the domain of
+// the path, but sent with its HTTP client directly, as the client would add its JSON content type as second
+// value. The payload is not logged as files may have binary content, the request is only retried after
+// authenticating again.
+func PostMultipart(client *fmc.Client, path string, form *MultipartForm, mods ...func(*fmc.Req)) (fmc.Res, error) {
+	if err := form.writer.Close(); err != nil {
+		return fmc.Res{}, err
+	}
+	return fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return postMultipart(client, path, form, mods...)
+	})
+}
+
+func postMultipart(client *fmc.Client, path string, form *MultipartForm, mods ...func(*fmc.Req)) (fmc.Res, error) {
+	if err := client.Authenticate(); err != nil {
+		return fmc.Res{}, err
+	}
+	req := client.NewReq("POST", path, bytes.NewReader(form.body.Bytes()), append(mods, fmc.NoLogPayload)...)
+	req.HttpReq.Header.Set("X-auth-access-token", client.AuthToken)
+	req.HttpReq.Header.Set("Content-Type", form.writer.FormDataContentType())
+	req.HttpReq.Header.Set("Accept", "application/json")
+
+	client.RateLimiterBucket.Wait(1)
+	httpRes, err := client.HttpClient.Do(req.HttpReq)
+	if err != nil {
+		return fmc.Res{}, err
+	}
+	defer httpRes.Body.Close()
+	body, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		return fmc.Res{}, fmt.Errorf("failed to read response body, got error: %w", err)
+	}
+	res := fmc.Res(gjson.ParseBytes(body))
+	if httpRes.StatusCode < 200 || httpRes.StatusCode > 299 {
+		return res, fmt.Errorf("HTTP Request failed: StatusCode %v", httpRes.StatusCode)
+	}
+	if msg := res.Get("error.messages.0"); msg.Exists() {
+		return res, fmt.Errorf("JSON error: %s", msg.String())
+	}
+	return res, nil
+}
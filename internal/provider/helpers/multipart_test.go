@@ -0,0 +1,129 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+func TestPostMultipart(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "cert.pem")
+	if err := os.WriteFile(file, []byte("-----BEGIN CERTIFICATE-----"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string][]string
+	var filename, content string
+	var contentTypes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentTypes = r.Header.Values("Content-Type")
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error": {"messages": [{"description": "%s"}]}}`, err)
+			return
+		}
+		fields = r.MultipartForm.Value
+		if files := r.MultipartForm.File["payloadFile"]; len(files) == 1 {
+			filename = files[0].Filename
+			f, _ := files[0].Open()
+			b, _ := io.ReadAll(f)
+			content = string(b)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "ID1"}`)
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+
+	form := NewMultipartForm()
+	form.Field("name", "CERT1")
+	form.Field("type", "ExternalCertificate")
+	if err := form.File("payloadFile", file); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if form.String() != "name, type, payloadFile=@cert.pem (27 bytes)" {
+		t.Errorf("unexpected form description: %s", form.String())
+	}
+	res, err := PostMultipart(&client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/certificates", form)
+	if err != nil {
+		t.Fatalf("unexpected error: %v, %s", err, res.String())
+	}
+	if res.Get("id").String() != "ID1" {
+		t.Errorf("unexpected response: %s", res.String())
+	}
+	if len(contentTypes) != 1 || !strings.HasPrefix(contentTypes[0], "multipart/form-data; boundary=") {
+		t.Errorf("expected a single multipart content type, got: %v", contentTypes)
+	}
+	if fields["name"][0] != "CERT1" || fields["type"][0] != "ExternalCertificate" {
+		t.Errorf("expected form fields, got: %v", fields)
+	}
+	if filename != "cert.pem" || content != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("expected file part cert.pem, got: %s, %s", filename, content)
+	}
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": {"messages": [{"description": "Invalid certificate"}]}}`)
+	})
+	if _, err := PostMultipart(&client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/certificates", NewMultipartForm()); err == nil || !strings.Contains(err.Error(), "StatusCode 400") {
+		t.Errorf("expected status code error, got: %v", err)
+	}
+
+	// An expired access token is replaced by logging in again, the same form is sent again
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+			w.Header().Set("X-auth-access-token", "token2")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Header.Get("X-auth-access-token") != "token2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil || r.MultipartForm.Value["name"][0] != "CERT2" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "ID2"}`)
+	})
+	form = NewMultipartForm()
+	form.Field("name", "CERT2")
+	if res, err := PostMultipart(&client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/certificates", form); err != nil || res.Get("id").String() != "ID2" {
+		t.Errorf("expected request to succeed after authenticating again, got: %v, %s", err, res.String())
+	}
+
+	if err := NewMultipartForm().File("payloadFile", filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
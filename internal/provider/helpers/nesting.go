@@ -0,0 +1,152 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+)
+
+// NestingResolver determines how deep groups are nested by retrieving the groups below a REST endpoint,
+// each group is retrieved at most once and members of other types than the groups are not retrieved
+type NestingResolver struct {
+	client      *fmc.Client
+	endpoint    string
+	membersPath string
+	idPath      string
+	groupType   string
+	mods        []func(*fmc.Req)
+	depths      map[string]int
+	members     map[string][]string
+	names       map[string]string
+}
+
+// NewNestingResolver returns a resolver for the groups below the endpoint, the members of a group are read
+// from the list at membersPath and identified by the field at idPath of the list elements, only members with
+// the type of the groups are resolved
+func NewNestingResolver(client *fmc.Client, endpoint, membersPath, idPath, groupType string, mods ...func(*fmc.Req)) *NestingResolver {
+	return &NestingResolver{client: client, endpoint: endpoint, membersPath: membersPath, idPath: idPath, groupType: groupType, mods: mods, depths: make(map[string]int), members: make(map[string][]string), names: make(map[string]string)}
+}
+
+// group returns the IDs of the members of the group with the given ID, ok is false for objects which are not
+// a group of the endpoint
+func (r *NestingResolver) group(id string) (members []string, ok bool, err error) {
+	if members, ok := r.members[id]; ok {
+		return members, members != nil, nil
+	}
+	res, err := r.client.Get(r.endpoint+"/"+id, r.mods...)
+	if fmcerrors.IsNotFound(err, res) {
+		r.members[id] = nil
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to retrieve group %s, got error: %w", id, err)
+	}
+	members = make([]string, 0)
+	for _, member := range res.Get(r.membersPath).Array() {
+		if r.IsGroup(member.Get("type").String()) {
+			members = append(members, member.Get(r.idPath).String())
+		}
+	}
+	r.members[id] = members
+	r.names[id] = res.Get("name").String()
+	return members, true, nil
+}
+
+// IsGroup returns true if members of the given type are groups of the endpoint, members of other types can not
+// contain groups and are not retrieved. An empty type is not known yet and the member might be a group.
+func (r *NestingResolver) IsGroup(typ string) bool {
+	return typ == "" || typ == r.groupType
+}
+
+// Depth returns the nesting depth of the object with the given ID, which is 0 for objects which are not a
+// group of the endpoint and 1 for groups without nested groups
+func (r *NestingResolver) Depth(id string) (int, error) {
+	if id == "" {
+		return 0, nil
+	}
+	if depth, ok := r.depths[id]; ok {
+		return depth, nil
+	}
+	// A group being resolved is counted as not nested, FMC rejects cyclic groups anyway
+	r.depths[id] = 0
+	members, ok, err := r.group(id)
+	if err != nil {
+		delete(r.depths, id)
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	depth := 1
+	for _, member := range members {
+		d, err := r.Depth(member)
+		if err != nil {
+			delete(r.depths, id)
+			return 0, err
+		}
+		if d+1 > depth {
+			depth = d + 1
+		}
+	}
+	r.depths[id] = depth
+	return depth, nil
+}
+
+// Cycle returns the names of the nested groups through which one of the members contains the group with the
+// given ID, starting with the member, an empty list if the group is a member of itself and nil without a cycle
+func (r *NestingResolver) Cycle(id string, members []string) ([]string, error) {
+	visited := make(map[string]bool)
+	var find func(member string) ([]string, error)
+	find = func(member string) ([]string, error) {
+		if member == id {
+			return []string{}, nil
+		}
+		if member == "" || visited[member] {
+			return nil, nil
+		}
+		visited[member] = true
+		nested, _, err := r.group(member)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range nested {
+			cycle, err := find(m)
+			if err != nil {
+				return nil, err
+			}
+			if cycle != nil {
+				name := r.names[member]
+				if name == "" {
+					name = member
+				}
+				return append([]string{name}, cycle...), nil
+			}
+		}
+		return nil, nil
+	}
+	for _, member := range members {
+		cycle, err := find(member)
+		if err != nil || cycle != nil {
+			return cycle, err
+		}
+	}
+	return nil, nil
+}
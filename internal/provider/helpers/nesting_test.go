@@ -0,0 +1,119 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+func TestNestingResolver(t *testing.T) {
+	groups := map[string]string{
+		"GROUP-1": `{"id": "GROUP-1", "objects": [{"id": "GROUP-2", "type": "NetworkGroup"}, {"id": "NETWORK-1", "type": "Network"}]}`,
+		"GROUP-2": `{"id": "GROUP-2", "objects": [{"id": "GROUP-3", "type": "NetworkGroup"}, {"id": "NETWORK-2", "type": "Network"}]}`,
+		"GROUP-3": `{"id": "GROUP-3", "objects": [{"id": "NETWORK-1", "type": "Network"}]}`,
+	}
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[path.Base(r.URL.Path)]++
+		w.Header().Set("Content-Type", "application/json")
+		if group, ok := groups[path.Base(r.URL.Path)]; ok {
+			fmt.Fprint(w, group)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"messages": [{"description": "Not found"}]}}`)
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+
+	resolver := NewNestingResolver(&client, "/object/networkgroups", "objects", "id", "NetworkGroup")
+	for _, tt := range []struct {
+		id       string
+		expected int
+	}{
+		{"GROUP-1", 3},
+		{"GROUP-3", 1},
+		{"NETWORK-1", 0},
+		{"", 0},
+	} {
+		if depth, err := resolver.Depth(tt.id); err != nil || depth != tt.expected {
+			t.Errorf("expected depth %d of '%s', got: %d, %v", tt.expected, tt.id, depth, err)
+		}
+	}
+	for id, count := range requests {
+		if count != 1 {
+			t.Errorf("expected %s to be retrieved once, got %d requests", id, count)
+		}
+	}
+	if requests["NETWORK-2"] != 0 {
+		t.Error("expected member of another type not to be retrieved")
+	}
+}
+
+func TestNestingResolverCycle(t *testing.T) {
+	groups := map[string]string{
+		"GROUP-1": `{"id": "GROUP-1", "name": "NETGRP1", "objects": [{"id": "NETWORK-1", "type": "Network"}]}`,
+		"GROUP-2": `{"id": "GROUP-2", "name": "NETGRP2", "objects": [{"id": "GROUP-3", "type": "NetworkGroup"}]}`,
+		"GROUP-3": `{"id": "GROUP-3", "name": "NETGRP3", "objects": [{"id": "GROUP-1", "type": "NetworkGroup"}, {"id": "GROUP-2", "type": "NetworkGroup"}]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if group, ok := groups[path.Base(r.URL.Path)]; ok {
+			fmt.Fprint(w, group)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"messages": [{"description": "Not found"}]}}`)
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+
+	resolver := NewNestingResolver(&client, "/object/networkgroups", "objects", "id", "NetworkGroup")
+	for _, tt := range []struct {
+		id       string
+		members  []string
+		expected []string
+	}{
+		{"GROUP-1", []string{"NETWORK-1", "GROUP-1"}, []string{}},
+		{"GROUP-1", []string{"GROUP-3"}, []string{"NETGRP3"}},
+		{"GROUP-1", []string{"GROUP-2"}, []string{"NETGRP2", "NETGRP3"}},
+		{"GROUP-4", []string{"GROUP-2", "NETWORK-1"}, nil},
+	} {
+		cycle, err := resolver.Cycle(tt.id, tt.members)
+		if err != nil || (cycle == nil) != (tt.expected == nil) || fmt.Sprint(cycle) != fmt.Sprint(tt.expected) {
+			t.Errorf("expected cycle %v of '%s' with members %v, got: %v, %v", tt.expected, tt.id, tt.members, cycle, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/tidwall/sjson"
+)
+
+// NonJSONSnippetLength is the maximum length of the snippet of a non-JSON error body in the error message
+var NonJSONSnippetLength = 200
+
+var (
+	htmlTagRegex    = regexp.MustCompile(`(?s)<(script|style)[^>]*>.*?</(script|style)>|<[^>]*>`)
+	whitespaceRegex = regexp.MustCompile(`\s+`)
+)
+
+type nonJSONErrorTransport struct {
+	base http.RoundTripper
+}
+
+// NonJSONErrorTransport returns an HTTP transport replacing non-JSON bodies of error responses, e.g. the
+// HTML error page of an overloaded FMC or a proxy, with an FMC error body whose message names the status
+// and includes a snippet of the text, other responses are passed on unchanged
+func NonJSONErrorTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return nonJSONErrorTransport{base: base}
+}
+
+func (t nonJSONErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil || res.StatusCode < 400 || isJSON(res.Header.Get("Content-Type")) {
+		return res, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		return res, nil
+	}
+
+	message := fmt.Sprintf("%s, status %d", fmcerrors.NonJSONMessage, res.StatusCode)
+	if snippet := NonJSONSnippet(body); snippet != "" {
+		message += ": " + snippet
+	}
+	body, _ = sjson.SetBytes(nil, "error.category", "FRAMEWORK")
+	body, _ = sjson.SetBytes(body, "error.severity", "ERROR")
+	body, _ = sjson.SetBytes(body, "error.messages.0.description", message)
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	res.ContentLength = int64(len(body))
+	res.Header = res.Header.Clone()
+	res.Header.Set("Content-Type", "application/json")
+	res.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return res, nil
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// NonJSONSnippet returns the beginning of the text of a non-JSON body, HTML markup is removed and
+// whitespace is collapsed
+func NonJSONSnippet(body []byte) string {
+	text := strings.TrimSpace(whitespaceRegex.ReplaceAllString(htmlTagRegex.ReplaceAllString(string(body), " "), " "))
+	if len(text) <= NonJSONSnippetLength {
+		return text
+	}
+	text = text[:NonJSONSnippetLength]
+	for !utf8.ValidString(text) {
+		text = text[:len(text)-1]
+	}
+	return text + "..."
+}
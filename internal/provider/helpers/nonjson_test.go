@@ -0,0 +1,86 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+)
+
+func TestNonJSONErrorTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/html":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, "<html><head><title>502 Bad Gateway</title><style>body { color: red; }</style></head>\n<body><h1>Bad Gateway</h1>\n<p>The proxy server received an invalid response.</p></body></html>")
+		case "/json":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": {"messages": [{"description": "Invalid name"}]}}`)
+		default:
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, "OK")
+		}
+	}))
+	t.Cleanup(server.Close)
+	client, _ := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+	client.HttpClient.Transport = NonJSONErrorTransport(client.HttpClient.Transport)
+
+	res, err := client.Get("/html")
+	if fmcerrors.StatusCode(err) != 502 {
+		t.Fatalf("expected status 502, got error: %v", err)
+	}
+	expected := "FMC returned a non-JSON error, status 502: 502 Bad Gateway Bad Gateway The proxy server received an invalid response."
+	if message := fmcerrors.Message(res); message != expected {
+		t.Errorf("expected message '%s', got: '%s'", expected, message)
+	}
+
+	res, err = client.Get("/json")
+	if err == nil || fmcerrors.Message(res) != "Invalid name" {
+		t.Errorf("expected JSON error to be unchanged, got: %v, %s", err, res.Raw)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/text", nil)
+	httpRes, err := client.HttpClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	httpRes.Body.Close()
+	if httpRes.Header.Get("Content-Type") != "text/plain" {
+		t.Errorf("expected successful response to be unchanged, got content type: %s", httpRes.Header.Get("Content-Type"))
+	}
+}
+
+func TestNonJSONSnippet(t *testing.T) {
+	if snippet := NonJSONSnippet([]byte(strings.Repeat("é", 150))); snippet != strings.Repeat("é", 100)+"..." {
+		t.Errorf("expected snippet truncated to 200 bytes, got: %s", snippet)
+	}
+	if snippet := NonJSONSnippet([]byte("<script>var x = 1;</script>Service Unavailable")); snippet != "Service Unavailable" {
+		t.Errorf("expected scripts to be removed, got: %s", snippet)
+	}
+}
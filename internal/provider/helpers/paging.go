@@ -0,0 +1,53 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// PageLimit is the number of objects requested per page when listing all objects of an endpoint
+var PageLimit = 1000
+
+// GetAllPages lists the objects of an endpoint page by page and returns a response with the items of all
+// pages, an error returns the response of the failed page
+func GetAllPages(client *fmc.Client, endpoint string, mods ...func(*fmc.Req)) (fmc.Res, error) {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	body := `{"items":[]}`
+	for offset := 0; ; offset += PageLimit {
+		res, err := client.Get(fmt.Sprintf("%s%slimit=%d&offset=%d", endpoint, separator, PageLimit, offset), mods...)
+		if err != nil {
+			return res, err
+		}
+		for _, item := range res.Get("items").Array() {
+			body, _ = sjson.SetRaw(body, "items.-1", item.Raw)
+		}
+		if !res.Get("paging.next.0").Exists() {
+			break
+		}
+	}
+	return gjson.Parse(body), nil
+}
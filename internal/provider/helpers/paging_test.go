@@ -0,0 +1,67 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+func TestGetAllPages(t *testing.T) {
+	limit := PageLimit
+	PageLimit = 2
+	t.Cleanup(func() { PageLimit = limit })
+
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			fmt.Fprint(w, `{"items": [{"id": "1"}, {"id": "2"}], "paging": {"next": ["page2"]}}`)
+		case "2":
+			fmt.Fprint(w, `{"items": [{"id": "3"}], "paging": {}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create mock client: %s", err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+	client.DomainUUID = "e276abec-e0f2-11e3-8169-6d9ed49b625f"
+
+	res, err := GetAllPages(&client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords?expanded=true")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Get("items.#").Int() != 3 || res.Get("items.2.id").String() != "3" {
+		t.Errorf("expected the items of both pages, got: %s", res.Raw)
+	}
+	if len(queries) != 2 || queries[1] != "expanded=true&limit=2&offset=2" {
+		t.Errorf("unexpected queries: %v", queries)
+	}
+}
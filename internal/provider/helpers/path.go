@@ -0,0 +1,84 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// PathSegment is a level of an object hierarchy, the object is identified by its name below the REST
+// endpoint, which contains a "%v" placeholder for the ID of each previous level
+type PathSegment struct {
+	Endpoint string
+	Name     string
+}
+
+// ResolvePath walks an object hierarchy (e.g. policy, category and rule) level by level and returns the
+// IDs of the objects of all levels
+func ResolvePath(client *fmc.Client, segments []PathSegment, mods ...func(*fmc.Req)) ([]string, error) {
+	ids := make([]string, 0, len(segments))
+	for level, segment := range segments {
+		args := make([]interface{}, len(ids))
+		for i, id := range ids {
+			args[i] = id
+		}
+		endpoint := fmt.Sprintf(segment.Endpoint, args...)
+
+		var matches []string
+		offset := 0
+		limit := 1000
+		for {
+			res, err := client.Get(fmt.Sprintf("%s?limit=%d&offset=%d", endpoint, limit, offset), mods...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve objects of path segment %d, got error: %w", level+1, err)
+			}
+			res.Get("items").ForEach(func(_, v gjson.Result) bool {
+				if v.Get("name").String() == segment.Name {
+					matches = append(matches, v.Get("id").String())
+				}
+				return true
+			})
+			if !res.Get("paging.next.0").Exists() {
+				break
+			}
+			offset += limit
+		}
+
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("path segment %d: no object with name '%s' found below '%s'", level+1, segment.Name, pathString(segments[:level]))
+		case 1:
+			ids = append(ids, matches[0])
+		default:
+			return nil, fmt.Errorf("path segment %d: name '%s' is ambiguous below '%s', found %d objects: %s", level+1, segment.Name, pathString(segments[:level]), len(matches), strings.Join(matches, ", "))
+		}
+	}
+	return ids, nil
+}
+
+func pathString(segments []PathSegment) string {
+	names := make([]string, len(segments))
+	for i, segment := range segments {
+		names[i] = segment.Name
+	}
+	return "/" + strings.Join(names, "/")
+}
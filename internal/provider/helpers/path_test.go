@@ -0,0 +1,91 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+func TestResolvePath(t *testing.T) {
+	prefix := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f"
+	responses := map[string]string{
+		"/policy/accesspolicies":                                      `{"items": [{"id": "POLICY-1", "name": "POLICY1"}, {"id": "POLICY-2", "name": "POLICY2"}]}`,
+		"/policy/accesspolicies/POLICY-1/categories":                  `{"items": [{"id": "CATEGORY-1", "name": "Category1"}, {"id": "CATEGORY-2", "name": "Duplicate"}, {"id": "CATEGORY-3", "name": "Duplicate"}]}`,
+		"/policy/accesspolicies/POLICY-1/categories/CATEGORY-1/rules": `{"items": [{"id": "RULE-1", "name": "Rule1"}]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[strings.TrimPrefix(r.URL.Path, prefix)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create mock client: %s", err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+	client.DomainUUID = "e276abec-e0f2-11e3-8169-6d9ed49b625f"
+
+	segments := func(names ...string) []PathSegment {
+		endpoints := []string{
+			"/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies",
+			"/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories",
+			"/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories/%v/rules",
+		}
+		result := make([]PathSegment, len(names))
+		for i, name := range names {
+			result[i] = PathSegment{Endpoint: endpoints[i], Name: name}
+		}
+		return result
+	}
+
+	ids, err := ResolvePath(&client, segments("POLICY1", "Category1", "Rule1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(ids, ",") != "POLICY-1,CATEGORY-1,RULE-1" {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+
+	tests := []struct {
+		names []string
+		err   string
+	}{
+		{[]string{"POLICY1", "Category1", "Rule2"}, "path segment 3: no object with name 'Rule2' found below '/POLICY1/Category1'"},
+		{[]string{"POLICY3", "Category1", "Rule1"}, "path segment 1: no object with name 'POLICY3' found below '/'"},
+		{[]string{"POLICY1", "Duplicate", "Rule1"}, "path segment 2: name 'Duplicate' is ambiguous below '/POLICY1', found 2 objects: CATEGORY-2, CATEGORY-3"},
+		{[]string{"POLICY2", "Category1", "Rule1"}, "failed to retrieve objects of path segment 2"},
+	}
+	for _, tt := range tests {
+		if _, err := ResolvePath(&client, segments(tt.names...)); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("path %v: expected error '%s', got: %v", tt.names, tt.err, err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnknownOnChange(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Computed: true},
+		"type": schema.StringAttribute{Optional: true},
+		"name": schema.StringAttribute{Optional: true},
+	}}
+	object := func(objectType, name string) tftypes.Value {
+		return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "ID1"),
+			"type": tftypes.NewValue(tftypes.String, objectType),
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	tests := []struct {
+		name    string
+		state   tftypes.Value
+		plan    tftypes.Value
+		unknown bool
+	}{
+		{"changed", object("A", "NAME1"), object("B", "NAME1"), true},
+		{"other attribute changed", object("A", "NAME1"), object("A", "NAME2"), false},
+		{"unchanged", object("A", "NAME1"), object("A", "NAME1"), false},
+		{"create", tftypes.NewValue(s.Type().TerraformType(ctx), nil), object("A", "NAME1"), false},
+	}
+	for _, tt := range tests {
+		req := planmodifier.StringRequest{
+			Path:       path.Root("id"),
+			State:      tfsdk.State{Schema: s, Raw: tt.state},
+			Plan:       tfsdk.Plan{Schema: s, Raw: tt.plan},
+			StateValue: types.StringValue("ID1"),
+			PlanValue:  types.StringValue("ID1"),
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		UnknownOnChange(path.Root("type")).PlanModifyString(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected error: %v", tt.name, resp.Diagnostics)
+		}
+		if resp.PlanValue.IsUnknown() != tt.unknown {
+			t.Errorf("%s: expected unknown id %v, got: %v", tt.name, tt.unknown, resp.PlanValue)
+		}
+	}
+}
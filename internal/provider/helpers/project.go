@@ -0,0 +1,58 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Project returns a copy of the response with only the given JSON paths. Paths with "#" select fields of
+// the elements of a list, e.g. "rules.#.name", all other fields of the elements are dropped as well
+func Project(res gjson.Result, paths ...string) gjson.Result {
+	body := "{}"
+	var lists []string
+	fields := make(map[string][]string)
+	for _, path := range paths {
+		if list, field, ok := strings.Cut(path, ".#."); ok {
+			if _, ok := fields[list]; !ok {
+				lists = append(lists, list)
+			}
+			fields[list] = append(fields[list], field)
+			continue
+		}
+		if value := res.Get(path); value.Exists() {
+			body, _ = sjson.SetRaw(body, path, value.Raw)
+		}
+	}
+	for _, list := range lists {
+		value := res.Get(list)
+		if !value.IsArray() {
+			continue
+		}
+		elements := value.Array()
+		items := make([]string, 0, len(elements))
+		for _, item := range elements {
+			items = append(items, Project(item, fields[list]...).Raw)
+		}
+		body, _ = sjson.SetRaw(body, list, "["+strings.Join(items, ",")+"]")
+	}
+	return gjson.Parse(body)
+}
@@ -0,0 +1,54 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestProject(t *testing.T) {
+	res := gjson.Parse(`{
+	  "id": "POLICY-1",
+	  "name": "POLICY1",
+	  "description": "Not tracked",
+	  "defaultAction": {"id": "ACTION-1", "action": "BLOCK", "logBegin": false},
+	  "rules": [
+	    {"id": "RULE-1", "name": "RULE1", "sourceNetworks": {"objects": [{"id": "NET-1", "name": "NET1", "type": "Network"}]}, "metadata": {"ruleIndex": 1}},
+	    {"id": "RULE-2", "name": "RULE2", "metadata": {"ruleIndex": 2}}
+	  ],
+	  "tags": "not a list",
+	  "links": {"self": "https://fmc/POLICY-1"}
+	}`)
+	tests := []struct {
+		paths    []string
+		expected string
+	}{
+		{[]string{"id", "name"}, `{"id":"POLICY-1","name":"POLICY1"}`},
+		{[]string{"defaultAction.action", "unknown"}, `{"defaultAction":{"action":"BLOCK"}}`},
+		{[]string{"rules.#.name"}, `{"rules":[{"name":"RULE1"},{"name":"RULE2"}]}`},
+		{[]string{"rules.#.id", "rules.#.sourceNetworks.objects.#.id"}, `{"rules":[{"id":"RULE-1","sourceNetworks":{"objects":[{"id":"NET-1"}]}},{"id":"RULE-2"}]}`},
+		{[]string{"tags.#.name", "rules.#.unknown"}, `{"rules":[{},{}]}`},
+	}
+	for _, tt := range tests {
+		if output := Project(res, tt.paths...).Raw; output != tt.expected {
+			t.Errorf("expected %s for %v, got: %s", tt.expected, tt.paths, output)
+		}
+	}
+}
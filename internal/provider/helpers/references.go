@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// ErrNameNotFound is returned if no object with a given name exists
+var ErrNameNotFound = errors.New("no object found")
+
+// NameResolver collects the names of referenced objects and resolves them to IDs, the objects of each
+// REST endpoint are listed once for all names of that endpoint
+type NameResolver struct {
+	names map[string]map[string]string
+}
+
+func NewNameResolver() *NameResolver {
+	return &NameResolver{names: make(map[string]map[string]string)}
+}
+
+// Add registers the name of an object below the given endpoint for resolution
+func (r *NameResolver) Add(endpoint, name string) {
+	if r.names[endpoint] == nil {
+		r.names[endpoint] = make(map[string]string)
+	}
+	r.names[endpoint][name] = ""
+}
+
+// Resolve lists the objects of all endpoints with registered names, listing an endpoint stops as soon as
+// all its names have been found
+func (r *NameResolver) Resolve(client *fmc.Client, mods ...func(*fmc.Req)) error {
+	endpoints := make([]string, 0, len(r.names))
+	for endpoint := range r.names {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	for _, endpoint := range endpoints {
+		ids := r.names[endpoint]
+		missing := len(ids)
+		offset := 0
+		limit := 1000
+		for missing > 0 {
+			res, err := client.Get(fmt.Sprintf("%s?limit=%d&offset=%d", endpoint, limit, offset), mods...)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve objects of '%s', got error: %w", endpoint, err)
+			}
+			res.Get("items").ForEach(func(_, v gjson.Result) bool {
+				name := v.Get("name").String()
+				if id, ok := ids[name]; ok && id == "" {
+					ids[name] = v.Get("id").String()
+					missing--
+				}
+				return true
+			})
+			if !res.Get("paging.next.0").Exists() {
+				break
+			}
+			offset += limit
+		}
+
+		if missing > 0 {
+			var names []string
+			for name, id := range ids {
+				if id == "" {
+					names = append(names, name)
+				}
+			}
+			sort.Strings(names)
+			return fmt.Errorf("%w below '%s' with name: %s", ErrNameNotFound, endpoint, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// Id returns the ID of a resolved object, or an empty string if the name has not been resolved
+func (r *NameResolver) Id(endpoint, name string) string {
+	return r.names[endpoint][name]
+}
+
+// EnsureParent returns the ID of the parent object with the given name below the endpoint, if it does not
+// exist and create is set, the parent is created from the body and reported as owned by the caller
+func EnsureParent(client *fmc.Client, endpoint, name, body string, create bool, mods ...func(*fmc.Req)) (string, bool, error) {
+	resolver := NewNameResolver()
+	resolver.Add(endpoint, name)
+	err := resolver.Resolve(client, mods...)
+	if err == nil {
+		return resolver.Id(endpoint, name), false, nil
+	}
+	if !errors.Is(err, ErrNameNotFound) || !create {
+		return "", false, err
+	}
+	body, _ = sjson.Set(body, "name", name)
+	res, err := client.Post(endpoint, body, mods...)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create parent object '%s', got error: %w, %s", name, err, res.String())
+	}
+	return res.Get("id").String(), true, nil
+}
+
+// ValueExists returns true if an object below the endpoint has the given value in the field, all pages of
+// the endpoint are listed
+func ValueExists(client *fmc.Client, endpoint, field, value string, mods ...func(*fmc.Req)) (bool, error) {
+	res, err := GetAllPages(client, endpoint, mods...)
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve objects of '%s', got error: %w", endpoint, err)
+	}
+	for _, item := range res.Get("items").Array() {
+		if item.Get(field).String() == value {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,132 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+func TestNameResolver(t *testing.T) {
+	prefix := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f"
+	responses := map[string]string{
+		"/object/networks": `{"items": [{"id": "NET-1", "name": "NET1"}, {"id": "NET-2", "name": "NET2"}, {"id": "NET-3", "name": "NET3"}]}`,
+		"/object/hosts":    `{"items": [{"id": "HOST-1", "name": "HOST1"}]}`,
+	}
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		endpoint := strings.TrimPrefix(r.URL.Path, prefix)
+		requests[endpoint]++
+		body, ok := responses[endpoint]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create mock client: %s", err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+	client.DomainUUID = "e276abec-e0f2-11e3-8169-6d9ed49b625f"
+
+	networks := "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks"
+	hosts := "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/hosts"
+	resolver := NewNameResolver()
+	resolver.Add(networks, "NET1")
+	resolver.Add(networks, "NET3")
+	resolver.Add(networks, "NET1")
+	resolver.Add(hosts, "HOST1")
+	if err := resolver.Resolve(&client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests["/object/networks"] != 1 || requests["/object/hosts"] != 1 {
+		t.Errorf("expected a single request per endpoint, got: %v", requests)
+	}
+	for _, ref := range [][]string{{networks, "NET1", "NET-1"}, {networks, "NET3", "NET-3"}, {hosts, "HOST1", "HOST-1"}} {
+		if id := resolver.Id(ref[0], ref[1]); id != ref[2] {
+			t.Errorf("expected id '%s' for '%s', got '%s'", ref[2], ref[1], id)
+		}
+	}
+
+	resolver = NewNameResolver()
+	resolver.Add(networks, "NET4")
+	resolver.Add(networks, "NET2")
+	resolver.Add(networks, "NET0")
+	if err := resolver.Resolve(&client); err == nil || !strings.Contains(err.Error(), "with name: NET0, NET4") {
+		t.Errorf("expected error for missing names, got: %v", err)
+	}
+
+	resolver = NewNameResolver()
+	resolver.Add("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/ranges", "RANGE1")
+	if err := resolver.Resolve(&client); err == nil || !strings.Contains(err.Error(), "failed to retrieve objects") {
+		t.Errorf("expected error for failed request, got: %v", err)
+	}
+}
+
+func TestValueExists(t *testing.T) {
+	interfacesPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/devices/devicerecords/DEVICE-1/physicalinterfaces"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != interfacesPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprint(w, `{"items": [{"id": "INTF-1", "name": "GigabitEthernet0/0"}], "paging": {"next": ["next"]}}`)
+		} else {
+			fmt.Fprint(w, `{"items": [{"id": "INTF-2", "name": "GigabitEthernet0/1"}]}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create mock client: %s", err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+	client.DomainUUID = "e276abec-e0f2-11e3-8169-6d9ed49b625f"
+
+	interfaces := "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords/DEVICE-1/physicalinterfaces"
+	for _, tt := range []struct {
+		value  string
+		exists bool
+	}{
+		{"GigabitEthernet0/0", true},
+		{"GigabitEthernet0/1", true},
+		{"GigabitEthernet0/2", false},
+	} {
+		exists, err := ValueExists(&client, interfaces, "name", tt.value)
+		if err != nil || exists != tt.exists {
+			t.Errorf("expected '%s' to exist %v, got: %v, %v", tt.value, tt.exists, exists, err)
+		}
+	}
+	if _, err := ValueExists(&client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords/DEVICE-2/physicalinterfaces", "name", "GigabitEthernet0/0"); err == nil || !strings.Contains(err.Error(), "failed to retrieve objects") {
+		t.Errorf("expected error for failed request, got: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/sjson"
+)
+
+// SnapshotTimeout is the maximum time waited for the export task of a snapshot to finish
+var SnapshotTimeout = 10 * time.Minute
+
+const configExportEndpoint = "/api/fmc_config/v1/domain/{DOMAIN_UUID}/action/configexportrequest"
+
+// Snapshot exports the configuration of the policy with the given ID and waits for the export task to finish.
+// The ID of the task is returned, it identifies the export package which can be downloaded from FMC and
+// imported again to restore the policy.
+func Snapshot(ctx context.Context, client *fmc.Client, id string, mods ...func(*fmc.Req)) (string, error) {
+	body, _ := sjson.Set("", "type", "PolicyExportRequest")
+	body, _ = sjson.Set(body, "entityIds", []string{id})
+	res, err := client.Post(configExportEndpoint, body, mods...)
+	if err != nil {
+		return "", fmt.Errorf("failed to export configuration (POST), got error: %w, %s", err, res.String())
+	}
+	taskId := res.Get("metadata.task.id").String()
+	if taskId == "" {
+		return "", fmt.Errorf("failed to export configuration (POST), no task returned: %s", res.String())
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, SnapshotTimeout)
+	defer cancel()
+	task, err := WaitForTask(waitCtx, client, taskId, mods...)
+	if err != nil {
+		return "", err
+	}
+	if TaskFailed(task.Get("status").String()) {
+		return "", fmt.Errorf("task %s failed to export configuration: %s", taskId, task.Get("message").String())
+	}
+	return taskId, nil
+}
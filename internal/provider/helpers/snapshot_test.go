@@ -0,0 +1,70 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestSnapshot(t *testing.T) {
+	tests := []struct {
+		export string
+		status string
+		id     string
+	}{
+		{`{"type": "PolicyExportRequest", "metadata": {"task": {"id": "TASK1"}}}`, `{"status": "SUCCESS"}`, "TASK1"},
+		{`{"type": "PolicyExportRequest", "metadata": {"task": {"id": "TASK1"}}}`, `{"status": "FAILED", "message": "Export failed"}`, ""},
+		{`{"type": "PolicyExportRequest"}`, `{"status": "SUCCESS"}`, ""},
+	}
+	for _, tt := range tests {
+		var entities string
+		client := testBulkClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/action/configexportrequest":
+				b, _ := io.ReadAll(r.Body)
+				entities = gjson.GetBytes(b, "entityIds").Raw
+				fmt.Fprint(w, tt.export)
+			case r.URL.Path == "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/job/taskstatuses/TASK1":
+				fmt.Fprint(w, tt.status)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		id, err := Snapshot(context.Background(), client, "POLICY1")
+		if entities != `["POLICY1"]` {
+			t.Errorf("expected the policy to be exported, got entities: %s", entities)
+		}
+		if tt.id == "" {
+			if err == nil {
+				t.Errorf("expected error for export %s with status %s", tt.export, tt.status)
+			}
+			continue
+		}
+		if err != nil || id != tt.id {
+			t.Errorf("expected snapshot %s, got: %s, %v", tt.id, id, err)
+		}
+	}
+}
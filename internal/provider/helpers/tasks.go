@@ -0,0 +1,88 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+// TaskPollInterval is the time between two requests for the status of an asynchronous FMC task or of an
+// object to reach an expected state
+var TaskPollInterval = 5 * time.Second
+
+const taskStatusEndpoint = "/api/fmc_config/v1/domain/{DOMAIN_UUID}/job/taskstatuses/"
+
+// TaskFinished returns true if the status of a task is final, either successful or failed
+func TaskFinished(status string) bool {
+	return TaskSucceeded(status) || TaskFailed(status)
+}
+
+// TaskSucceeded returns true if the status of a task reports a successful completion
+func TaskSucceeded(status string) bool {
+	status = strings.ToUpper(status)
+	return status == "SUCCESS" || status == "COMPLETED"
+}
+
+// TaskFailed returns true if the status of a task reports a failure
+func TaskFailed(status string) bool {
+	status = strings.ToUpper(status)
+	return status == "FAILED" || status == "FAILURE" || status == "ERROR"
+}
+
+// WaitForTask polls the status of an asynchronous task until it is finished and returns the last status
+// response, the context bounds the time waited for the task
+func WaitForTask(ctx context.Context, client *fmc.Client, taskId string, mods ...func(*fmc.Req)) (fmc.Res, error) {
+	for {
+		res, err := client.Get(taskStatusEndpoint+taskId, mods...)
+		if err != nil {
+			return res, fmt.Errorf("failed to retrieve status of task %s, got error: %w", taskId, err)
+		}
+		if TaskFinished(res.Get("status").String()) {
+			return res, nil
+		}
+		select {
+		case <-ctx.Done():
+			return res, fmt.Errorf("task %s did not finish, last status: %s", taskId, res.Get("status").String())
+		case <-time.After(TaskPollInterval):
+		}
+	}
+}
+
+// WaitForStatus polls an object until a field of its response has the expected value and returns the last
+// response, the context bounds the time waited for the value
+func WaitForStatus(ctx context.Context, client *fmc.Client, path, field, value string, mods ...func(*fmc.Req)) (fmc.Res, error) {
+	for {
+		res, err := client.Get(path, mods...)
+		if err != nil {
+			return res, fmt.Errorf("failed to retrieve status of '%s', got error: %w", path, err)
+		}
+		if res.Get(field).String() == value {
+			return res, nil
+		}
+		select {
+		case <-ctx.Done():
+			return res, fmt.Errorf("%s did not become '%s', last value: '%s'", field, value, res.Get(field).String())
+		case <-time.After(TaskPollInterval):
+		}
+	}
+}
@@ -18,8 +18,19 @@
 package helpers
 
 import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/netascode/go-fmc"
 	"github.com/tidwall/gjson"
 )
 
@@ -39,3 +50,277 @@ func GetStringList(result []gjson.Result) types.List {
 	}
 	return types.ListValueMust(types.StringType, v)
 }
+
+// GetMap returns the members of a JSON object as map value with the given element type, which is one of
+// types.StringType, types.Int64Type or types.BoolType
+func GetMap(result gjson.Result, elementType attr.Type) types.Map {
+	v := make(map[string]attr.Value)
+	result.ForEach(func(k, e gjson.Result) bool {
+		switch elementType {
+		case types.Int64Type:
+			v[k.String()] = types.Int64Value(e.Int())
+		case types.BoolType:
+			v[k.String()] = types.BoolValue(e.Bool())
+		default:
+			v[k.String()] = types.StringValue(e.String())
+		}
+		return true
+	})
+	return types.MapValueMust(elementType, v)
+}
+
+// MapToBody returns the elements of a map value as the members of a JSON object
+func MapToBody(m types.Map) map[string]interface{} {
+	values := make(map[string]interface{}, len(m.Elements()))
+	for k, e := range m.Elements() {
+		switch e := e.(type) {
+		case types.Int64:
+			values[k] = e.ValueInt64()
+		case types.Bool:
+			values[k] = e.ValueBool()
+		case types.String:
+			values[k] = e.ValueString()
+		}
+	}
+	return values
+}
+
+// ScalarOrList returns a single value as a scalar and several values as a list, for FMC fields accepting
+// either of them
+func ScalarOrList(values []string) interface{} {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return values
+}
+
+// StringListValue returns a list value of the given strings, e.g. for list defaults
+func StringListValue(values ...string) types.List {
+	v := make([]attr.Value, len(values))
+	for i := range values {
+		v[i] = types.StringValue(values[i])
+	}
+	return types.ListValueMust(types.StringType, v)
+}
+
+// GetStringListInOrder returns the values in the order of the prior list, values which are not part of
+// the prior list are appended in the order returned by the API
+func GetStringListInOrder(result []gjson.Result, prior types.List) types.List {
+	remaining := make([]string, len(result))
+	for r := range result {
+		remaining[r] = result[r].String()
+	}
+	v := make([]attr.Value, 0, len(result))
+	for _, p := range prior.Elements() {
+		s, ok := p.(types.String)
+		if !ok {
+			continue
+		}
+		for r := range remaining {
+			if remaining[r] == s.ValueString() {
+				v = append(v, s)
+				remaining = append(remaining[:r], remaining[r+1:]...)
+				break
+			}
+		}
+	}
+	for _, r := range remaining {
+		v = append(v, types.StringValue(r))
+	}
+	return types.ListValueMust(types.StringType, v)
+}
+
+// ScaleToBody converts a model value to its API representation by dividing it by scale
+func ScaleToBody[T int64 | float64](value T, scale float64) float64 {
+	return float64(value) / scale
+}
+
+// ScaleFromBody converts an API value to its model representation by multiplying it by scale,
+// rounding away floating point noise introduced by the conversion
+func ScaleFromBody[T int64 | float64](value, scale float64) T {
+	v := value * scale
+	var t T
+	if _, ok := any(t).(int64); ok {
+		return T(math.Round(v))
+	}
+	v, _ = strconv.ParseFloat(strconv.FormatFloat(v, 'g', 12, 64), 64)
+	return T(v)
+}
+
+// EnumToInteger returns the integer an enum value is mapped to in the API, or -1 if the value is unknown
+func EnumToInteger(value string, values []string, integers []int64) int64 {
+	for i, v := range values {
+		if v == value {
+			return integers[i]
+		}
+	}
+	return -1
+}
+
+// EnumFromInteger returns the enum value an integer from the API is mapped to, or the integer itself
+// as string if it is unknown
+func EnumFromInteger(value int64, values []string, integers []int64) string {
+	for i, v := range integers {
+		if v == value {
+			return values[i]
+		}
+	}
+	return strconv.FormatInt(value, 10)
+}
+
+// EnumFold returns the enum value matching a value from the API regardless of its case, or the value itself
+// if it is unknown, e.g. FMC reports the section of a rule as "Mandatory" while it is placed with "mandatory"
+func EnumFold(value string, values ...string) string {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return v
+		}
+	}
+	return value
+}
+
+// ObjectAs converts an object to a model struct, attributes which are only part of either the object or the
+// struct are skipped, e.g. attributes only exposed by data sources or write-only attributes not exposed by
+// them, the struct fields of skipped attributes remain null
+func ObjectAs(ctx context.Context, object types.Object, target interface{}) diag.Diagnostics {
+	return objectAs(object, reflect.ValueOf(target).Elem())
+}
+
+func objectAs(object types.Object, target reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attrs := object.Attributes()
+	for i := 0; i < target.NumField(); i++ {
+		name := target.Type().Field(i).Tag.Get("tfsdk")
+		value, ok := attrs[name]
+		if !ok {
+			continue
+		}
+		field := target.Field(i)
+		if field.Kind() != reflect.Slice {
+			if !reflect.TypeOf(value).AssignableTo(field.Type()) {
+				diags.AddError("Value Conversion Error", fmt.Sprintf("Attribute %s of type %T can not be assigned to %s", name, value, field.Type()))
+				return diags
+			}
+			field.Set(reflect.ValueOf(value))
+			continue
+		}
+		// Nested attributes are converted element by element, null and unknown values remain a nil slice
+		var elems []attr.Value
+		switch v := value.(type) {
+		case types.List:
+			elems = v.Elements()
+		case types.Set:
+			elems = v.Elements()
+		default:
+			diags.AddError("Value Conversion Error", fmt.Sprintf("Attribute %s of type %T is not a nested attribute", name, value))
+			return diags
+		}
+		if len(elems) == 0 {
+			continue
+		}
+		slice := reflect.MakeSlice(field.Type(), len(elems), len(elems))
+		for j, elem := range elems {
+			diags.Append(objectAs(elem.(types.Object), slice.Index(j))...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		field.Set(slice)
+	}
+	return diags
+}
+
+// ObjectFrom converts a model struct to an object with the given attribute types, struct fields without an
+// attribute type are skipped and attributes without a struct field are null unless given as extra attributes
+func ObjectFrom(ctx context.Context, attrTypes map[string]attr.Type, source interface{}, extra map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	return objectFrom(ctx, attrTypes, reflect.ValueOf(source), extra)
+}
+
+func objectFrom(ctx context.Context, attrTypes map[string]attr.Type, source reflect.Value, extra map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fields := make(map[string]reflect.Value)
+	for i := 0; i < source.NumField(); i++ {
+		fields[source.Type().Field(i).Tag.Get("tfsdk")] = source.Field(i)
+	}
+	attrs := make(map[string]attr.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		field, ok := fields[name]
+		if value, isExtra := extra[name]; isExtra {
+			attrs[name] = value
+			continue
+		} else if !ok || (field.Kind() == reflect.Slice && field.IsNil()) {
+			value, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+			if err != nil {
+				diags.AddError("Value Conversion Error", fmt.Sprintf("Failed to create null value of attribute %s: %s", name, err))
+				return types.ObjectNull(attrTypes), diags
+			}
+			attrs[name] = value
+			continue
+		}
+		if field.Kind() != reflect.Slice {
+			attrs[name] = field.Interface().(attr.Value)
+			continue
+		}
+		// Nested attributes are converted element by element
+		var elemType types.ObjectType
+		switch t := attrType.(type) {
+		case types.ListType:
+			elemType, ok = t.ElemType.(types.ObjectType)
+		case types.SetType:
+			elemType, ok = t.ElemType.(types.ObjectType)
+		default:
+			ok = false
+		}
+		if !ok {
+			diags.AddError("Value Conversion Error", fmt.Sprintf("Attribute %s of type %s is not a nested attribute", name, attrType))
+			return types.ObjectNull(attrTypes), diags
+		}
+		elems := make([]attr.Value, field.Len())
+		for j := range elems {
+			elem, d := objectFrom(ctx, elemType.AttrTypes, field.Index(j), nil)
+			diags.Append(d...)
+			if diags.HasError() {
+				return types.ObjectNull(attrTypes), diags
+			}
+			elems[j] = elem
+		}
+		var value attr.Value
+		var d diag.Diagnostics
+		if _, isSet := attrType.(types.SetType); isSet {
+			value, d = types.SetValue(elemType, elems)
+		} else {
+			value, d = types.ListValue(elemType, elems)
+		}
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.ObjectNull(attrTypes), diags
+		}
+		attrs[name] = value
+	}
+	o, d := types.ObjectValue(attrTypes, attrs)
+	diags.Append(d...)
+	return o, diags
+}
+
+// LastModified returns the timestamp of the last modification from the metadata of an object in RFC 3339 format,
+// or null if the FMC does not provide it
+func LastModified(res gjson.Result) types.String {
+	if value := res.Get("metadata.timestamp"); value.Exists() && value.Int() > 0 {
+		return types.StringValue(time.UnixMilli(value.Int()).UTC().Format(time.RFC3339))
+	}
+	return types.StringNull()
+}
+
+// IgnoreWarnings modifies a request to proceed despite warnings, which the FMC would otherwise reject the request with
+func IgnoreWarnings(req *fmc.Req) {
+	query := req.HttpReq.URL.Query()
+	query.Set("ignoreWarnings", "true")
+	req.HttpReq.URL.RawQuery = query.Encode()
+}
+
+// Expanded modifies a request to return the full details of nested objects
+func Expanded(req *fmc.Req) {
+	query := req.HttpReq.URL.Query()
+	query.Set("expanded", "true")
+	req.HttpReq.URL.RawQuery = query.Encode()
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+func TestScale(t *testing.T) {
+	if v := ScaleToBody(int64(50), 100); v != 0.5 {
+		t.Errorf("expected 50 percent to be written as 0.5, got %v", v)
+	}
+	if v := ScaleFromBody[int64](0.5, 100); v != 50 {
+		t.Errorf("expected 0.5 to be read as 50 percent, got %v", v)
+	}
+	if v := ScaleToBody(50.0, 100); v != 0.5 {
+		t.Errorf("expected 50.0 percent to be written as 0.5, got %v", v)
+	}
+	if v := ScaleFromBody[float64](0.5, 100); v != 50 {
+		t.Errorf("expected 0.5 to be read as 50.0 percent, got %v", v)
+	}
+	if v := ScaleFromBody[float64](ScaleToBody(29.0, 100), 100); v != 29 {
+		t.Errorf("expected 29.0 percent to round-trip, got %v", v)
+	}
+}
+
+func TestEnumInteger(t *testing.T) {
+	values := []string{"ECHO_REPLY", "ECHO"}
+	integers := []int64{0, 8}
+	if v := EnumToInteger("ECHO", values, integers); v != 8 {
+		t.Errorf("expected ECHO to be mapped to 8, got %v", v)
+	}
+	if v := EnumFromInteger(8, values, integers); v != "ECHO" {
+		t.Errorf("expected 8 to be mapped to ECHO, got %v", v)
+	}
+	if v := EnumFromInteger(42, values, integers); v != "42" {
+		t.Errorf("expected unknown integer to be returned as is, got %v", v)
+	}
+}
+
+func TestEnumFold(t *testing.T) {
+	if v := EnumFold("Mandatory", "mandatory", "default"); v != "mandatory" {
+		t.Errorf("expected Mandatory to be mapped to mandatory, got %v", v)
+	}
+	if v := EnumFold("other", "mandatory", "default"); v != "other" {
+		t.Errorf("expected unknown value to be returned as is, got %v", v)
+	}
+}
+
+func TestScalarOrList(t *testing.T) {
+	tests := []struct {
+		values   []string
+		expected string
+	}{
+		{[]string{"a"}, `"a"`},
+		{[]string{"a", "b"}, `["a","b"]`},
+		{[]string{}, `[]`},
+	}
+	for _, tt := range tests {
+		body, _ := sjson.Set("", "value", ScalarOrList(tt.values))
+		if gjson.Get(body, "value").Raw != tt.expected {
+			t.Errorf("expected %s for %v, got: %s", tt.expected, tt.values, gjson.Get(body, "value").Raw)
+		}
+		if values := GetStringList(gjson.Get(body, "value").Array()); len(values.Elements()) != len(tt.values) {
+			t.Errorf("expected %d values read back from %s, got: %v", len(tt.values), body, values)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		elementType attr.Type
+		body        string
+	}{
+		{types.StringType, `{"environment":"production","a.b":"1"}`},
+		{types.Int64Type, `{"priority":10,"weight":-1}`},
+		{types.BoolType, `{"enabled":true,"logged":false}`},
+		{types.StringType, `{}`},
+	}
+	for _, tt := range tests {
+		value := GetMap(gjson.Parse(tt.body), tt.elementType)
+		if !value.ElementType(context.Background()).Equal(tt.elementType) || len(value.Elements()) != len(gjson.Parse(tt.body).Map()) {
+			t.Errorf("unexpected map read from %s: %v", tt.body, value)
+		}
+		body, _ := sjson.Set("", "value", MapToBody(value))
+		if !GetMap(gjson.Get(body, "value"), tt.elementType).Equal(value) {
+			t.Errorf("expected %s to be written back, got: %s", tt.body, gjson.Get(body, "value").Raw)
+		}
+	}
+}
+
+func TestGetStringListInOrder(t *testing.T) {
+	prior := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("c"), types.StringValue("a"), types.StringValue("x")})
+	list := GetStringListInOrder(gjson.Parse(`["a", "b", "c"]`).Array(), prior)
+
+	var values []string
+	list.ElementsAs(context.Background(), &values, false)
+	if strings.Join(values, ",") != "c,a,b" {
+		t.Errorf("expected values in prior order with removed values dropped and new values appended, got %v", values)
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Weekdays are the day of week values used by FMC schedules
+var Weekdays = []string{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}
+
+// TimeOfDayValidator validates that a string is a time of day in 24-hour HH:MM format
+func TimeOfDayValidator() validator.String {
+	return stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time of day in HH:MM format")
+}
+
+// DateTimeValidator validates that a string is a date and time of day in YYYY-MM-DDTHH:MM format
+func DateTimeValidator() validator.String {
+	return dateTimeValidator{}
+}
+
+type dateTimeValidator struct{}
+
+func (v dateTimeValidator) Description(ctx context.Context) string {
+	return "value must be a date and time in YYYY-MM-DDTHH:MM format"
+}
+
+func (v dateTimeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dateTimeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse("2006-01-02T15:04", req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s must be a date and time in YYYY-MM-DDTHH:MM format, got: %s", req.Path, req.ConfigValue.ValueString()))
+	}
+}
+
+type afterAttributeValidator struct {
+	expression path.Expression
+}
+
+// AfterAttributeValidator validates that a time of day or a date and time is later than the value of another
+// attribute in the same format, the validation is skipped if that attribute is null or unknown
+func AfterAttributeValidator(expression path.Expression) validator.String {
+	return afterAttributeValidator{expression: expression}
+}
+
+func (v afterAttributeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be later than the value of %s", v.expression)
+}
+
+func (v afterAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v afterAttributeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	paths, diags := req.Config.PathMatches(ctx, req.PathExpression.Merge(v.expression))
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || len(paths) != 1 {
+		return
+	}
+	var start types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, paths[0], &start)...)
+	if start.IsNull() || start.IsUnknown() {
+		return
+	}
+	// Both formats have fixed-width fields ordered from the most significant one, so the strings compare
+	// like the times they represent
+	if req.ConfigValue.ValueString() <= start.ValueString() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s must be later than %s (%s), got: %s", req.Path, paths[0], start.ValueString(), req.ConfigValue.ValueString()))
+	}
+}
+
+// WeekdayValidator validates that a string is a day of week
+func WeekdayValidator() validator.String {
+	return stringvalidator.OneOf(Weekdays...)
+}
+
+// ReservedNames are the names of objects predefined by FMC, which can not be used for other objects
+var ReservedNames = []string{"any", "any-ipv4", "any-ipv6"}
+
+type reservedNamesValidator struct {
+	names []string
+}
+
+// ReservedNamesValidator validates that a string is none of the reserved names or the given additional names,
+// names are compared case-insensitively
+func ReservedNamesValidator(additional ...string) validator.String {
+	return reservedNamesValidator{names: append(append([]string{}, ReservedNames...), additional...)}
+}
+
+func (v reservedNamesValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must not be one of the reserved names: %s", strings.Join(v.names, ", "))
+}
+
+func (v reservedNamesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v reservedNamesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, name := range v.names {
+		if strings.EqualFold(req.ConfigValue.ValueString(), name) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Reserved Name", fmt.Sprintf("Attribute %s must not be a name reserved by FMC, got: %q, reserved names are: %s", req.Path, req.ConfigValue.ValueString(), strings.Join(v.names, ", ")))
+			return
+		}
+	}
+}
+
+type withinCIDRValidator struct {
+	cidr       string
+	expression path.Expression
+}
+
+// WithinCIDRValidator validates that a string is an IP address or prefix within the given prefix
+func WithinCIDRValidator(cidr string) validator.String {
+	return withinCIDRValidator{cidr: cidr}
+}
+
+// WithinCIDRAttributeValidator validates that a string is an IP address or prefix within the prefix
+// configured in another attribute, the validation is skipped if that attribute is null or unknown
+func WithinCIDRAttributeValidator(expression path.Expression) validator.String {
+	return withinCIDRValidator{expression: expression}
+}
+
+func (v withinCIDRValidator) Description(ctx context.Context) string {
+	if v.cidr != "" {
+		return fmt.Sprintf("value must be within %s", v.cidr)
+	}
+	return fmt.Sprintf("value must be within the prefix of %s", v.expression)
+}
+
+func (v withinCIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v withinCIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	cidr := v.cidr
+	if cidr == "" {
+		paths, diags := req.Config.PathMatches(ctx, req.PathExpression.Merge(v.expression))
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || len(paths) != 1 {
+			return
+		}
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, paths[0], &value)...)
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+		cidr = value.ValueString()
+	}
+	supernet, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s must be within %q, which is not a prefix in CIDR notation", req.Path, cidr))
+		return
+	}
+
+	prefix, err := parsePrefixOrAddr(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s must be an IP address or a prefix in CIDR notation, got: %s", req.Path, req.ConfigValue.ValueString()))
+		return
+	}
+	if prefix.Bits() < supernet.Bits() || !supernet.Masked().Contains(prefix.Addr()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s must be within %s, got: %s", req.Path, supernet, req.ConfigValue.ValueString()))
+	}
+}
+
+type warnThresholdValidator struct {
+	max     int64
+	percent int64
+}
+
+// WarnThresholdValidator warns if an integer exceeds the given percentage of its maximum, which helps to
+// notice values approaching the limit before it is hit, the value itself is still valid
+func WarnThresholdValidator(max, percent int64) validator.Int64 {
+	return warnThresholdValidator{max: max, percent: percent}
+}
+
+func (v warnThresholdValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("a warning is shown for values above %d%% of %d", v.percent, v.max)
+}
+
+func (v warnThresholdValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v warnThresholdValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if value := req.ConfigValue.ValueInt64(); value*100 > v.max*v.percent {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Value Close To Limit", fmt.Sprintf("Attribute %s is %d, which is above %d%% of the maximum of %d", req.Path, value, v.percent, v.max))
+	}
+}
+
+type impliesValidator struct {
+	attributes map[string][]string
+}
+
+// ImpliesValidator validates that the top-level attributes implied by a configured attribute are configured
+// as well, unlike a mutual requirement the implied attributes can be configured on their own
+func ImpliesValidator(attributes map[string][]string) resource.ConfigValidator {
+	return impliesValidator{attributes}
+}
+
+func (v impliesValidator) Description(ctx context.Context) string {
+	return "attributes must be configured if an attribute implying them is configured"
+}
+
+func (v impliesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v impliesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	names := make([]string, 0, len(v.attributes))
+	for name := range v.attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if value == nil || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		for _, implied := range v.attributes[name] {
+			var impliedValue attr.Value
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(implied), &impliedValue)...)
+			if impliedValue != nil && !impliedValue.IsNull() {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("Attribute %q must be configured if %q is configured", implied, name),
+			)
+		}
+	}
+}
+
+// IsValidCIDR returns true if a string is an IP address or a prefix in CIDR notation
+func IsValidCIDR(s string) bool {
+	_, err := parsePrefixOrAddr(s)
+	return err == nil
+}
+
+// IPInRange returns true if an IP address is within a prefix in CIDR notation or an address range in
+// "<first>-<last>" format as used by FMC range objects, malformed input is reported as error
+func IPInRange(ip, r string) (bool, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false, fmt.Errorf("invalid IP address %q", ip)
+	}
+	if first, last, ok := strings.Cut(r, "-"); ok {
+		start, err := netip.ParseAddr(strings.TrimSpace(first))
+		if err != nil {
+			return false, fmt.Errorf("invalid address range %q, expected format <first>-<last>", r)
+		}
+		end, err := netip.ParseAddr(strings.TrimSpace(last))
+		if err != nil || start.Is4() != end.Is4() || end.Less(start) {
+			return false, fmt.Errorf("invalid address range %q, expected format <first>-<last>", r)
+		}
+		return addr.Is4() == start.Is4() && !addr.Less(start) && !end.Less(addr), nil
+	}
+	prefix, err := parsePrefixOrAddr(r)
+	if err != nil {
+		return false, fmt.Errorf("invalid prefix %q, expected an IP address or a prefix in CIDR notation", r)
+	}
+	return prefix.Masked().Contains(addr), nil
+}
+
+// parsePrefixOrAddr parses a prefix in CIDR notation, or an IP address as a host prefix
+func parsePrefixOrAddr(s string) (netip.Prefix, error) {
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return prefix, nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+type discriminatorValidator struct {
+	discriminator string
+	attributes    map[string][]string
+	required      []string
+}
+
+// DiscriminatorValidator validates that top-level attributes, which are only valid for some values of the
+// discriminator attribute (e.g. "type"), are not configured for other values, the required attributes must
+// be configured for their values
+func DiscriminatorValidator(discriminator string, attributes map[string][]string, required ...string) resource.ConfigValidator {
+	return discriminatorValidator{discriminator, attributes, required}
+}
+
+func (v discriminatorValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("attributes must be valid for the configured %s", v.discriminator)
+}
+
+func (v discriminatorValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("attributes must be valid for the configured `%s`", v.discriminator)
+}
+
+func (v discriminatorValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var discriminator types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.discriminator), &discriminator)...)
+	if resp.Diagnostics.HasError() || discriminator.IsNull() || discriminator.IsUnknown() {
+		return
+	}
+
+	names := make([]string, 0, len(v.attributes))
+	for name := range v.attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if Contains(v.attributes[name], discriminator.ValueString()) {
+			if Contains(v.required, name) && (value == nil || value.IsNull()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Missing Required Attribute",
+					fmt.Sprintf("Attribute %q must be configured if %q is %q", name, v.discriminator, discriminator.ValueString()),
+				)
+			}
+			continue
+		}
+		if value == nil || value.IsNull() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q can only be configured if %q is one of: %s, got: %q", name, v.discriminator, strings.Join(v.attributes[name], ", "), discriminator.ValueString()),
+		)
+	}
+}
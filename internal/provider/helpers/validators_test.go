@@ -0,0 +1,265 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func validateString(v validator.String, value string) bool {
+	req := validator.StringRequest{Path: path.Root("test"), ConfigValue: types.StringValue(value)}
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), req, resp)
+	return !resp.Diagnostics.HasError()
+}
+
+func TestTimeOfDayValidator(t *testing.T) {
+	for _, value := range []string{"00:00", "09:30", "23:59"} {
+		if !validateString(TimeOfDayValidator(), value) {
+			t.Errorf("expected '%s' to be valid", value)
+		}
+	}
+	for _, value := range []string{"24:00", "9:30", "12:60", "12:00:00", "noon", ""} {
+		if validateString(TimeOfDayValidator(), value) {
+			t.Errorf("expected '%s' to be invalid", value)
+		}
+	}
+}
+
+func TestDateTimeValidator(t *testing.T) {
+	for _, value := range []string{"2024-01-01T00:00", "2024-02-29T23:59"} {
+		if !validateString(DateTimeValidator(), value) {
+			t.Errorf("expected '%s' to be valid", value)
+		}
+	}
+	for _, value := range []string{"2023-02-29T08:00", "2024-01-01 08:00", "2024-01-01T24:00", "2024-01-01", "08:00", ""} {
+		if validateString(DateTimeValidator(), value) {
+			t.Errorf("expected '%s' to be invalid", value)
+		}
+	}
+}
+
+func TestReservedNamesValidator(t *testing.T) {
+	for _, value := range []string{"NET1", "any-network", "anything"} {
+		if !validateString(ReservedNamesValidator("internal"), value) {
+			t.Errorf("expected '%s' to be valid", value)
+		}
+	}
+	for _, value := range []string{"any", "ANY", "any-ipv4", "Any-IPv6", "internal"} {
+		if validateString(ReservedNamesValidator("internal"), value) {
+			t.Errorf("expected '%s' to be invalid", value)
+		}
+	}
+}
+
+func TestWeekdayValidator(t *testing.T) {
+	for _, value := range []string{"MON", "SUN"} {
+		if !validateString(WeekdayValidator(), value) {
+			t.Errorf("expected '%s' to be valid", value)
+		}
+	}
+	for _, value := range []string{"mon", "MONDAY", "SUNDAY", ""} {
+		if validateString(WeekdayValidator(), value) {
+			t.Errorf("expected '%s' to be invalid", value)
+		}
+	}
+}
+
+func TestWithinCIDRValidator(t *testing.T) {
+	for _, value := range []string{"10.0.0.0/8", "10.1.0.0/16", "10.255.255.255", "10.1.1.1/32"} {
+		if !validateString(WithinCIDRValidator("10.0.0.0/8"), value) {
+			t.Errorf("expected '%s' to be valid", value)
+		}
+	}
+	for _, value := range []string{"11.0.0.0/16", "10.0.0.0/7", "0.0.0.0/0", "192.168.1.1", "2001:db8::/64", "10.0.0.0/33", "host"} {
+		if validateString(WithinCIDRValidator("10.0.0.0/8"), value) {
+			t.Errorf("expected '%s' to be invalid", value)
+		}
+	}
+}
+
+func TestWithinCIDRAttributeValidator(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"supernet": schema.StringAttribute{Optional: true},
+		"prefix":   schema.StringAttribute{Optional: true},
+	}}
+	v := WithinCIDRAttributeValidator(path.MatchRelative().AtParent().AtName("supernet"))
+	tests := []struct {
+		supernet tftypes.Value
+		prefix   string
+		valid    bool
+	}{
+		{tftypes.NewValue(tftypes.String, "192.168.0.0/16"), "192.168.10.0/24", true},
+		{tftypes.NewValue(tftypes.String, "192.168.0.0/16"), "172.16.10.0/24", false},
+		{tftypes.NewValue(tftypes.String, "2001:db8::/32"), "2001:db8:1::/48", true},
+		{tftypes.NewValue(tftypes.String, "supernet"), "192.168.10.0/24", false},
+		{tftypes.NewValue(tftypes.String, nil), "172.16.10.0/24", true},
+		{tftypes.NewValue(tftypes.String, tftypes.UnknownValue), "172.16.10.0/24", true},
+	}
+	for _, tt := range tests {
+		config := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"supernet": tt.supernet,
+			"prefix":   tftypes.NewValue(tftypes.String, tt.prefix),
+		})}
+		req := validator.StringRequest{Path: path.Root("prefix"), PathExpression: path.MatchRoot("prefix"), ConfigValue: types.StringValue(tt.prefix), Config: config}
+		resp := &validator.StringResponse{}
+		v.ValidateString(ctx, req, resp)
+		if resp.Diagnostics.HasError() == tt.valid {
+			t.Errorf("expected '%s' within '%s' to be valid: %v, got: %v", tt.prefix, tt.supernet, tt.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestAfterAttributeValidator(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"start": schema.StringAttribute{Optional: true},
+		"end":   schema.StringAttribute{Optional: true},
+	}}
+	v := AfterAttributeValidator(path.MatchRelative().AtParent().AtName("start"))
+	tests := []struct {
+		start tftypes.Value
+		end   string
+		valid bool
+	}{
+		{tftypes.NewValue(tftypes.String, "08:00"), "17:00", true},
+		{tftypes.NewValue(tftypes.String, "08:00"), "08:00", false},
+		{tftypes.NewValue(tftypes.String, "17:00"), "08:00", false},
+		{tftypes.NewValue(tftypes.String, "2024-01-01T08:00"), "2024-12-31T07:00", true},
+		{tftypes.NewValue(tftypes.String, "2024-12-31T08:00"), "2024-01-01T17:00", false},
+		{tftypes.NewValue(tftypes.String, nil), "08:00", true},
+		{tftypes.NewValue(tftypes.String, tftypes.UnknownValue), "08:00", true},
+	}
+	for _, tt := range tests {
+		config := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"start": tt.start,
+			"end":   tftypes.NewValue(tftypes.String, tt.end),
+		})}
+		req := validator.StringRequest{Path: path.Root("end"), PathExpression: path.MatchRoot("end"), ConfigValue: types.StringValue(tt.end), Config: config}
+		resp := &validator.StringResponse{}
+		v.ValidateString(ctx, req, resp)
+		if resp.Diagnostics.HasError() == tt.valid {
+			t.Errorf("expected '%s' after '%s' to be valid: %v, got: %v", tt.end, tt.start, tt.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestImpliesValidator(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"username": schema.StringAttribute{Optional: true},
+		"password": schema.StringAttribute{Optional: true},
+	}}
+	v := ImpliesValidator(map[string][]string{"username": {"password"}})
+	tests := []struct {
+		username tftypes.Value
+		password tftypes.Value
+		valid    bool
+	}{
+		{tftypes.NewValue(tftypes.String, "admin"), tftypes.NewValue(tftypes.String, nil), false},
+		{tftypes.NewValue(tftypes.String, "admin"), tftypes.NewValue(tftypes.String, "secret"), true},
+		{tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, "secret"), true},
+		{tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil), true},
+		{tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, nil), true},
+		{tftypes.NewValue(tftypes.String, "admin"), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), true},
+	}
+	for _, tt := range tests {
+		config := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"username": tt.username,
+			"password": tt.password,
+		})}
+		resp := &resource.ValidateConfigResponse{}
+		v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+		if resp.Diagnostics.HasError() == tt.valid {
+			t.Errorf("expected username %s with password %s to be valid: %v, got: %v", tt.username, tt.password, tt.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestWarnThresholdValidator(t *testing.T) {
+	tests := []struct {
+		value types.Int64
+		warn  bool
+	}{
+		{types.Int64Value(50), false},
+		{types.Int64Value(90), false},
+		{types.Int64Value(91), true},
+		{types.Int64Value(100), true},
+		{types.Int64Null(), false},
+		{types.Int64Unknown(), false},
+	}
+	for _, tt := range tests {
+		req := validator.Int64Request{Path: path.Root("test"), ConfigValue: tt.value}
+		resp := &validator.Int64Response{}
+		WarnThresholdValidator(100, 90).ValidateInt64(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected no error, got: %v", tt.value, resp.Diagnostics)
+		}
+		if (resp.Diagnostics.WarningsCount() == 1) != tt.warn {
+			t.Errorf("%s: expected warning %v, got: %v", tt.value, tt.warn, resp.Diagnostics)
+		}
+	}
+}
+
+func TestIsValidCIDR(t *testing.T) {
+	for _, value := range []string{"10.1.1.0/24", "10.1.1.1", "2001:db8::/32", "::1"} {
+		if !IsValidCIDR(value) {
+			t.Errorf("expected '%s' to be valid", value)
+		}
+	}
+	for _, value := range []string{"10.1.1.0/33", "10.1.1", "10.1.1.0-10.1.1.9", "host", ""} {
+		if IsValidCIDR(value) {
+			t.Errorf("expected '%s' to be invalid", value)
+		}
+	}
+}
+
+func TestIPInRange(t *testing.T) {
+	tests := []struct {
+		ip     string
+		r      string
+		within bool
+		err    bool
+	}{
+		{"10.1.1.5", "10.1.1.0/24", true, false},
+		{"10.1.2.5", "10.1.1.0/24", false, false},
+		{"10.1.1.5", "10.1.1.1-10.1.1.9", true, false},
+		{"10.1.1.9", "10.1.1.1-10.1.1.9", true, false},
+		{"10.1.1.10", "10.1.1.1-10.1.1.9", false, false},
+		{"2001:db8::1", "10.1.1.0/24", false, false},
+		{"10.1.1.5", "10.1.1.9-10.1.1.1", false, true},
+		{"10.1.1.5", "10.1.1.0/33", false, true},
+		{"10.1.1", "10.1.1.0/24", false, true},
+	}
+	for _, tt := range tests {
+		within, err := IPInRange(tt.ip, tt.r)
+		if (err != nil) != tt.err || within != tt.within {
+			t.Errorf("expected '%s' in '%s' to be %v with error %v, got: %v, %v", tt.ip, tt.r, tt.within, tt.err, within, err)
+		}
+	}
+}
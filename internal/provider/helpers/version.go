@@ -0,0 +1,87 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/netascode/go-fmc"
+)
+
+const serverVersionEndpoint = "/api/fmc_platform/v1/info/serverversion"
+
+// versionRegex matches the numeric components of a version, a suffix like the build number is ignored
+var versionRegex = regexp.MustCompile(`^\d+(\.\d+)*`)
+
+// ServerVersion returns the version of the FMC, e.g. "7.2.0 (build 82)"
+func ServerVersion(client *fmc.Client, mods ...func(*fmc.Req)) (string, error) {
+	res, err := client.Get(serverVersionEndpoint, mods...)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve FMC version, got error: %w", err)
+	}
+	version := res.Get("items.0.serverVersion").String()
+	if version == "" {
+		return "", fmt.Errorf("failed to retrieve FMC version, no version returned: %s", res.String())
+	}
+	return version, nil
+}
+
+// VersionAtLeast returns true if the version is equal to or newer than the minimum version, versions are
+// compared by their numeric components and missing components count as 0, e.g. "7.4" equals "7.4.0"
+func VersionAtLeast(version, minimum string) (bool, error) {
+	v, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	m, err := parseVersion(minimum)
+	if err != nil {
+		return false, err
+	}
+	for i := 0; i < len(v) || i < len(m); i++ {
+		var a, b int
+		if i < len(v) {
+			a = v[i]
+		}
+		if i < len(m) {
+			b = m[i]
+		}
+		if a != b {
+			return a > b, nil
+		}
+	}
+	return true, nil
+}
+
+func parseVersion(version string) ([]int, error) {
+	match := versionRegex.FindString(strings.TrimSpace(version))
+	if match == "" {
+		return nil, fmt.Errorf("invalid version '%s'", version)
+	}
+	var components []int
+	for _, s := range strings.Split(match, ".") {
+		c, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version '%s', got error: %w", version, err)
+		}
+		components = append(components, c)
+	}
+	return components, nil
+}
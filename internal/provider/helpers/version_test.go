@@ -0,0 +1,43 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import "testing"
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version  string
+		minimum  string
+		expected bool
+	}{
+		{"7.4.1", "7.4", true},
+		{"7.4", "7.4.0", true},
+		{"7.2.0 (build 82)", "7.4", false},
+		{"7.10.0", "7.4.1", true},
+		{"6.7.0.3", "7.0", false},
+		{"8.0", "7.4.2", true},
+	}
+	for _, tt := range tests {
+		if got, err := VersionAtLeast(tt.version, tt.minimum); err != nil || got != tt.expected {
+			t.Errorf("version %s minimum %s: expected %v, got %v, %v", tt.version, tt.minimum, tt.expected, got, err)
+		}
+	}
+	if _, err := VersionAtLeast("unknown", "7.4"); err == nil {
+		t.Error("expected error for invalid version")
+	}
+}
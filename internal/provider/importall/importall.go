@@ -0,0 +1,103 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+// Package importall lists the objects of an FMC and prints the terraform import commands of all resources,
+// e.g. to bring an existing FMC configuration under management by Terraform after a disaster recovery.
+package importall
+
+//template:begin importall
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/netascode/go-fmc"
+)
+
+// Resource is a resource type whose objects are listed for import
+type Resource struct {
+	// Type is the Terraform resource type
+	Type string
+	// Endpoint is the REST endpoint listing the objects
+	Endpoint string
+}
+
+// Resources are all resource types whose objects can be imported by their ID
+var Resources = []Resource{
+	{Type: "fmc_access_control_policy", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies"},
+	{Type: "fmc_certificate_enrollment", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/certenrollments"},
+	{Type: "fmc_health_policy", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/health/policies"},
+	{Type: "fmc_host", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/hosts"},
+	{Type: "fmc_icmpv4_object", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/icmpv4objects"},
+	{Type: "fmc_ikev2_policy", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/ikev2policies"},
+	{Type: "fmc_network", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks"},
+	{Type: "fmc_network_group", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networkgroups"},
+	{Type: "fmc_prefilter_policy", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/prefilterpolicies"},
+	{Type: "fmc_scheduled_task", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/job/scheduledtasks"},
+	{Type: "fmc_time_range", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/timeranges"},
+	{Type: "fmc_variable_set", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/variablesets"},
+	{Type: "fmc_vpn_s2s", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/ftds2svpns"},
+}
+
+var invalidNameRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Commands lists the objects of the resources and writes a terraform import command for each object, the
+// resource names are derived from the object names and made unique per resource type
+func Commands(client *fmc.Client, resources []Resource, w io.Writer, mods ...func(*fmc.Req)) error {
+	for _, resource := range resources {
+		names := make(map[string]bool)
+		offset := 0
+		limit := 1000
+		for {
+			res, err := client.Get(fmt.Sprintf("%s?limit=%d&offset=%d", resource.Endpoint, limit, offset), mods...)
+			if err != nil {
+				return fmt.Errorf("failed to list objects of %s, got error: %w", resource.Type, err)
+			}
+			for _, item := range res.Get("items").Array() {
+				id := item.Get("id").String()
+				fmt.Fprintf(w, "terraform import %s.%s %q\n", resource.Type, resourceName(names, item.Get("name").String(), id), id)
+			}
+			if !res.Get("paging.next.0").Exists() {
+				break
+			}
+			offset += limit
+		}
+	}
+	return nil
+}
+
+// resourceName returns a valid and unique Terraform resource name for an object
+func resourceName(names map[string]bool, name, id string) string {
+	if name == "" {
+		name = id
+	}
+	name = strings.Trim(invalidNameRegex.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[unique] = true
+	return unique
+}
+
+//template:end importall
@@ -0,0 +1,87 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package importall
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/netascode/go-fmc"
+)
+
+func TestCommands(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/networks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+		  "items": [
+		    {"id": "0050568a-3d4f-0ed3-0000-004294967346", "name": "Net-1", "type": "Network"},
+		    {"id": "0050568a-3d4f-0ed3-0000-004294967400", "name": "net 1", "type": "Network"}
+		  ],
+		  "paging": {"offset": 0, "limit": 1000, "count": 2, "pages": 1}
+		}`)
+	}))
+	t.Cleanup(server.Close)
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+	client.DomainUUID = "e276abec-e0f2-11e3-8169-6d9ed49b625f"
+
+	var out strings.Builder
+	resources := []Resource{{Type: "fmc_network", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks"}}
+	if err := Commands(&client, resources, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `terraform import fmc_network.net_1 "0050568a-3d4f-0ed3-0000-004294967346"` + "\n" +
+		`terraform import fmc_network.net_1_2 "0050568a-3d4f-0ed3-0000-004294967400"` + "\n"
+	if out.String() != expected {
+		t.Errorf("expected import commands:\n%s\ngot:\n%s", expected, out.String())
+	}
+
+	resources = append(resources, Resource{Type: "fmc_host", Endpoint: "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/hosts"})
+	if err := Commands(&client, resources, &strings.Builder{}); err == nil || !strings.Contains(err.Error(), "fmc_host") {
+		t.Errorf("expected error listing fmc_host, got: %v", err)
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	names := make(map[string]bool)
+	for _, tt := range []struct {
+		name     string
+		expected string
+	}{
+		{"Web Servers", "web_servers"},
+		{"web-servers", "web_servers_2"},
+		{"10.0.0.0/8", "_10_0_0_0_8"},
+		{"", "_0050568a_3d4f"},
+	} {
+		if got := resourceName(names, tt.name, "0050568a-3d4f"); got != tt.expected {
+			t.Errorf("expected resource name %s for '%s', got %s", tt.expected, tt.name, got)
+		}
+	}
+}
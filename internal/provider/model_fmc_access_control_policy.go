@@ -22,8 +22,11 @@ package provider
 //template:begin imports
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
@@ -42,6 +45,7 @@ type AccessControlPolicy struct {
 	DefaultActionLogEnd          types.Bool   `tfsdk:"default_action_log_end"`
 	DefaultActionSendEventsToFmc types.Bool   `tfsdk:"default_action_send_events_to_fmc"`
 	DefaultActionSendSyslog      types.Bool   `tfsdk:"default_action_send_syslog"`
+	BasePolicyId                 types.String `tfsdk:"base_policy_id"`
 }
 
 //template:end types
@@ -51,6 +55,20 @@ func (data AccessControlPolicy) getPath() string {
 	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies"
 }
 
+// readEndpoints retrieves the attributes provided by additional endpoints and the referenced objects and
+// merges them into the object
+func (data AccessControlPolicy) readEndpoints(ctx context.Context, client *fmc.Client, res gjson.Result, reqMods ...func(*fmc.Req)) (gjson.Result, error) {
+	body := res.Raw
+	if r, err := client.Get(data.getPath()+"/"+data.Id.ValueString()+"/inheritancesettings", reqMods...); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("%s: Failed to retrieve attributes from %s, got error: %s", data.Id.ValueString(), "/inheritancesettings", err))
+	} else {
+		if value := r.Get("items.0.baseHierarchyObject.id"); value.Exists() {
+			body, _ = sjson.SetRaw(body, "items.0.baseHierarchyObject.id", value.Raw)
+		}
+	}
+	return gjson.Parse(body), nil
+}
+
 //template:end getPath
 
 //template:begin toBody
@@ -130,6 +148,11 @@ func (data *AccessControlPolicy) fromBody(ctx context.Context, res gjson.Result)
 	} else {
 		data.DefaultActionSendSyslog = types.BoolValue(false)
 	}
+	if value := res.Get("items.0.baseHierarchyObject.id"); value.Exists() {
+		data.BasePolicyId = types.StringValue(value.String())
+	} else {
+		data.BasePolicyId = types.StringNull()
+	}
 }
 
 //template:end fromBody
@@ -176,6 +199,11 @@ func (data *AccessControlPolicy) updateFromBody(ctx context.Context, res gjson.R
 	} else if data.DefaultActionSendSyslog.ValueBool() != false {
 		data.DefaultActionSendSyslog = types.BoolNull()
 	}
+	if value := res.Get("items.0.baseHierarchyObject.id"); value.Exists() {
+		data.BasePolicyId = types.StringValue(value.String())
+	} else {
+		data.BasePolicyId = types.StringNull()
+	}
 }
 
 //template:end updateFromBody
@@ -206,6 +234,9 @@ func (data *AccessControlPolicy) isNull(ctx context.Context, res gjson.Result) b
 	if !data.DefaultActionSendSyslog.IsNull() {
 		return false
 	}
+	if !data.BasePolicyId.IsNull() {
+		return false
+	}
 	return true
 }
 
@@ -33,10 +33,12 @@ import (
 
 //template:begin types
 type AccessControlPolicyCategory struct {
-	Id                    types.String `tfsdk:"id"`
-	Domain                types.String `tfsdk:"domain"`
-	AccessControlPolicyId types.String `tfsdk:"access_control_policy_id"`
-	Name                  types.String `tfsdk:"name"`
+	Id                        types.String `tfsdk:"id"`
+	Domain                    types.String `tfsdk:"domain"`
+	AccessControlPolicyId     types.String `tfsdk:"access_control_policy_id"`
+	Name                      types.String `tfsdk:"name"`
+	AccessControlPolicyName   types.String `tfsdk:"access_control_policy_name"`
+	CreateAccessControlPolicy types.Bool   `tfsdk:"create_access_control_policy"`
 }
 
 //template:end types
@@ -92,6 +94,12 @@ func (data *AccessControlPolicyCategory) isNull(ctx context.Context, res gjson.R
 	if !data.Name.IsNull() {
 		return false
 	}
+	if !data.AccessControlPolicyName.IsNull() {
+		return false
+	}
+	if !data.CreateAccessControlPolicy.IsNull() {
+		return false
+	}
 	return true
 }
 
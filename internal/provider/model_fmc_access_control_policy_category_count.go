@@ -0,0 +1,98 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type AccessControlPolicyCategoryCount struct {
+	Id                    types.String `tfsdk:"id"`
+	Domain                types.String `tfsdk:"domain"`
+	AccessControlPolicyId types.String `tfsdk:"access_control_policy_id"`
+	TotalCount            types.Int64  `tfsdk:"total_count"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data AccessControlPolicyCategoryCount) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories?limit=1", data.AccessControlPolicyId.ValueString())
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data AccessControlPolicyCategoryCount) toBody(ctx context.Context, state AccessControlPolicyCategoryCount) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.TotalCount.IsNull() {
+		body, _ = sjson.Set(body, "paging.count", data.TotalCount.ValueInt64())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *AccessControlPolicyCategoryCount) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("paging.count"); value.Exists() {
+		data.TotalCount = types.Int64Value(value.Int())
+	} else {
+		data.TotalCount = types.Int64Null()
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *AccessControlPolicyCategoryCount) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("paging.count"); value.Exists() && !data.TotalCount.IsNull() {
+		data.TotalCount = types.Int64Value(value.Int())
+	} else {
+		data.TotalCount = types.Int64Null()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *AccessControlPolicyCategoryCount) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.AccessControlPolicyId.IsNull() {
+		return false
+	}
+	if !data.TotalCount.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
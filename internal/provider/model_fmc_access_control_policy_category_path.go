@@ -0,0 +1,102 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type AccessControlPolicyCategoryPath struct {
+	Id                      types.String `tfsdk:"id"`
+	Domain                  types.String `tfsdk:"domain"`
+	AccessControlPolicyName types.String `tfsdk:"access_control_policy_name"`
+	AccessControlPolicyId   types.String `tfsdk:"access_control_policy_id"`
+	Name                    types.String `tfsdk:"name"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data AccessControlPolicyCategoryPath) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories", data.AccessControlPolicyId.ValueString())
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data AccessControlPolicyCategoryPath) toBody(ctx context.Context, state AccessControlPolicyCategoryPath) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.AccessControlPolicyId.IsNull() {
+		body, _ = sjson.Set(body, "accessControlPolicyId", data.AccessControlPolicyId.ValueString())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *AccessControlPolicyCategoryPath) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("accessControlPolicyId"); value.Exists() {
+		data.AccessControlPolicyId = types.StringValue(value.String())
+	} else {
+		data.AccessControlPolicyId = types.StringNull()
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *AccessControlPolicyCategoryPath) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("accessControlPolicyId"); value.Exists() && !data.AccessControlPolicyId.IsNull() {
+		data.AccessControlPolicyId = types.StringValue(value.String())
+	} else {
+		data.AccessControlPolicyId = types.StringNull()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *AccessControlPolicyCategoryPath) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.AccessControlPolicyName.IsNull() {
+		return false
+	}
+	if !data.AccessControlPolicyId.IsNull() {
+		return false
+	}
+	if !data.Name.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
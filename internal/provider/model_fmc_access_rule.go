@@ -0,0 +1,386 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type AccessRule struct {
+	Id                        types.String                          `tfsdk:"id"`
+	Domain                    types.String                          `tfsdk:"domain"`
+	AccessControlPolicyId     types.String                          `tfsdk:"access_control_policy_id"`
+	Name                      types.String                          `tfsdk:"name"`
+	Action                    types.String                          `tfsdk:"action"`
+	Enabled                   types.Bool                            `tfsdk:"enabled"`
+	Category                  types.String                          `tfsdk:"category"`
+	Section                   types.String                          `tfsdk:"section"`
+	LogBegin                  types.Bool                            `tfsdk:"log_begin"`
+	LogEnd                    types.Bool                            `tfsdk:"log_end"`
+	SendEventsToFmc           types.Bool                            `tfsdk:"send_events_to_fmc"`
+	SourceNetworkObjects      []AccessRuleSourceNetworkObjects      `tfsdk:"source_network_objects"`
+	DestinationNetworkObjects []AccessRuleDestinationNetworkObjects `tfsdk:"destination_network_objects"`
+}
+
+type AccessRuleSourceNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+type AccessRuleDestinationNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data AccessRule) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/accessrules", data.AccessControlPolicyId.ValueString())
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data AccessRule) toBody(ctx context.Context, state AccessRule) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "AccessRule")
+	if !data.Action.IsNull() {
+		body, _ = sjson.Set(body, "action", data.Action.ValueString())
+	}
+	if !data.Enabled.IsNull() {
+		body, _ = sjson.Set(body, "enabled", data.Enabled.ValueBool())
+	}
+	if !data.LogBegin.IsNull() {
+		body, _ = sjson.Set(body, "logBegin", data.LogBegin.ValueBool())
+	}
+	if !data.LogEnd.IsNull() {
+		body, _ = sjson.Set(body, "logEnd", data.LogEnd.ValueBool())
+	}
+	if !data.SendEventsToFmc.IsNull() {
+		body, _ = sjson.Set(body, "sendEventsToFMC", data.SendEventsToFmc.ValueBool())
+	}
+	if len(data.SourceNetworkObjects) > 0 {
+		body, _ = sjson.Set(body, "sourceNetworks.objects", []interface{}{})
+		for _, item := range data.SourceNetworkObjects {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Type.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "type", item.Type.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "sourceNetworks.objects.-1", itemBody)
+		}
+	}
+	if len(data.DestinationNetworkObjects) > 0 {
+		body, _ = sjson.Set(body, "destinationNetworks.objects", []interface{}{})
+		for _, item := range data.DestinationNetworkObjects {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Type.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "type", item.Type.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "destinationNetworks.objects.-1", itemBody)
+		}
+	}
+	return body
+}
+
+// setQueryParameters adds the attributes which are sent as query parameters to the create request
+func (data AccessRule) setQueryParameters(req *fmc.Req) {
+	query := req.HttpReq.URL.Query()
+	if !data.Category.IsNull() && !data.Category.IsUnknown() {
+		query.Set("category", data.Category.ValueString())
+	}
+	if !data.Section.IsNull() && !data.Section.IsUnknown() {
+		query.Set("section", data.Section.ValueString())
+	}
+	req.HttpReq.URL.RawQuery = query.Encode()
+}
+
+// setPlacementParameters adds the category and section the object is placed in to the update request
+func (data AccessRule) setPlacementParameters(req *fmc.Req) {
+	query := req.HttpReq.URL.Query()
+	if !data.Category.IsNull() && !data.Category.IsUnknown() {
+		query.Set("category", data.Category.ValueString())
+	}
+	if !data.Section.IsNull() && !data.Section.IsUnknown() {
+		query.Set("section", data.Section.ValueString())
+	}
+	req.HttpReq.URL.RawQuery = query.Encode()
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *AccessRule) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("action"); value.Exists() {
+		data.Action = types.StringValue(value.String())
+	} else {
+		data.Action = types.StringNull()
+	}
+	if value := res.Get("enabled"); value.Exists() {
+		data.Enabled = types.BoolValue(value.Bool())
+	} else {
+		data.Enabled = types.BoolValue(true)
+	}
+	if value := res.Get("metadata.category"); value.Exists() {
+		data.Category = types.StringValue(value.String())
+	} else {
+		data.Category = types.StringNull()
+	}
+	if value := res.Get("metadata.section"); value.Exists() {
+		data.Section = types.StringValue(helpers.EnumFold(value.String(), "mandatory", "default"))
+	} else {
+		data.Section = types.StringNull()
+	}
+	if value := res.Get("logBegin"); value.Exists() {
+		data.LogBegin = types.BoolValue(value.Bool())
+	} else {
+		data.LogBegin = types.BoolValue(false)
+	}
+	if value := res.Get("logEnd"); value.Exists() {
+		data.LogEnd = types.BoolValue(value.Bool())
+	} else {
+		data.LogEnd = types.BoolValue(false)
+	}
+	if value := res.Get("sendEventsToFMC"); value.Exists() {
+		data.SendEventsToFmc = types.BoolValue(value.Bool())
+	} else {
+		data.SendEventsToFmc = types.BoolValue(false)
+	}
+	if value := res.Get("sourceNetworks.objects"); value.Exists() {
+		data.SourceNetworkObjects = make([]AccessRuleSourceNetworkObjects, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := AccessRuleSourceNetworkObjects{}
+			if cValue := v.Get("id"); cValue.Exists() {
+				item.Id = types.StringValue(cValue.String())
+			} else {
+				item.Id = types.StringNull()
+			}
+			if cValue := v.Get("type"); cValue.Exists() {
+				item.Type = types.StringValue(cValue.String())
+			} else {
+				item.Type = types.StringNull()
+			}
+			data.SourceNetworkObjects = append(data.SourceNetworkObjects, item)
+			return true
+		})
+	}
+	if value := res.Get("destinationNetworks.objects"); value.Exists() {
+		data.DestinationNetworkObjects = make([]AccessRuleDestinationNetworkObjects, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := AccessRuleDestinationNetworkObjects{}
+			if cValue := v.Get("id"); cValue.Exists() {
+				item.Id = types.StringValue(cValue.String())
+			} else {
+				item.Id = types.StringNull()
+			}
+			if cValue := v.Get("type"); cValue.Exists() {
+				item.Type = types.StringValue(cValue.String())
+			} else {
+				item.Type = types.StringNull()
+			}
+			data.DestinationNetworkObjects = append(data.DestinationNetworkObjects, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *AccessRule) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("action"); value.Exists() && !data.Action.IsNull() {
+		data.Action = types.StringValue(value.String())
+	} else {
+		data.Action = types.StringNull()
+	}
+	if value := res.Get("enabled"); value.Exists() && !data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(value.Bool())
+	} else if data.Enabled.ValueBool() != true {
+		data.Enabled = types.BoolNull()
+	}
+	if value := res.Get("metadata.category"); value.Exists() {
+		data.Category = types.StringValue(value.String())
+	} else {
+		data.Category = types.StringNull()
+	}
+	if value := res.Get("metadata.section"); value.Exists() {
+		data.Section = types.StringValue(helpers.EnumFold(value.String(), "mandatory", "default"))
+	} else {
+		data.Section = types.StringNull()
+	}
+	if value := res.Get("logBegin"); value.Exists() && !data.LogBegin.IsNull() {
+		data.LogBegin = types.BoolValue(value.Bool())
+	} else if data.LogBegin.ValueBool() != false {
+		data.LogBegin = types.BoolNull()
+	}
+	if value := res.Get("logEnd"); value.Exists() && !data.LogEnd.IsNull() {
+		data.LogEnd = types.BoolValue(value.Bool())
+	} else if data.LogEnd.ValueBool() != false {
+		data.LogEnd = types.BoolNull()
+	}
+	if value := res.Get("sendEventsToFMC"); value.Exists() && !data.SendEventsToFmc.IsNull() {
+		data.SendEventsToFmc = types.BoolValue(value.Bool())
+	} else if data.SendEventsToFmc.ValueBool() != false {
+		data.SendEventsToFmc = types.BoolNull()
+	}
+	for i := range data.SourceNetworkObjects {
+		keys := [...]string{"id"}
+		keyValues := [...]string{data.SourceNetworkObjects[i].Id.ValueString()}
+
+		var r gjson.Result
+		res.Get("sourceNetworks.objects").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("id"); value.Exists() && !data.SourceNetworkObjects[i].Id.IsNull() {
+			data.SourceNetworkObjects[i].Id = types.StringValue(value.String())
+		} else {
+			data.SourceNetworkObjects[i].Id = types.StringNull()
+		}
+		if value := r.Get("type"); value.Exists() && !data.SourceNetworkObjects[i].Type.IsNull() {
+			data.SourceNetworkObjects[i].Type = types.StringValue(value.String())
+		} else {
+			data.SourceNetworkObjects[i].Type = types.StringNull()
+		}
+	}
+	for i := range data.DestinationNetworkObjects {
+		keys := [...]string{"id"}
+		keyValues := [...]string{data.DestinationNetworkObjects[i].Id.ValueString()}
+
+		var r gjson.Result
+		res.Get("destinationNetworks.objects").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("id"); value.Exists() && !data.DestinationNetworkObjects[i].Id.IsNull() {
+			data.DestinationNetworkObjects[i].Id = types.StringValue(value.String())
+		} else {
+			data.DestinationNetworkObjects[i].Id = types.StringNull()
+		}
+		if value := r.Get("type"); value.Exists() && !data.DestinationNetworkObjects[i].Type.IsNull() {
+			data.DestinationNetworkObjects[i].Type = types.StringValue(value.String())
+		} else {
+			data.DestinationNetworkObjects[i].Type = types.StringNull()
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *AccessRule) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.AccessControlPolicyId.IsNull() {
+		return false
+	}
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Action.IsNull() {
+		return false
+	}
+	if !data.Enabled.IsNull() {
+		return false
+	}
+	if !data.Category.IsNull() {
+		return false
+	}
+	if !data.Section.IsNull() {
+		return false
+	}
+	if !data.LogBegin.IsNull() {
+		return false
+	}
+	if !data.LogEnd.IsNull() {
+		return false
+	}
+	if !data.SendEventsToFmc.IsNull() {
+		return false
+	}
+	if len(data.SourceNetworkObjects) > 0 {
+		return false
+	}
+	if len(data.DestinationNetworkObjects) > 0 {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -0,0 +1,209 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type CertificateEnrollment struct {
+	Id                  types.String `tfsdk:"id"`
+	Domain              types.String `tfsdk:"domain"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	EnrollmentType      types.String `tfsdk:"enrollment_type"`
+	ScepEnrollmentUrl   types.String `tfsdk:"scep_enrollment_url"`
+	ScepRetryPeriod     types.Int64  `tfsdk:"scep_retry_period"`
+	ScepRetryCount      types.Int64  `tfsdk:"scep_retry_count"`
+	ManualCaCertificate types.String `tfsdk:"manual_ca_certificate"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data CertificateEnrollment) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/certenrollments"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data CertificateEnrollment) toBody(ctx context.Context, state CertificateEnrollment) string {
+	body := ""
+	if !helpers.Contains([]string{"SCEP"}, data.EnrollmentType.ValueString()) {
+		data.ScepEnrollmentUrl = types.StringNull()
+	}
+	if !helpers.Contains([]string{"SCEP"}, data.EnrollmentType.ValueString()) {
+		data.ScepRetryPeriod = types.Int64Null()
+	}
+	if !helpers.Contains([]string{"SCEP"}, data.EnrollmentType.ValueString()) {
+		data.ScepRetryCount = types.Int64Null()
+	}
+	if !helpers.Contains([]string{"MANUAL"}, data.EnrollmentType.ValueString()) {
+		data.ManualCaCertificate = types.StringNull()
+	}
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "CertEnrollment")
+	if !data.EnrollmentType.IsNull() {
+		body, _ = sjson.Set(body, "enrollmentType", data.EnrollmentType.ValueString())
+	}
+	if !data.ScepEnrollmentUrl.IsNull() {
+		body, _ = sjson.Set(body, "scep.enrollmentUrl", data.ScepEnrollmentUrl.ValueString())
+	}
+	if !data.ScepRetryPeriod.IsNull() {
+		body, _ = sjson.Set(body, "scep.retryPeriod", data.ScepRetryPeriod.ValueInt64())
+	}
+	if !data.ScepRetryCount.IsNull() {
+		body, _ = sjson.Set(body, "scep.retryCount", data.ScepRetryCount.ValueInt64())
+	}
+	if !data.ManualCaCertificate.IsNull() {
+		body, _ = sjson.Set(body, "manual.caCertificate", data.ManualCaCertificate.ValueString())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *CertificateEnrollment) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("enrollmentType"); value.Exists() {
+		data.EnrollmentType = types.StringValue(value.String())
+	} else {
+		data.EnrollmentType = types.StringNull()
+	}
+	if value := res.Get("scep.enrollmentUrl"); value.Exists() {
+		data.ScepEnrollmentUrl = types.StringValue(value.String())
+	} else {
+		data.ScepEnrollmentUrl = types.StringNull()
+	}
+	if value := res.Get("scep.retryPeriod"); value.Exists() {
+		data.ScepRetryPeriod = types.Int64Value(value.Int())
+	} else {
+		data.ScepRetryPeriod = types.Int64Null()
+	}
+	if value := res.Get("scep.retryCount"); value.Exists() {
+		data.ScepRetryCount = types.Int64Value(value.Int())
+	} else {
+		data.ScepRetryCount = types.Int64Null()
+	}
+	if value := res.Get("manual.caCertificate"); value.Exists() {
+		data.ManualCaCertificate = types.StringValue(value.String())
+	} else {
+		data.ManualCaCertificate = types.StringNull()
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *CertificateEnrollment) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("enrollmentType"); value.Exists() && !data.EnrollmentType.IsNull() {
+		data.EnrollmentType = types.StringValue(value.String())
+	} else {
+		data.EnrollmentType = types.StringNull()
+	}
+	if value := res.Get("scep.enrollmentUrl"); value.Exists() && !data.ScepEnrollmentUrl.IsNull() {
+		data.ScepEnrollmentUrl = types.StringValue(value.String())
+	} else {
+		data.ScepEnrollmentUrl = types.StringNull()
+	}
+	if value := res.Get("scep.retryPeriod"); value.Exists() && !data.ScepRetryPeriod.IsNull() {
+		data.ScepRetryPeriod = types.Int64Value(value.Int())
+	} else {
+		data.ScepRetryPeriod = types.Int64Null()
+	}
+	if value := res.Get("scep.retryCount"); value.Exists() && !data.ScepRetryCount.IsNull() {
+		data.ScepRetryCount = types.Int64Value(value.Int())
+	} else {
+		data.ScepRetryCount = types.Int64Null()
+	}
+	if value := res.Get("manual.caCertificate"); value.Exists() && !data.ManualCaCertificate.IsNull() {
+		data.ManualCaCertificate = types.StringValue(value.String())
+	} else {
+		data.ManualCaCertificate = types.StringNull()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *CertificateEnrollment) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if !data.EnrollmentType.IsNull() {
+		return false
+	}
+	if !data.ScepEnrollmentUrl.IsNull() {
+		return false
+	}
+	if !data.ScepRetryPeriod.IsNull() {
+		return false
+	}
+	if !data.ScepRetryCount.IsNull() {
+		return false
+	}
+	if !data.ManualCaCertificate.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
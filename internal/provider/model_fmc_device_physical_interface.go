@@ -0,0 +1,191 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type DevicePhysicalInterface struct {
+	Id          types.String `tfsdk:"id"`
+	Domain      types.String `tfsdk:"domain"`
+	DeviceId    types.String `tfsdk:"device_id"`
+	Name        types.String `tfsdk:"name"`
+	LogicalName types.String `tfsdk:"logical_name"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Mode        types.String `tfsdk:"mode"`
+	Mtu         types.Int64  `tfsdk:"mtu"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data DevicePhysicalInterface) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords/%v/physicalinterfaces", data.DeviceId.ValueString())
+}
+
+// naturalKey returns the composite key which identifies the object
+func (data DevicePhysicalInterface) naturalKey() string {
+	return strings.Join([]string{data.DeviceId.ValueString(), data.Name.ValueString()}, ",")
+}
+
+// findByNaturalKey returns the object matching the natural key from a list of objects
+func (data DevicePhysicalInterface) findByNaturalKey(res gjson.Result) gjson.Result {
+	for _, v := range res.Get("items").Array() {
+		if v.Get("name").String() == data.Name.ValueString() {
+			return v
+		}
+	}
+	return gjson.Result{}
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data DevicePhysicalInterface) toBody(ctx context.Context, state DevicePhysicalInterface) string {
+	body := ""
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "PhysicalInterface")
+	if !data.LogicalName.IsNull() {
+		body, _ = sjson.Set(body, "ifname", data.LogicalName.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	if !data.Enabled.IsNull() {
+		body, _ = sjson.Set(body, "enabled", data.Enabled.ValueBool())
+	}
+	if !data.Mode.IsNull() {
+		body, _ = sjson.Set(body, "mode", data.Mode.ValueString())
+	}
+	if !data.Mtu.IsNull() {
+		body, _ = sjson.Set(body, "MTU", data.Mtu.ValueInt64())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *DevicePhysicalInterface) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("ifname"); value.Exists() {
+		data.LogicalName = types.StringValue(value.String())
+	} else {
+		data.LogicalName = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("enabled"); value.Exists() {
+		data.Enabled = types.BoolValue(value.Bool())
+	} else {
+		data.Enabled = types.BoolValue(true)
+	}
+	if value := res.Get("mode"); value.Exists() {
+		data.Mode = types.StringValue(value.String())
+	} else {
+		data.Mode = types.StringValue("NONE")
+	}
+	if value := res.Get("MTU"); value.Exists() {
+		data.Mtu = types.Int64Value(value.Int())
+	} else {
+		data.Mtu = types.Int64Value(1500)
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *DevicePhysicalInterface) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("ifname"); value.Exists() && !data.LogicalName.IsNull() {
+		data.LogicalName = types.StringValue(value.String())
+	} else {
+		data.LogicalName = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("enabled"); value.Exists() && !data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(value.Bool())
+	} else if data.Enabled.ValueBool() != true {
+		data.Enabled = types.BoolNull()
+	}
+	if value := res.Get("mode"); value.Exists() && !data.Mode.IsNull() {
+		data.Mode = types.StringValue(value.String())
+	} else if data.Mode.ValueString() != "NONE" {
+		data.Mode = types.StringNull()
+	}
+	if value := res.Get("MTU"); value.Exists() && !data.Mtu.IsNull() {
+		data.Mtu = types.Int64Value(value.Int())
+	} else if data.Mtu.ValueInt64() != 1500 {
+		data.Mtu = types.Int64Null()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *DevicePhysicalInterface) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.LogicalName.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if !data.Enabled.IsNull() {
+		return false
+	}
+	if !data.Mode.IsNull() {
+		return false
+	}
+	if !data.Mtu.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -0,0 +1,220 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type Devices struct {
+	Id      types.String     `tfsdk:"id"`
+	Domain  types.String     `tfsdk:"domain"`
+	Devices []DevicesDevices `tfsdk:"devices"`
+}
+
+type DevicesDevices struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Model         types.String `tfsdk:"model"`
+	Version       types.String `tfsdk:"version"`
+	Role          types.String `tfsdk:"role"`
+	ContainerType types.String `tfsdk:"container_type"`
+	ContainerName types.String `tfsdk:"container_name"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data Devices) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords?expanded=true"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data Devices) toBody(ctx context.Context, state Devices) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if len(data.Devices) > 0 {
+		body, _ = sjson.Set(body, "items", []interface{}{})
+		for _, item := range data.Devices {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.Model.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "model", item.Model.ValueString())
+			}
+			if !item.Version.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "sw_version", item.Version.ValueString())
+			}
+			if !item.Role.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "metadata.containerDetails.role", item.Role.ValueString())
+			}
+			if !item.ContainerType.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "metadata.containerDetails.type", item.ContainerType.ValueString())
+			}
+			if !item.ContainerName.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "metadata.containerDetails.name", item.ContainerName.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "items.-1", itemBody)
+		}
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *Devices) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("items"); value.Exists() {
+		data.Devices = make([]DevicesDevices, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := DevicesDevices{}
+			if cValue := v.Get("id"); cValue.Exists() {
+				item.Id = types.StringValue(cValue.String())
+			} else {
+				item.Id = types.StringNull()
+			}
+			if cValue := v.Get("name"); cValue.Exists() {
+				item.Name = types.StringValue(cValue.String())
+			} else {
+				item.Name = types.StringNull()
+			}
+			if cValue := v.Get("model"); cValue.Exists() {
+				item.Model = types.StringValue(cValue.String())
+			} else {
+				item.Model = types.StringNull()
+			}
+			if cValue := v.Get("sw_version"); cValue.Exists() {
+				item.Version = types.StringValue(cValue.String())
+			} else {
+				item.Version = types.StringNull()
+			}
+			if cValue := v.Get("metadata.containerDetails.role"); cValue.Exists() {
+				item.Role = types.StringValue(cValue.String())
+			} else {
+				item.Role = types.StringNull()
+			}
+			if cValue := v.Get("metadata.containerDetails.type"); cValue.Exists() {
+				item.ContainerType = types.StringValue(cValue.String())
+			} else {
+				item.ContainerType = types.StringNull()
+			}
+			if cValue := v.Get("metadata.containerDetails.name"); cValue.Exists() {
+				item.ContainerName = types.StringValue(cValue.String())
+			} else {
+				item.ContainerName = types.StringNull()
+			}
+			data.Devices = append(data.Devices, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *Devices) updateFromBody(ctx context.Context, res gjson.Result) {
+	for i := range data.Devices {
+		keys := [...]string{"id", "name", "model", "sw_version", "metadata.containerDetails.role", "metadata.containerDetails.type", "metadata.containerDetails.name"}
+		keyValues := [...]string{data.Devices[i].Id.ValueString(), data.Devices[i].Name.ValueString(), data.Devices[i].Model.ValueString(), data.Devices[i].Version.ValueString(), data.Devices[i].Role.ValueString(), data.Devices[i].ContainerType.ValueString(), data.Devices[i].ContainerName.ValueString()}
+
+		var r gjson.Result
+		res.Get("items").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("id"); value.Exists() && !data.Devices[i].Id.IsNull() {
+			data.Devices[i].Id = types.StringValue(value.String())
+		} else {
+			data.Devices[i].Id = types.StringNull()
+		}
+		if value := r.Get("name"); value.Exists() && !data.Devices[i].Name.IsNull() {
+			data.Devices[i].Name = types.StringValue(value.String())
+		} else {
+			data.Devices[i].Name = types.StringNull()
+		}
+		if value := r.Get("model"); value.Exists() && !data.Devices[i].Model.IsNull() {
+			data.Devices[i].Model = types.StringValue(value.String())
+		} else {
+			data.Devices[i].Model = types.StringNull()
+		}
+		if value := r.Get("sw_version"); value.Exists() && !data.Devices[i].Version.IsNull() {
+			data.Devices[i].Version = types.StringValue(value.String())
+		} else {
+			data.Devices[i].Version = types.StringNull()
+		}
+		if value := r.Get("metadata.containerDetails.role"); value.Exists() && !data.Devices[i].Role.IsNull() {
+			data.Devices[i].Role = types.StringValue(value.String())
+		} else {
+			data.Devices[i].Role = types.StringNull()
+		}
+		if value := r.Get("metadata.containerDetails.type"); value.Exists() && !data.Devices[i].ContainerType.IsNull() {
+			data.Devices[i].ContainerType = types.StringValue(value.String())
+		} else {
+			data.Devices[i].ContainerType = types.StringNull()
+		}
+		if value := r.Get("metadata.containerDetails.name"); value.Exists() && !data.Devices[i].ContainerName.IsNull() {
+			data.Devices[i].ContainerName = types.StringValue(value.String())
+		} else {
+			data.Devices[i].ContainerName = types.StringNull()
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *Devices) isNull(ctx context.Context, res gjson.Result) bool {
+	if len(data.Devices) > 0 {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -0,0 +1,284 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type HealthPolicy struct {
+	Id          types.String          `tfsdk:"id"`
+	Domain      types.String          `tfsdk:"domain"`
+	Name        types.String          `tfsdk:"name"`
+	Description types.String          `tfsdk:"description"`
+	Modules     []HealthPolicyModules `tfsdk:"modules"`
+}
+
+type HealthPolicyModules struct {
+	Name              types.String                  `tfsdk:"name"`
+	Enabled           types.Bool                    `tfsdk:"enabled"`
+	WarningThreshold  types.Int64                   `tfsdk:"warning_threshold"`
+	CriticalThreshold types.Int64                   `tfsdk:"critical_threshold"`
+	Settings          []HealthPolicyModulesSettings `tfsdk:"settings"`
+}
+
+type HealthPolicyModulesSettings struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data HealthPolicy) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/health/policies"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data HealthPolicy) toBody(ctx context.Context, state HealthPolicy) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "HealthPolicy")
+	if len(data.Modules) > 0 {
+		body, _ = sjson.Set(body, "healthModules", []interface{}{})
+		for _, item := range data.Modules {
+			itemBody := ""
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.Enabled.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "enabled", item.Enabled.ValueBool())
+			}
+			if !item.WarningThreshold.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "alertConfig.warningThreshold", item.WarningThreshold.ValueInt64())
+			}
+			if !item.CriticalThreshold.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "alertConfig.criticalThreshold", item.CriticalThreshold.ValueInt64())
+			}
+			if len(item.Settings) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "moduleAttributes", []interface{}{})
+				for _, childItem := range item.Settings {
+					itemChildBody := ""
+					if !childItem.Name.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "name", childItem.Name.ValueString())
+					}
+					if !childItem.Value.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "value", childItem.Value.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "moduleAttributes.-1", itemChildBody)
+				}
+			}
+			body, _ = sjson.SetRaw(body, "healthModules.-1", itemBody)
+		}
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *HealthPolicy) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("healthModules"); value.Exists() {
+		data.Modules = make([]HealthPolicyModules, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := HealthPolicyModules{}
+			if cValue := v.Get("name"); cValue.Exists() {
+				item.Name = types.StringValue(cValue.String())
+			} else {
+				item.Name = types.StringNull()
+			}
+			if cValue := v.Get("enabled"); cValue.Exists() {
+				item.Enabled = types.BoolValue(cValue.Bool())
+			} else {
+				item.Enabled = types.BoolValue(true)
+			}
+			if cValue := v.Get("alertConfig.warningThreshold"); cValue.Exists() {
+				item.WarningThreshold = types.Int64Value(cValue.Int())
+			} else {
+				item.WarningThreshold = types.Int64Null()
+			}
+			if cValue := v.Get("alertConfig.criticalThreshold"); cValue.Exists() {
+				item.CriticalThreshold = types.Int64Value(cValue.Int())
+			} else {
+				item.CriticalThreshold = types.Int64Null()
+			}
+			if cValue := v.Get("moduleAttributes"); cValue.Exists() {
+				item.Settings = make([]HealthPolicyModulesSettings, 0)
+				cValue.ForEach(func(ck, cv gjson.Result) bool {
+					cItem := HealthPolicyModulesSettings{}
+					if ccValue := cv.Get("name"); ccValue.Exists() {
+						cItem.Name = types.StringValue(ccValue.String())
+					} else {
+						cItem.Name = types.StringNull()
+					}
+					if ccValue := cv.Get("value"); ccValue.Exists() {
+						cItem.Value = types.StringValue(ccValue.String())
+					} else {
+						cItem.Value = types.StringNull()
+					}
+					item.Settings = append(item.Settings, cItem)
+					return true
+				})
+			}
+			data.Modules = append(data.Modules, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *HealthPolicy) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	for i := range data.Modules {
+		keys := [...]string{"name"}
+		keyValues := [...]string{data.Modules[i].Name.ValueString()}
+
+		var r gjson.Result
+		res.Get("healthModules").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("name"); value.Exists() && !data.Modules[i].Name.IsNull() {
+			data.Modules[i].Name = types.StringValue(value.String())
+		} else {
+			data.Modules[i].Name = types.StringNull()
+		}
+		if value := r.Get("enabled"); value.Exists() && !data.Modules[i].Enabled.IsNull() {
+			data.Modules[i].Enabled = types.BoolValue(value.Bool())
+		} else if data.Modules[i].Enabled.ValueBool() != true {
+			data.Modules[i].Enabled = types.BoolNull()
+		}
+		if value := r.Get("alertConfig.warningThreshold"); value.Exists() && !data.Modules[i].WarningThreshold.IsNull() {
+			data.Modules[i].WarningThreshold = types.Int64Value(value.Int())
+		} else {
+			data.Modules[i].WarningThreshold = types.Int64Null()
+		}
+		if value := r.Get("alertConfig.criticalThreshold"); value.Exists() && !data.Modules[i].CriticalThreshold.IsNull() {
+			data.Modules[i].CriticalThreshold = types.Int64Value(value.Int())
+		} else {
+			data.Modules[i].CriticalThreshold = types.Int64Null()
+		}
+		for ci := range data.Modules[i].Settings {
+			keys := [...]string{"name"}
+			keyValues := [...]string{data.Modules[i].Settings[ci].Name.ValueString()}
+
+			var cr gjson.Result
+			r.Get("moduleAttributes").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() == keyValues[ik] {
+							found = true
+							continue
+						}
+						found = false
+						break
+					}
+					if found {
+						cr = v
+						return false
+					}
+					return true
+				},
+			)
+			if value := cr.Get("name"); value.Exists() && !data.Modules[i].Settings[ci].Name.IsNull() {
+				data.Modules[i].Settings[ci].Name = types.StringValue(value.String())
+			} else {
+				data.Modules[i].Settings[ci].Name = types.StringNull()
+			}
+			if value := cr.Get("value"); value.Exists() && !data.Modules[i].Settings[ci].Value.IsNull() {
+				data.Modules[i].Settings[ci].Value = types.StringValue(value.String())
+			} else {
+				data.Modules[i].Settings[ci].Value = types.StringNull()
+			}
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *HealthPolicy) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if len(data.Modules) > 0 {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -37,7 +37,14 @@ type Host struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Ip          types.String `tfsdk:"ip"`
+	Type        types.String `tfsdk:"type"`
 	Overridable types.Bool   `tfsdk:"overridable"`
+	Tags        []HostTags   `tfsdk:"tags"`
+}
+
+type HostTags struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
 }
 
 //template:end types
@@ -67,6 +74,19 @@ func (data Host) toBody(ctx context.Context, state Host) string {
 	if !data.Overridable.IsNull() {
 		body, _ = sjson.Set(body, "overridable", data.Overridable.ValueBool())
 	}
+	if len(data.Tags) > 0 {
+		body, _ = sjson.Set(body, "tags", []interface{}{})
+		for _, item := range data.Tags {
+			itemBody := ""
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.Value.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "value", item.Value.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "tags.-1", itemBody)
+		}
+	}
 	return body
 }
 
@@ -89,11 +109,34 @@ func (data *Host) fromBody(ctx context.Context, res gjson.Result) {
 	} else {
 		data.Ip = types.StringNull()
 	}
+	if value := res.Get("type"); value.Exists() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
 	if value := res.Get("overridable"); value.Exists() {
 		data.Overridable = types.BoolValue(value.Bool())
 	} else {
 		data.Overridable = types.BoolNull()
 	}
+	if value := res.Get("tags"); value.Exists() {
+		data.Tags = make([]HostTags, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := HostTags{}
+			if cValue := v.Get("name"); cValue.Exists() {
+				item.Name = types.StringValue(cValue.String())
+			} else {
+				item.Name = types.StringNull()
+			}
+			if cValue := v.Get("value"); cValue.Exists() {
+				item.Value = types.StringValue(cValue.String())
+			} else {
+				item.Value = types.StringNull()
+			}
+			data.Tags = append(data.Tags, item)
+			return true
+		})
+	}
 }
 
 //template:end fromBody
@@ -115,11 +158,50 @@ func (data *Host) updateFromBody(ctx context.Context, res gjson.Result) {
 	} else {
 		data.Ip = types.StringNull()
 	}
+	if value := res.Get("type"); value.Exists() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
 	if value := res.Get("overridable"); value.Exists() && !data.Overridable.IsNull() {
 		data.Overridable = types.BoolValue(value.Bool())
 	} else {
 		data.Overridable = types.BoolNull()
 	}
+	for i := range data.Tags {
+		keys := [...]string{"name"}
+		keyValues := [...]string{data.Tags[i].Name.ValueString()}
+
+		var r gjson.Result
+		res.Get("tags").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("name"); value.Exists() && !data.Tags[i].Name.IsNull() {
+			data.Tags[i].Name = types.StringValue(value.String())
+		} else {
+			data.Tags[i].Name = types.StringNull()
+		}
+		if value := r.Get("value"); value.Exists() && !data.Tags[i].Value.IsNull() {
+			data.Tags[i].Value = types.StringValue(value.String())
+		} else {
+			data.Tags[i].Value = types.StringNull()
+		}
+	}
 }
 
 //template:end updateFromBody
@@ -135,9 +217,15 @@ func (data *Host) isNull(ctx context.Context, res gjson.Result) bool {
 	if !data.Ip.IsNull() {
 		return false
 	}
+	if !data.Type.IsNull() {
+		return false
+	}
 	if !data.Overridable.IsNull() {
 		return false
 	}
+	if len(data.Tags) > 0 {
+		return false
+	}
 	return true
 }
 
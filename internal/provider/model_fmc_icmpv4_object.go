@@ -0,0 +1,163 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type ICMPv4Object struct {
+	Id          types.String `tfsdk:"id"`
+	Domain      types.String `tfsdk:"domain"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IcmpType    types.String `tfsdk:"icmp_type"`
+	Code        types.Int64  `tfsdk:"code"`
+	Overridable types.Bool   `tfsdk:"overridable"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data ICMPv4Object) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/icmpv4objects"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data ICMPv4Object) toBody(ctx context.Context, state ICMPv4Object) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "ICMPV4Object")
+	if !data.IcmpType.IsNull() {
+		body, _ = sjson.Set(body, "icmpType", helpers.EnumToInteger(data.IcmpType.ValueString(), []string{"ECHO_REPLY", "DESTINATION_UNREACHABLE", "SOURCE_QUENCH", "REDIRECT", "ALTERNATE_HOST_ADDRESS", "ECHO", "ROUTER_ADVERTISEMENT", "ROUTER_SOLICITATION", "TIME_EXCEEDED", "PARAMETER_PROBLEM", "TIMESTAMP", "TIMESTAMP_REPLY", "INFORMATION_REQUEST", "INFORMATION_REPLY", "ADDRESS_MASK_REQUEST", "ADDRESS_MASK_REPLY", "TRACEROUTE"}, []int64{0, 3, 4, 5, 6, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 30}))
+	}
+	if !data.Code.IsNull() {
+		body, _ = sjson.Set(body, "code", data.Code.ValueInt64())
+	}
+	if !data.Overridable.IsNull() {
+		body, _ = sjson.Set(body, "overridable", data.Overridable.ValueBool())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *ICMPv4Object) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("icmpType"); value.Exists() {
+		data.IcmpType = types.StringValue(helpers.EnumFromInteger(value.Int(), []string{"ECHO_REPLY", "DESTINATION_UNREACHABLE", "SOURCE_QUENCH", "REDIRECT", "ALTERNATE_HOST_ADDRESS", "ECHO", "ROUTER_ADVERTISEMENT", "ROUTER_SOLICITATION", "TIME_EXCEEDED", "PARAMETER_PROBLEM", "TIMESTAMP", "TIMESTAMP_REPLY", "INFORMATION_REQUEST", "INFORMATION_REPLY", "ADDRESS_MASK_REQUEST", "ADDRESS_MASK_REPLY", "TRACEROUTE"}, []int64{0, 3, 4, 5, 6, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 30}))
+	} else {
+		data.IcmpType = types.StringNull()
+	}
+	if value := res.Get("code"); value.Exists() {
+		data.Code = types.Int64Value(value.Int())
+	} else {
+		data.Code = types.Int64Null()
+	}
+	if value := res.Get("overridable"); value.Exists() {
+		data.Overridable = types.BoolValue(value.Bool())
+	} else {
+		data.Overridable = types.BoolNull()
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *ICMPv4Object) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("icmpType"); value.Exists() && !data.IcmpType.IsNull() {
+		data.IcmpType = types.StringValue(helpers.EnumFromInteger(value.Int(), []string{"ECHO_REPLY", "DESTINATION_UNREACHABLE", "SOURCE_QUENCH", "REDIRECT", "ALTERNATE_HOST_ADDRESS", "ECHO", "ROUTER_ADVERTISEMENT", "ROUTER_SOLICITATION", "TIME_EXCEEDED", "PARAMETER_PROBLEM", "TIMESTAMP", "TIMESTAMP_REPLY", "INFORMATION_REQUEST", "INFORMATION_REPLY", "ADDRESS_MASK_REQUEST", "ADDRESS_MASK_REPLY", "TRACEROUTE"}, []int64{0, 3, 4, 5, 6, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 30}))
+	} else {
+		data.IcmpType = types.StringNull()
+	}
+	if value := res.Get("code"); value.Exists() && !data.Code.IsNull() {
+		data.Code = types.Int64Value(value.Int())
+	} else {
+		data.Code = types.Int64Null()
+	}
+	if value := res.Get("overridable"); value.Exists() && !data.Overridable.IsNull() {
+		data.Overridable = types.BoolValue(value.Bool())
+	} else {
+		data.Overridable = types.BoolNull()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *ICMPv4Object) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if !data.IcmpType.IsNull() {
+		return false
+	}
+	if !data.Code.IsNull() {
+		return false
+	}
+	if !data.Overridable.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
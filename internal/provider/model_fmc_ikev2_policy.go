@@ -0,0 +1,202 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type IKEv2Policy struct {
+	Id                     types.String `tfsdk:"id"`
+	Domain                 types.String `tfsdk:"domain"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Priority               types.Int64  `tfsdk:"priority"`
+	Lifetime               types.Int64  `tfsdk:"lifetime"`
+	EncryptionAlgorithms   types.List   `tfsdk:"encryption_algorithms"`
+	IntegrityAlgorithms    types.List   `tfsdk:"integrity_algorithms"`
+	PrfIntegrityAlgorithms types.List   `tfsdk:"prf_integrity_algorithms"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data IKEv2Policy) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/ikev2policies"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data IKEv2Policy) toBody(ctx context.Context, state IKEv2Policy) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	if !data.Priority.IsNull() {
+		body, _ = sjson.Set(body, "priority", data.Priority.ValueInt64())
+	}
+	if !data.Lifetime.IsNull() {
+		body, _ = sjson.Set(body, "lifetimeInSeconds", data.Lifetime.ValueInt64())
+	}
+	if !data.EncryptionAlgorithms.IsNull() {
+		var values []string
+		data.EncryptionAlgorithms.ElementsAs(ctx, &values, false)
+		body, _ = sjson.Set(body, "encryptionAlgorithms", values)
+	}
+	if !data.IntegrityAlgorithms.IsNull() {
+		var values []string
+		data.IntegrityAlgorithms.ElementsAs(ctx, &values, false)
+		body, _ = sjson.Set(body, "integrityAlgorithms", values)
+	}
+	if !data.PrfIntegrityAlgorithms.IsNull() {
+		var values []string
+		data.PrfIntegrityAlgorithms.ElementsAs(ctx, &values, false)
+		body, _ = sjson.Set(body, "prfIntegrityAlgorithms", values)
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *IKEv2Policy) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("priority"); value.Exists() {
+		data.Priority = types.Int64Value(value.Int())
+	} else {
+		data.Priority = types.Int64Null()
+	}
+	if value := res.Get("lifetimeInSeconds"); value.Exists() {
+		data.Lifetime = types.Int64Value(value.Int())
+	} else {
+		data.Lifetime = types.Int64Value(86400)
+	}
+	if value := res.Get("encryptionAlgorithms"); value.Exists() {
+		data.EncryptionAlgorithms = helpers.GetStringList(value.Array())
+	} else {
+		data.EncryptionAlgorithms = helpers.StringListValue("AES-256")
+	}
+	if value := res.Get("integrityAlgorithms"); value.Exists() {
+		data.IntegrityAlgorithms = helpers.GetStringList(value.Array())
+	} else {
+		data.IntegrityAlgorithms = helpers.StringListValue("SHA256")
+	}
+	if value := res.Get("prfIntegrityAlgorithms"); value.Exists() {
+		data.PrfIntegrityAlgorithms = helpers.GetStringList(value.Array())
+	} else {
+		data.PrfIntegrityAlgorithms = helpers.StringListValue("SHA256")
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *IKEv2Policy) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("priority"); value.Exists() && !data.Priority.IsNull() {
+		data.Priority = types.Int64Value(value.Int())
+	} else {
+		data.Priority = types.Int64Null()
+	}
+	if value := res.Get("lifetimeInSeconds"); value.Exists() && !data.Lifetime.IsNull() {
+		data.Lifetime = types.Int64Value(value.Int())
+	} else if data.Lifetime.ValueInt64() != 86400 {
+		data.Lifetime = types.Int64Null()
+	}
+	if value := res.Get("encryptionAlgorithms"); value.Exists() && !data.EncryptionAlgorithms.IsNull() {
+		data.EncryptionAlgorithms = helpers.GetStringListInOrder(value.Array(), data.EncryptionAlgorithms)
+	} else if !data.EncryptionAlgorithms.Equal(helpers.StringListValue("AES-256")) {
+		data.EncryptionAlgorithms = types.ListNull(types.StringType)
+	}
+	if value := res.Get("integrityAlgorithms"); value.Exists() && !data.IntegrityAlgorithms.IsNull() {
+		data.IntegrityAlgorithms = helpers.GetStringListInOrder(value.Array(), data.IntegrityAlgorithms)
+	} else if !data.IntegrityAlgorithms.Equal(helpers.StringListValue("SHA256")) {
+		data.IntegrityAlgorithms = types.ListNull(types.StringType)
+	}
+	if value := res.Get("prfIntegrityAlgorithms"); value.Exists() && !data.PrfIntegrityAlgorithms.IsNull() {
+		data.PrfIntegrityAlgorithms = helpers.GetStringListInOrder(value.Array(), data.PrfIntegrityAlgorithms)
+	} else if !data.PrfIntegrityAlgorithms.Equal(helpers.StringListValue("SHA256")) {
+		data.PrfIntegrityAlgorithms = types.ListNull(types.StringType)
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *IKEv2Policy) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if !data.Priority.IsNull() {
+		return false
+	}
+	if !data.Lifetime.IsNull() {
+		return false
+	}
+	if !data.EncryptionAlgorithms.IsNull() {
+		return false
+	}
+	if !data.IntegrityAlgorithms.IsNull() {
+		return false
+	}
+	if !data.PrfIntegrityAlgorithms.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
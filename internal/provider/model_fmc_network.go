@@ -60,6 +60,8 @@ func (data Network) toBody(ctx context.Context, state Network) string {
 	}
 	if !data.Description.IsNull() {
 		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	} else if !state.Description.IsNull() {
+		body, _ = sjson.SetRaw(body, "description", "null")
 	}
 	if !data.Prefix.IsNull() {
 		body, _ = sjson.Set(body, "value", data.Prefix.ValueString())
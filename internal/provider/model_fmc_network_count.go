@@ -0,0 +1,93 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type NetworkCount struct {
+	Id         types.String `tfsdk:"id"`
+	Domain     types.String `tfsdk:"domain"`
+	TotalCount types.Int64  `tfsdk:"total_count"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data NetworkCount) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks?limit=1"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data NetworkCount) toBody(ctx context.Context, state NetworkCount) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.TotalCount.IsNull() {
+		body, _ = sjson.Set(body, "paging.count", data.TotalCount.ValueInt64())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *NetworkCount) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("paging.count"); value.Exists() {
+		data.TotalCount = types.Int64Value(value.Int())
+	} else {
+		data.TotalCount = types.Int64Null()
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *NetworkCount) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("paging.count"); value.Exists() && !data.TotalCount.IsNull() {
+		data.TotalCount = types.Int64Value(value.Int())
+	} else {
+		data.TotalCount = types.Int64Null()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *NetworkCount) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.TotalCount.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -0,0 +1,244 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type NetworkGroup struct {
+	Id          types.String          `tfsdk:"id"`
+	Domain      types.String          `tfsdk:"domain"`
+	Name        types.String          `tfsdk:"name"`
+	Description types.String          `tfsdk:"description"`
+	Overridable types.Bool            `tfsdk:"overridable"`
+	Objects     []NetworkGroupObjects `tfsdk:"objects"`
+}
+
+type NetworkGroupObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// GetName returns the value of name, or the zero value if it is null or unknown
+func (data NetworkGroup) GetName() string {
+	return data.Name.ValueString()
+}
+
+// GetDescription returns the value of description, or the zero value if it is null or unknown
+func (data NetworkGroup) GetDescription() string {
+	return data.Description.ValueString()
+}
+
+// GetOverridable returns the value of overridable, or the zero value if it is null or unknown
+func (data NetworkGroup) GetOverridable() bool {
+	return data.Overridable.ValueBool()
+}
+
+// GetObjects returns the value of objects, or the zero value if it is null or unknown
+func (data NetworkGroup) GetObjects() []NetworkGroupObjects {
+	return data.Objects
+}
+
+// GetId returns the value of id, or the zero value if it is null or unknown
+func (data NetworkGroupObjects) GetId() string {
+	return data.Id.ValueString()
+}
+
+// GetName returns the value of name, or the zero value if it is null or unknown
+func (data NetworkGroupObjects) GetName() string {
+	return data.Name.ValueString()
+}
+
+// GetType returns the value of type, or the zero value if it is null or unknown
+func (data NetworkGroupObjects) GetType() string {
+	return data.Type.ValueString()
+}
+
+//template:end types
+
+//template:begin getPath
+func (data NetworkGroup) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networkgroups"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data NetworkGroup) toBody(ctx context.Context, state NetworkGroup) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	if !data.Overridable.IsNull() {
+		body, _ = sjson.Set(body, "overridable", data.Overridable.ValueBool())
+	}
+	if len(data.Objects) > 0 {
+		body, _ = sjson.Set(body, "objects", []interface{}{})
+		for _, item := range data.Objects {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "objects.-1", itemBody)
+		}
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *NetworkGroup) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("overridable"); value.Exists() {
+		data.Overridable = types.BoolValue(value.Bool())
+	} else {
+		data.Overridable = types.BoolNull()
+	}
+	if value := res.Get("objects"); value.Exists() {
+		data.Objects = make([]NetworkGroupObjects, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := NetworkGroupObjects{}
+			if cValue := v.Get("id"); cValue.Exists() {
+				item.Id = types.StringValue(cValue.String())
+			} else {
+				item.Id = types.StringNull()
+			}
+			if cValue := v.Get("name"); cValue.Exists() {
+				item.Name = types.StringValue(cValue.String())
+			} else {
+				item.Name = types.StringNull()
+			}
+			if cValue := v.Get("type"); cValue.Exists() {
+				item.Type = types.StringValue(cValue.String())
+			} else {
+				item.Type = types.StringNull()
+			}
+			data.Objects = append(data.Objects, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *NetworkGroup) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("overridable"); value.Exists() && !data.Overridable.IsNull() {
+		data.Overridable = types.BoolValue(value.Bool())
+	} else {
+		data.Overridable = types.BoolNull()
+	}
+	for i := range data.Objects {
+		keys := [...]string{"id"}
+		keyValues := [...]string{data.Objects[i].Id.ValueString()}
+
+		var r gjson.Result
+		res.Get("objects").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("id"); value.Exists() && !data.Objects[i].Id.IsNull() {
+			data.Objects[i].Id = types.StringValue(value.String())
+		} else {
+			data.Objects[i].Id = types.StringNull()
+		}
+		if value := r.Get("name"); value.Exists() {
+			data.Objects[i].Name = types.StringValue(value.String())
+		} else {
+			data.Objects[i].Name = types.StringNull()
+		}
+		if value := r.Get("type"); value.Exists() {
+			data.Objects[i].Type = types.StringValue(value.String())
+		} else {
+			data.Objects[i].Type = types.StringNull()
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *NetworkGroup) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if !data.Overridable.IsNull() {
+		return false
+	}
+	if len(data.Objects) > 0 {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -0,0 +1,102 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type NetworkOverride struct {
+	Id        types.String `tfsdk:"id"`
+	Domain    types.String `tfsdk:"domain"`
+	NetworkId types.String `tfsdk:"network_id"`
+	TargetId  types.String `tfsdk:"target_id"`
+	Prefix    types.String `tfsdk:"prefix"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data NetworkOverride) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks/%v?overrideTargetId=%v", data.NetworkId.ValueString(), data.TargetId.ValueString())
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data NetworkOverride) toBody(ctx context.Context, state NetworkOverride) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Prefix.IsNull() {
+		body, _ = sjson.Set(body, "value", data.Prefix.ValueString())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *NetworkOverride) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("value"); value.Exists() {
+		data.Prefix = types.StringValue(value.String())
+	} else {
+		data.Prefix = types.StringNull()
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *NetworkOverride) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("value"); value.Exists() && !data.Prefix.IsNull() {
+		data.Prefix = types.StringValue(value.String())
+	} else {
+		data.Prefix = types.StringNull()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *NetworkOverride) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.NetworkId.IsNull() {
+		return false
+	}
+	if !data.TargetId.IsNull() {
+		return false
+	}
+	if !data.Prefix.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
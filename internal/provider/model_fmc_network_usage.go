@@ -0,0 +1,169 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type NetworkUsage struct {
+	Id        types.String          `tfsdk:"id"`
+	Domain    types.String          `tfsdk:"domain"`
+	NetworkId types.String          `tfsdk:"network_id"`
+	Objects   []NetworkUsageObjects `tfsdk:"objects"`
+}
+
+type NetworkUsageObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data NetworkUsage) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/operational/usage?filter=uuid:%v;type:Network", data.NetworkId.ValueString())
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data NetworkUsage) toBody(ctx context.Context, state NetworkUsage) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if len(data.Objects) > 0 {
+		body, _ = sjson.Set(body, "items", []interface{}{})
+		for _, item := range data.Objects {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.Type.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "type", item.Type.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "items.-1", itemBody)
+		}
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *NetworkUsage) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("items"); value.Exists() {
+		data.Objects = make([]NetworkUsageObjects, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := NetworkUsageObjects{}
+			if cValue := v.Get("id"); cValue.Exists() {
+				item.Id = types.StringValue(cValue.String())
+			} else {
+				item.Id = types.StringNull()
+			}
+			if cValue := v.Get("name"); cValue.Exists() {
+				item.Name = types.StringValue(cValue.String())
+			} else {
+				item.Name = types.StringNull()
+			}
+			if cValue := v.Get("type"); cValue.Exists() {
+				item.Type = types.StringValue(cValue.String())
+			} else {
+				item.Type = types.StringNull()
+			}
+			data.Objects = append(data.Objects, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *NetworkUsage) updateFromBody(ctx context.Context, res gjson.Result) {
+	for i := range data.Objects {
+		keys := [...]string{"id", "name", "type"}
+		keyValues := [...]string{data.Objects[i].Id.ValueString(), data.Objects[i].Name.ValueString(), data.Objects[i].Type.ValueString()}
+
+		var r gjson.Result
+		res.Get("items").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("id"); value.Exists() && !data.Objects[i].Id.IsNull() {
+			data.Objects[i].Id = types.StringValue(value.String())
+		} else {
+			data.Objects[i].Id = types.StringNull()
+		}
+		if value := r.Get("name"); value.Exists() && !data.Objects[i].Name.IsNull() {
+			data.Objects[i].Name = types.StringValue(value.String())
+		} else {
+			data.Objects[i].Name = types.StringNull()
+		}
+		if value := r.Get("type"); value.Exists() && !data.Objects[i].Type.IsNull() {
+			data.Objects[i].Type = types.StringValue(value.String())
+		} else {
+			data.Objects[i].Type = types.StringNull()
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *NetworkUsage) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.NetworkId.IsNull() {
+		return false
+	}
+	if len(data.Objects) > 0 {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
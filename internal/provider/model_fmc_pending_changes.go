@@ -0,0 +1,179 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type PendingChanges struct {
+	Id      types.String            `tfsdk:"id"`
+	Domain  types.String            `tfsdk:"domain"`
+	Devices []PendingChangesDevices `tfsdk:"devices"`
+}
+
+type PendingChangesDevices struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Version       types.String `tfsdk:"version"`
+	CanBeDeployed types.Bool   `tfsdk:"can_be_deployed"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data PendingChanges) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/deployment/deployabledevices?expanded=true&limit=1000"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data PendingChanges) toBody(ctx context.Context, state PendingChanges) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if len(data.Devices) > 0 {
+		body, _ = sjson.Set(body, "items", []interface{}{})
+		for _, item := range data.Devices {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "device.id", item.Id.ValueString())
+			}
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.Version.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "version", item.Version.ValueString())
+			}
+			if !item.CanBeDeployed.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "canBeDeployed", item.CanBeDeployed.ValueBool())
+			}
+			body, _ = sjson.SetRaw(body, "items.-1", itemBody)
+		}
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *PendingChanges) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("items"); value.Exists() {
+		data.Devices = make([]PendingChangesDevices, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := PendingChangesDevices{}
+			if cValue := v.Get("device.id"); cValue.Exists() {
+				item.Id = types.StringValue(cValue.String())
+			} else {
+				item.Id = types.StringNull()
+			}
+			if cValue := v.Get("name"); cValue.Exists() {
+				item.Name = types.StringValue(cValue.String())
+			} else {
+				item.Name = types.StringNull()
+			}
+			if cValue := v.Get("version"); cValue.Exists() {
+				item.Version = types.StringValue(cValue.String())
+			} else {
+				item.Version = types.StringNull()
+			}
+			if cValue := v.Get("canBeDeployed"); cValue.Exists() {
+				item.CanBeDeployed = types.BoolValue(cValue.Bool())
+			} else {
+				item.CanBeDeployed = types.BoolNull()
+			}
+			data.Devices = append(data.Devices, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *PendingChanges) updateFromBody(ctx context.Context, res gjson.Result) {
+	for i := range data.Devices {
+		keys := [...]string{"device.id", "name", "version", "canBeDeployed"}
+		keyValues := [...]string{data.Devices[i].Id.ValueString(), data.Devices[i].Name.ValueString(), data.Devices[i].Version.ValueString(), strconv.FormatBool(data.Devices[i].CanBeDeployed.ValueBool())}
+
+		var r gjson.Result
+		res.Get("items").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("device.id"); value.Exists() && !data.Devices[i].Id.IsNull() {
+			data.Devices[i].Id = types.StringValue(value.String())
+		} else {
+			data.Devices[i].Id = types.StringNull()
+		}
+		if value := r.Get("name"); value.Exists() && !data.Devices[i].Name.IsNull() {
+			data.Devices[i].Name = types.StringValue(value.String())
+		} else {
+			data.Devices[i].Name = types.StringNull()
+		}
+		if value := r.Get("version"); value.Exists() && !data.Devices[i].Version.IsNull() {
+			data.Devices[i].Version = types.StringValue(value.String())
+		} else {
+			data.Devices[i].Version = types.StringNull()
+		}
+		if value := r.Get("canBeDeployed"); value.Exists() && !data.Devices[i].CanBeDeployed.IsNull() {
+			data.Devices[i].CanBeDeployed = types.BoolValue(value.Bool())
+		} else {
+			data.Devices[i].CanBeDeployed = types.BoolNull()
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *PendingChanges) isNull(ctx context.Context, res gjson.Result) bool {
+	if len(data.Devices) > 0 {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -0,0 +1,179 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type PrefilterPolicy struct {
+	Id                           types.String `tfsdk:"id"`
+	Domain                       types.String `tfsdk:"domain"`
+	Name                         types.String `tfsdk:"name"`
+	Description                  types.String `tfsdk:"description"`
+	DefaultAction                types.String `tfsdk:"default_action"`
+	DefaultActionId              types.String `tfsdk:"default_action_id"`
+	DefaultActionLogBegin        types.Bool   `tfsdk:"default_action_log_begin"`
+	DefaultActionSendEventsToFmc types.Bool   `tfsdk:"default_action_send_events_to_fmc"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data PrefilterPolicy) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/prefilterpolicies"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data PrefilterPolicy) toBody(ctx context.Context, state PrefilterPolicy) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "PrefilterPolicy")
+	if !data.DefaultAction.IsNull() {
+		body, _ = sjson.Set(body, "defaultAction.action", data.DefaultAction.ValueString())
+	}
+	if state.DefaultActionId.ValueString() != "" {
+		body, _ = sjson.Set(body, "defaultAction.id", state.DefaultActionId.ValueString())
+	}
+	if !data.DefaultActionLogBegin.IsNull() {
+		body, _ = sjson.Set(body, "defaultAction.logBegin", data.DefaultActionLogBegin.ValueBool())
+	}
+	if !data.DefaultActionSendEventsToFmc.IsNull() {
+		body, _ = sjson.Set(body, "defaultAction.sendEventsToFMC", data.DefaultActionSendEventsToFmc.ValueBool())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *PrefilterPolicy) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("defaultAction.action"); value.Exists() {
+		data.DefaultAction = types.StringValue(value.String())
+	} else {
+		data.DefaultAction = types.StringNull()
+	}
+	if value := res.Get("defaultAction.id"); value.Exists() {
+		data.DefaultActionId = types.StringValue(value.String())
+	} else {
+		data.DefaultActionId = types.StringNull()
+	}
+	if value := res.Get("defaultAction.logBegin"); value.Exists() {
+		data.DefaultActionLogBegin = types.BoolValue(value.Bool())
+	} else {
+		data.DefaultActionLogBegin = types.BoolValue(false)
+	}
+	if value := res.Get("defaultAction.sendEventsToFMC"); value.Exists() {
+		data.DefaultActionSendEventsToFmc = types.BoolValue(value.Bool())
+	} else {
+		data.DefaultActionSendEventsToFmc = types.BoolValue(false)
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *PrefilterPolicy) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("defaultAction.action"); value.Exists() && !data.DefaultAction.IsNull() {
+		data.DefaultAction = types.StringValue(value.String())
+	} else {
+		data.DefaultAction = types.StringNull()
+	}
+	if value := res.Get("defaultAction.id"); value.Exists() {
+		data.DefaultActionId = types.StringValue(value.String())
+	} else {
+		data.DefaultActionId = types.StringNull()
+	}
+	if value := res.Get("defaultAction.logBegin"); value.Exists() && !data.DefaultActionLogBegin.IsNull() {
+		data.DefaultActionLogBegin = types.BoolValue(value.Bool())
+	} else if data.DefaultActionLogBegin.ValueBool() != false {
+		data.DefaultActionLogBegin = types.BoolNull()
+	}
+	if value := res.Get("defaultAction.sendEventsToFMC"); value.Exists() && !data.DefaultActionSendEventsToFmc.IsNull() {
+		data.DefaultActionSendEventsToFmc = types.BoolValue(value.Bool())
+	} else if data.DefaultActionSendEventsToFmc.ValueBool() != false {
+		data.DefaultActionSendEventsToFmc = types.BoolNull()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *PrefilterPolicy) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if !data.DefaultAction.IsNull() {
+		return false
+	}
+	if !data.DefaultActionId.IsNull() {
+		return false
+	}
+	if !data.DefaultActionLogBegin.IsNull() {
+		return false
+	}
+	if !data.DefaultActionSendEventsToFmc.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
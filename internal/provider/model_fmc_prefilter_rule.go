@@ -0,0 +1,400 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type PrefilterRule struct {
+	Id                        types.String                             `tfsdk:"id"`
+	Domain                    types.String                             `tfsdk:"domain"`
+	PrefilterPolicyId         types.String                             `tfsdk:"prefilter_policy_id"`
+	Name                      types.String                             `tfsdk:"name"`
+	RuleType                  types.String                             `tfsdk:"rule_type"`
+	Action                    types.String                             `tfsdk:"action"`
+	Enabled                   types.Bool                               `tfsdk:"enabled"`
+	LogBegin                  types.Bool                               `tfsdk:"log_begin"`
+	LogEnd                    types.Bool                               `tfsdk:"log_end"`
+	SendEventsToFmc           types.Bool                               `tfsdk:"send_events_to_fmc"`
+	SourceNetworkObjects      []PrefilterRuleSourceNetworkObjects      `tfsdk:"source_network_objects"`
+	DestinationNetworkObjects []PrefilterRuleDestinationNetworkObjects `tfsdk:"destination_network_objects"`
+	InsertBefore              types.Int64                              `tfsdk:"insert_before"`
+}
+
+type PrefilterRuleSourceNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+type PrefilterRuleDestinationNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+// PrefilterRuleRuleType is a value of rule_type
+type PrefilterRuleRuleType string
+
+// Values returns the valid values of rule_type
+func (PrefilterRuleRuleType) Values() []string {
+	return []string{"PREFILTER", "TUNNEL"}
+}
+
+// IsValid returns true if the value is one of the valid values of rule_type
+func (v PrefilterRuleRuleType) IsValid() bool {
+	for _, value := range v.Values() {
+		if string(v) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// PrefilterRuleAction is a value of action
+type PrefilterRuleAction string
+
+// Values returns the valid values of action
+func (PrefilterRuleAction) Values() []string {
+	return []string{"FASTPATH", "ANALYZE", "BLOCK"}
+}
+
+// IsValid returns true if the value is one of the valid values of action
+func (v PrefilterRuleAction) IsValid() bool {
+	for _, value := range v.Values() {
+		if string(v) == value {
+			return true
+		}
+	}
+	return false
+}
+
+//template:end types
+
+//template:begin getPath
+func (data PrefilterRule) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/prefilterpolicies/%v/prefilterrules", data.PrefilterPolicyId.ValueString())
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data PrefilterRule) toBody(ctx context.Context, state PrefilterRule) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "PrefilterRule")
+	if !data.RuleType.IsNull() {
+		body, _ = sjson.Set(body, "ruleType", data.RuleType.ValueString())
+	}
+	if !data.Action.IsNull() {
+		body, _ = sjson.Set(body, "action", data.Action.ValueString())
+	}
+	if !data.Enabled.IsNull() {
+		body, _ = sjson.Set(body, "enabled", data.Enabled.ValueBool())
+	}
+	if !data.LogBegin.IsNull() {
+		body, _ = sjson.Set(body, "logBegin", data.LogBegin.ValueBool())
+	}
+	if !data.LogEnd.IsNull() {
+		body, _ = sjson.Set(body, "logEnd", data.LogEnd.ValueBool())
+	}
+	if !data.SendEventsToFmc.IsNull() {
+		body, _ = sjson.Set(body, "sendEventsToFMC", data.SendEventsToFmc.ValueBool())
+	}
+	if len(data.SourceNetworkObjects) > 0 {
+		body, _ = sjson.Set(body, "sourceNetworks.objects", []interface{}{})
+		for _, item := range data.SourceNetworkObjects {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Type.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "type", item.Type.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "sourceNetworks.objects.-1", itemBody)
+		}
+	}
+	if len(data.DestinationNetworkObjects) > 0 {
+		body, _ = sjson.Set(body, "destinationNetworks.objects", []interface{}{})
+		for _, item := range data.DestinationNetworkObjects {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Type.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "type", item.Type.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "destinationNetworks.objects.-1", itemBody)
+		}
+	}
+	return body
+}
+
+// setQueryParameters adds the attributes which are sent as query parameters to the create request
+func (data PrefilterRule) setQueryParameters(req *fmc.Req) {
+	query := req.HttpReq.URL.Query()
+	if !data.InsertBefore.IsNull() {
+		query.Set("insertBefore", strconv.FormatInt(data.InsertBefore.ValueInt64(), 10))
+	}
+	req.HttpReq.URL.RawQuery = query.Encode()
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *PrefilterRule) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("ruleType"); value.Exists() {
+		data.RuleType = types.StringValue(value.String())
+	} else {
+		data.RuleType = types.StringValue("PREFILTER")
+	}
+	if value := res.Get("action"); value.Exists() {
+		data.Action = types.StringValue(value.String())
+	} else {
+		data.Action = types.StringNull()
+	}
+	if value := res.Get("enabled"); value.Exists() {
+		data.Enabled = types.BoolValue(value.Bool())
+	} else {
+		data.Enabled = types.BoolValue(true)
+	}
+	if value := res.Get("logBegin"); value.Exists() {
+		data.LogBegin = types.BoolValue(value.Bool())
+	} else {
+		data.LogBegin = types.BoolValue(false)
+	}
+	if value := res.Get("logEnd"); value.Exists() {
+		data.LogEnd = types.BoolValue(value.Bool())
+	} else {
+		data.LogEnd = types.BoolValue(false)
+	}
+	if value := res.Get("sendEventsToFMC"); value.Exists() {
+		data.SendEventsToFmc = types.BoolValue(value.Bool())
+	} else {
+		data.SendEventsToFmc = types.BoolValue(false)
+	}
+	if value := res.Get("sourceNetworks.objects"); value.Exists() {
+		data.SourceNetworkObjects = make([]PrefilterRuleSourceNetworkObjects, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := PrefilterRuleSourceNetworkObjects{}
+			if cValue := v.Get("id"); cValue.Exists() {
+				item.Id = types.StringValue(cValue.String())
+			} else {
+				item.Id = types.StringNull()
+			}
+			if cValue := v.Get("type"); cValue.Exists() {
+				item.Type = types.StringValue(cValue.String())
+			} else {
+				item.Type = types.StringNull()
+			}
+			data.SourceNetworkObjects = append(data.SourceNetworkObjects, item)
+			return true
+		})
+	}
+	if value := res.Get("destinationNetworks.objects"); value.Exists() {
+		data.DestinationNetworkObjects = make([]PrefilterRuleDestinationNetworkObjects, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := PrefilterRuleDestinationNetworkObjects{}
+			if cValue := v.Get("id"); cValue.Exists() {
+				item.Id = types.StringValue(cValue.String())
+			} else {
+				item.Id = types.StringNull()
+			}
+			if cValue := v.Get("type"); cValue.Exists() {
+				item.Type = types.StringValue(cValue.String())
+			} else {
+				item.Type = types.StringNull()
+			}
+			data.DestinationNetworkObjects = append(data.DestinationNetworkObjects, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *PrefilterRule) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("ruleType"); value.Exists() && !data.RuleType.IsNull() {
+		data.RuleType = types.StringValue(value.String())
+	} else if data.RuleType.ValueString() != "PREFILTER" {
+		data.RuleType = types.StringNull()
+	}
+	if value := res.Get("action"); value.Exists() && !data.Action.IsNull() {
+		data.Action = types.StringValue(value.String())
+	} else {
+		data.Action = types.StringNull()
+	}
+	if value := res.Get("enabled"); value.Exists() && !data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(value.Bool())
+	} else if data.Enabled.ValueBool() != true {
+		data.Enabled = types.BoolNull()
+	}
+	if value := res.Get("logBegin"); value.Exists() && !data.LogBegin.IsNull() {
+		data.LogBegin = types.BoolValue(value.Bool())
+	} else if data.LogBegin.ValueBool() != false {
+		data.LogBegin = types.BoolNull()
+	}
+	if value := res.Get("logEnd"); value.Exists() && !data.LogEnd.IsNull() {
+		data.LogEnd = types.BoolValue(value.Bool())
+	} else if data.LogEnd.ValueBool() != false {
+		data.LogEnd = types.BoolNull()
+	}
+	if value := res.Get("sendEventsToFMC"); value.Exists() && !data.SendEventsToFmc.IsNull() {
+		data.SendEventsToFmc = types.BoolValue(value.Bool())
+	} else if data.SendEventsToFmc.ValueBool() != false {
+		data.SendEventsToFmc = types.BoolNull()
+	}
+	for i := range data.SourceNetworkObjects {
+		keys := [...]string{"id"}
+		keyValues := [...]string{data.SourceNetworkObjects[i].Id.ValueString()}
+
+		var r gjson.Result
+		res.Get("sourceNetworks.objects").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("id"); value.Exists() && !data.SourceNetworkObjects[i].Id.IsNull() {
+			data.SourceNetworkObjects[i].Id = types.StringValue(value.String())
+		} else {
+			data.SourceNetworkObjects[i].Id = types.StringNull()
+		}
+		if value := r.Get("type"); value.Exists() && !data.SourceNetworkObjects[i].Type.IsNull() {
+			data.SourceNetworkObjects[i].Type = types.StringValue(value.String())
+		} else {
+			data.SourceNetworkObjects[i].Type = types.StringNull()
+		}
+	}
+	for i := range data.DestinationNetworkObjects {
+		keys := [...]string{"id"}
+		keyValues := [...]string{data.DestinationNetworkObjects[i].Id.ValueString()}
+
+		var r gjson.Result
+		res.Get("destinationNetworks.objects").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("id"); value.Exists() && !data.DestinationNetworkObjects[i].Id.IsNull() {
+			data.DestinationNetworkObjects[i].Id = types.StringValue(value.String())
+		} else {
+			data.DestinationNetworkObjects[i].Id = types.StringNull()
+		}
+		if value := r.Get("type"); value.Exists() && !data.DestinationNetworkObjects[i].Type.IsNull() {
+			data.DestinationNetworkObjects[i].Type = types.StringValue(value.String())
+		} else {
+			data.DestinationNetworkObjects[i].Type = types.StringNull()
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *PrefilterRule) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.PrefilterPolicyId.IsNull() {
+		return false
+	}
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.RuleType.IsNull() {
+		return false
+	}
+	if !data.Action.IsNull() {
+		return false
+	}
+	if !data.Enabled.IsNull() {
+		return false
+	}
+	if !data.LogBegin.IsNull() {
+		return false
+	}
+	if !data.LogEnd.IsNull() {
+		return false
+	}
+	if !data.SendEventsToFmc.IsNull() {
+		return false
+	}
+	if len(data.SourceNetworkObjects) > 0 {
+		return false
+	}
+	if len(data.DestinationNetworkObjects) > 0 {
+		return false
+	}
+	if !data.InsertBefore.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -0,0 +1,267 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type ScheduledTask struct {
+	Id                   types.String `tfsdk:"id"`
+	Domain               types.String `tfsdk:"domain"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	JobType              types.String `tfsdk:"job_type"`
+	RecurrenceFrequency  types.String `tfsdk:"recurrence_frequency"`
+	RecurrenceInterval   types.Int64  `tfsdk:"recurrence_interval"`
+	RecurrenceStartTime  types.String `tfsdk:"recurrence_start_time"`
+	RecurrenceWeekdays   types.List   `tfsdk:"recurrence_weekdays"`
+	RecurrenceDayOfMonth types.Int64  `tfsdk:"recurrence_day_of_month"`
+}
+
+// GetName returns the value of name, or the zero value if it is null or unknown
+func (data ScheduledTask) GetName() string {
+	return data.Name.ValueString()
+}
+
+// GetDescription returns the value of description, or the zero value if it is null or unknown
+func (data ScheduledTask) GetDescription() string {
+	return data.Description.ValueString()
+}
+
+// GetJobType returns the value of job_type, or the zero value if it is null or unknown
+func (data ScheduledTask) GetJobType() string {
+	return data.JobType.ValueString()
+}
+
+// GetRecurrenceFrequency returns the value of recurrence_frequency, or the zero value if it is null or unknown
+func (data ScheduledTask) GetRecurrenceFrequency() string {
+	return data.RecurrenceFrequency.ValueString()
+}
+
+// GetRecurrenceInterval returns the value of recurrence_interval, or the zero value if it is null or unknown
+func (data ScheduledTask) GetRecurrenceInterval() int64 {
+	return data.RecurrenceInterval.ValueInt64()
+}
+
+// GetRecurrenceStartTime returns the value of recurrence_start_time, or the zero value if it is null or unknown
+func (data ScheduledTask) GetRecurrenceStartTime() string {
+	return data.RecurrenceStartTime.ValueString()
+}
+
+// GetRecurrenceWeekdays returns the value of recurrence_weekdays, or the zero value if it is null or unknown
+func (data ScheduledTask) GetRecurrenceWeekdays() []string {
+	if data.RecurrenceWeekdays.IsNull() || data.RecurrenceWeekdays.IsUnknown() {
+		return nil
+	}
+	var values []string
+	data.RecurrenceWeekdays.ElementsAs(context.Background(), &values, false)
+	return values
+}
+
+// GetRecurrenceDayOfMonth returns the value of recurrence_day_of_month, or the zero value if it is null or unknown
+func (data ScheduledTask) GetRecurrenceDayOfMonth() int64 {
+	return data.RecurrenceDayOfMonth.ValueInt64()
+}
+
+//template:end types
+
+//template:begin getPath
+func (data ScheduledTask) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/job/scheduledtasks"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data ScheduledTask) toBody(ctx context.Context, state ScheduledTask) string {
+	body := ""
+	if !helpers.Contains([]string{"WEEKLY"}, data.RecurrenceFrequency.ValueString()) {
+		data.RecurrenceWeekdays = types.ListNull(types.StringType)
+	}
+	if !helpers.Contains([]string{"MONTHLY"}, data.RecurrenceFrequency.ValueString()) {
+		data.RecurrenceDayOfMonth = types.Int64Null()
+	}
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "ScheduledTask")
+	if !data.JobType.IsNull() {
+		body, _ = sjson.Set(body, "jobType", data.JobType.ValueString())
+	}
+	if !data.RecurrenceFrequency.IsNull() {
+		body, _ = sjson.Set(body, "recurrence.frequency", data.RecurrenceFrequency.ValueString())
+	}
+	if !data.RecurrenceInterval.IsNull() {
+		body, _ = sjson.Set(body, "recurrence.interval", data.RecurrenceInterval.ValueInt64())
+	}
+	if !data.RecurrenceStartTime.IsNull() {
+		body, _ = sjson.Set(body, "recurrence.startTime", data.RecurrenceStartTime.ValueString())
+	}
+	if !data.RecurrenceWeekdays.IsNull() {
+		var values []string
+		data.RecurrenceWeekdays.ElementsAs(ctx, &values, false)
+		body, _ = sjson.Set(body, "recurrence.days", values)
+	}
+	if !data.RecurrenceDayOfMonth.IsNull() {
+		body, _ = sjson.Set(body, "recurrence.dayOfMonth", data.RecurrenceDayOfMonth.ValueInt64())
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *ScheduledTask) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("jobType"); value.Exists() {
+		data.JobType = types.StringValue(value.String())
+	} else {
+		data.JobType = types.StringNull()
+	}
+	if value := res.Get("recurrence.frequency"); value.Exists() {
+		data.RecurrenceFrequency = types.StringValue(value.String())
+	} else {
+		data.RecurrenceFrequency = types.StringNull()
+	}
+	if value := res.Get("recurrence.interval"); value.Exists() {
+		data.RecurrenceInterval = types.Int64Value(value.Int())
+	} else {
+		data.RecurrenceInterval = types.Int64Value(1)
+	}
+	if value := res.Get("recurrence.startTime"); value.Exists() {
+		data.RecurrenceStartTime = types.StringValue(value.String())
+	} else {
+		data.RecurrenceStartTime = types.StringNull()
+	}
+	if value := res.Get("recurrence.days"); value.Exists() {
+		data.RecurrenceWeekdays = helpers.GetStringList(value.Array())
+	} else {
+		data.RecurrenceWeekdays = types.ListNull(types.StringType)
+	}
+	if value := res.Get("recurrence.dayOfMonth"); value.Exists() {
+		data.RecurrenceDayOfMonth = types.Int64Value(value.Int())
+	} else {
+		data.RecurrenceDayOfMonth = types.Int64Null()
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *ScheduledTask) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("jobType"); value.Exists() && !data.JobType.IsNull() {
+		data.JobType = types.StringValue(value.String())
+	} else {
+		data.JobType = types.StringNull()
+	}
+	if value := res.Get("recurrence.frequency"); value.Exists() && !data.RecurrenceFrequency.IsNull() {
+		data.RecurrenceFrequency = types.StringValue(value.String())
+	} else {
+		data.RecurrenceFrequency = types.StringNull()
+	}
+	if value := res.Get("recurrence.interval"); value.Exists() && !data.RecurrenceInterval.IsNull() {
+		data.RecurrenceInterval = types.Int64Value(value.Int())
+	} else if data.RecurrenceInterval.ValueInt64() != 1 {
+		data.RecurrenceInterval = types.Int64Null()
+	}
+	if value := res.Get("recurrence.startTime"); value.Exists() && !data.RecurrenceStartTime.IsNull() {
+		data.RecurrenceStartTime = types.StringValue(value.String())
+	} else {
+		data.RecurrenceStartTime = types.StringNull()
+	}
+	if value := res.Get("recurrence.days"); value.Exists() && !data.RecurrenceWeekdays.IsNull() {
+		data.RecurrenceWeekdays = helpers.GetStringListInOrder(value.Array(), data.RecurrenceWeekdays)
+	} else {
+		data.RecurrenceWeekdays = types.ListNull(types.StringType)
+	}
+	if value := res.Get("recurrence.dayOfMonth"); value.Exists() && !data.RecurrenceDayOfMonth.IsNull() {
+		data.RecurrenceDayOfMonth = types.Int64Value(value.Int())
+	} else {
+		data.RecurrenceDayOfMonth = types.Int64Null()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *ScheduledTask) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if !data.JobType.IsNull() {
+		return false
+	}
+	if !data.RecurrenceFrequency.IsNull() {
+		return false
+	}
+	if !data.RecurrenceInterval.IsNull() {
+		return false
+	}
+	if !data.RecurrenceStartTime.IsNull() {
+		return false
+	}
+	if !data.RecurrenceWeekdays.IsNull() {
+		return false
+	}
+	if !data.RecurrenceDayOfMonth.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
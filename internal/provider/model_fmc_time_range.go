@@ -0,0 +1,306 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type TimeRange struct {
+	Id            types.String           `tfsdk:"id"`
+	Domain        types.String           `tfsdk:"domain"`
+	Name          types.String           `tfsdk:"name"`
+	Description   types.String           `tfsdk:"description"`
+	StartDateTime types.String           `tfsdk:"start_date_time"`
+	EndDateTime   types.String           `tfsdk:"end_date_time"`
+	Recurrences   []TimeRangeRecurrences `tfsdk:"recurrences"`
+}
+
+type TimeRangeRecurrences struct {
+	RecurrenceType types.String `tfsdk:"recurrence_type"`
+	DailyStartTime types.String `tfsdk:"daily_start_time"`
+	DailyEndTime   types.String `tfsdk:"daily_end_time"`
+	DailyDays      types.List   `tfsdk:"daily_days"`
+	RangeStartDay  types.String `tfsdk:"range_start_day"`
+	RangeStartTime types.String `tfsdk:"range_start_time"`
+	RangeEndDay    types.String `tfsdk:"range_end_day"`
+	RangeEndTime   types.String `tfsdk:"range_end_time"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data TimeRange) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/timeranges"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data TimeRange) toBody(ctx context.Context, state TimeRange) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "TimeRange")
+	if !data.StartDateTime.IsNull() {
+		body, _ = sjson.Set(body, "effectiveStartDateTime", data.StartDateTime.ValueString())
+	}
+	if !data.EndDateTime.IsNull() {
+		body, _ = sjson.Set(body, "effectiveEndDateTime", data.EndDateTime.ValueString())
+	}
+	if len(data.Recurrences) > 0 {
+		body, _ = sjson.Set(body, "recurrenceList", []interface{}{})
+		for _, item := range data.Recurrences {
+			itemBody := ""
+			if !item.RecurrenceType.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "recurrenceType", item.RecurrenceType.ValueString())
+			}
+			if !item.DailyStartTime.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "dailyStartTime", item.DailyStartTime.ValueString())
+			}
+			if !item.DailyEndTime.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "dailyEndTime", item.DailyEndTime.ValueString())
+			}
+			if !item.DailyDays.IsNull() {
+				var values []string
+				item.DailyDays.ElementsAs(ctx, &values, false)
+				itemBody, _ = sjson.Set(itemBody, "days", values)
+			}
+			if !item.RangeStartDay.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "rangeStartDay", item.RangeStartDay.ValueString())
+			}
+			if !item.RangeStartTime.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "rangeStartTime", item.RangeStartTime.ValueString())
+			}
+			if !item.RangeEndDay.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "rangeEndDay", item.RangeEndDay.ValueString())
+			}
+			if !item.RangeEndTime.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "rangeEndTime", item.RangeEndTime.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "recurrenceList.-1", itemBody)
+		}
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *TimeRange) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("effectiveStartDateTime"); value.Exists() {
+		data.StartDateTime = types.StringValue(value.String())
+	} else {
+		data.StartDateTime = types.StringNull()
+	}
+	if value := res.Get("effectiveEndDateTime"); value.Exists() {
+		data.EndDateTime = types.StringValue(value.String())
+	} else {
+		data.EndDateTime = types.StringNull()
+	}
+	if value := res.Get("recurrenceList"); value.Exists() {
+		data.Recurrences = make([]TimeRangeRecurrences, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := TimeRangeRecurrences{}
+			if cValue := v.Get("recurrenceType"); cValue.Exists() {
+				item.RecurrenceType = types.StringValue(cValue.String())
+			} else {
+				item.RecurrenceType = types.StringNull()
+			}
+			if cValue := v.Get("dailyStartTime"); cValue.Exists() {
+				item.DailyStartTime = types.StringValue(cValue.String())
+			} else {
+				item.DailyStartTime = types.StringNull()
+			}
+			if cValue := v.Get("dailyEndTime"); cValue.Exists() {
+				item.DailyEndTime = types.StringValue(cValue.String())
+			} else {
+				item.DailyEndTime = types.StringNull()
+			}
+			if cValue := v.Get("days"); cValue.Exists() {
+				item.DailyDays = helpers.GetStringList(cValue.Array())
+			} else {
+				item.DailyDays = types.ListNull(types.StringType)
+			}
+			if cValue := v.Get("rangeStartDay"); cValue.Exists() {
+				item.RangeStartDay = types.StringValue(cValue.String())
+			} else {
+				item.RangeStartDay = types.StringNull()
+			}
+			if cValue := v.Get("rangeStartTime"); cValue.Exists() {
+				item.RangeStartTime = types.StringValue(cValue.String())
+			} else {
+				item.RangeStartTime = types.StringNull()
+			}
+			if cValue := v.Get("rangeEndDay"); cValue.Exists() {
+				item.RangeEndDay = types.StringValue(cValue.String())
+			} else {
+				item.RangeEndDay = types.StringNull()
+			}
+			if cValue := v.Get("rangeEndTime"); cValue.Exists() {
+				item.RangeEndTime = types.StringValue(cValue.String())
+			} else {
+				item.RangeEndTime = types.StringNull()
+			}
+			data.Recurrences = append(data.Recurrences, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *TimeRange) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("effectiveStartDateTime"); value.Exists() && !data.StartDateTime.IsNull() {
+		data.StartDateTime = types.StringValue(value.String())
+	} else {
+		data.StartDateTime = types.StringNull()
+	}
+	if value := res.Get("effectiveEndDateTime"); value.Exists() && !data.EndDateTime.IsNull() {
+		data.EndDateTime = types.StringValue(value.String())
+	} else {
+		data.EndDateTime = types.StringNull()
+	}
+	for i := range data.Recurrences {
+		keys := [...]string{"recurrenceType", "dailyStartTime", "dailyEndTime", "rangeStartDay", "rangeStartTime", "rangeEndDay", "rangeEndTime"}
+		keyValues := [...]string{data.Recurrences[i].RecurrenceType.ValueString(), data.Recurrences[i].DailyStartTime.ValueString(), data.Recurrences[i].DailyEndTime.ValueString(), data.Recurrences[i].RangeStartDay.ValueString(), data.Recurrences[i].RangeStartTime.ValueString(), data.Recurrences[i].RangeEndDay.ValueString(), data.Recurrences[i].RangeEndTime.ValueString()}
+
+		var r gjson.Result
+		res.Get("recurrenceList").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("recurrenceType"); value.Exists() && !data.Recurrences[i].RecurrenceType.IsNull() {
+			data.Recurrences[i].RecurrenceType = types.StringValue(value.String())
+		} else {
+			data.Recurrences[i].RecurrenceType = types.StringNull()
+		}
+		if value := r.Get("dailyStartTime"); value.Exists() && !data.Recurrences[i].DailyStartTime.IsNull() {
+			data.Recurrences[i].DailyStartTime = types.StringValue(value.String())
+		} else {
+			data.Recurrences[i].DailyStartTime = types.StringNull()
+		}
+		if value := r.Get("dailyEndTime"); value.Exists() && !data.Recurrences[i].DailyEndTime.IsNull() {
+			data.Recurrences[i].DailyEndTime = types.StringValue(value.String())
+		} else {
+			data.Recurrences[i].DailyEndTime = types.StringNull()
+		}
+		if value := r.Get("days"); value.Exists() && !data.Recurrences[i].DailyDays.IsNull() {
+			data.Recurrences[i].DailyDays = helpers.GetStringListInOrder(value.Array(), data.Recurrences[i].DailyDays)
+		} else {
+			data.Recurrences[i].DailyDays = types.ListNull(types.StringType)
+		}
+		if value := r.Get("rangeStartDay"); value.Exists() && !data.Recurrences[i].RangeStartDay.IsNull() {
+			data.Recurrences[i].RangeStartDay = types.StringValue(value.String())
+		} else {
+			data.Recurrences[i].RangeStartDay = types.StringNull()
+		}
+		if value := r.Get("rangeStartTime"); value.Exists() && !data.Recurrences[i].RangeStartTime.IsNull() {
+			data.Recurrences[i].RangeStartTime = types.StringValue(value.String())
+		} else {
+			data.Recurrences[i].RangeStartTime = types.StringNull()
+		}
+		if value := r.Get("rangeEndDay"); value.Exists() && !data.Recurrences[i].RangeEndDay.IsNull() {
+			data.Recurrences[i].RangeEndDay = types.StringValue(value.String())
+		} else {
+			data.Recurrences[i].RangeEndDay = types.StringNull()
+		}
+		if value := r.Get("rangeEndTime"); value.Exists() && !data.Recurrences[i].RangeEndTime.IsNull() {
+			data.Recurrences[i].RangeEndTime = types.StringValue(value.String())
+		} else {
+			data.Recurrences[i].RangeEndTime = types.StringNull()
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *TimeRange) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if !data.StartDateTime.IsNull() {
+		return false
+	}
+	if !data.EndDateTime.IsNull() {
+		return false
+	}
+	if len(data.Recurrences) > 0 {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
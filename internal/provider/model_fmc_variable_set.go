@@ -0,0 +1,212 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type VariableSet struct {
+	Id          types.String           `tfsdk:"id"`
+	Domain      types.String           `tfsdk:"domain"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	Variables   []VariableSetVariables `tfsdk:"variables"`
+}
+
+type VariableSetVariables struct {
+	Name        types.String `tfsdk:"name"`
+	NetworkId   types.String `tfsdk:"network_id"`
+	NetworkName types.String `tfsdk:"network_name"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data VariableSet) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/variablesets"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data VariableSet) toBody(ctx context.Context, state VariableSet) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	if len(data.Variables) > 0 {
+		body, _ = sjson.Set(body, "variables", []interface{}{})
+		for _, item := range data.Variables {
+			itemBody := ""
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.NetworkId.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "value.id", item.NetworkId.ValueString())
+			}
+			itemBody, _ = sjson.Set(itemBody, "value.type", "Network")
+			body, _ = sjson.SetRaw(body, "variables.-1", itemBody)
+		}
+	}
+	return body
+}
+
+// resolveReferences sets the IDs of referenced objects configured by name, all names of the same
+// object type are resolved with a single list of the objects
+func (data *VariableSet) resolveReferences(ctx context.Context, client *fmc.Client, reqMods ...func(*fmc.Req)) error {
+	resolver := helpers.NewNameResolver()
+	for _, item := range data.Variables {
+		if item.NetworkId.ValueString() == "" {
+			if item.NetworkName.ValueString() == "" {
+				return fmt.Errorf("either network_id or network_name must be configured")
+			}
+			resolver.Add("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks", item.NetworkName.ValueString())
+		}
+	}
+	if err := resolver.Resolve(client, reqMods...); err != nil {
+		return err
+	}
+	for i, item := range data.Variables {
+		if item.NetworkId.ValueString() == "" {
+			data.Variables[i].NetworkId = types.StringValue(resolver.Id("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks", item.NetworkName.ValueString()))
+		}
+	}
+	return nil
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *VariableSet) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("variables"); value.Exists() {
+		data.Variables = make([]VariableSetVariables, 0)
+		value.ForEach(func(k, v gjson.Result) bool {
+			item := VariableSetVariables{}
+			if cValue := v.Get("name"); cValue.Exists() {
+				item.Name = types.StringValue(cValue.String())
+			} else {
+				item.Name = types.StringNull()
+			}
+			if cValue := v.Get("value.id"); cValue.Exists() {
+				item.NetworkId = types.StringValue(cValue.String())
+			} else {
+				item.NetworkId = types.StringNull()
+			}
+			data.Variables = append(data.Variables, item)
+			return true
+		})
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *VariableSet) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	for i := range data.Variables {
+		keys := [...]string{"name"}
+		keyValues := [...]string{data.Variables[i].Name.ValueString()}
+
+		var r gjson.Result
+		res.Get("variables").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() == keyValues[ik] {
+						found = true
+						continue
+					}
+					found = false
+					break
+				}
+				if found {
+					r = v
+					return false
+				}
+				return true
+			},
+		)
+		if value := r.Get("name"); value.Exists() && !data.Variables[i].Name.IsNull() {
+			data.Variables[i].Name = types.StringValue(value.String())
+		} else {
+			data.Variables[i].Name = types.StringNull()
+		}
+		if value := r.Get("value.id"); value.Exists() && !data.Variables[i].NetworkId.IsNull() {
+			data.Variables[i].NetworkId = types.StringValue(value.String())
+		} else {
+			data.Variables[i].NetworkId = types.StringNull()
+		}
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *VariableSet) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.Description.IsNull() {
+		return false
+	}
+	if len(data.Variables) > 0 {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
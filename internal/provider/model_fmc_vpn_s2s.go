@@ -0,0 +1,174 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin types
+type VPNS2S struct {
+	Id              types.String `tfsdk:"id"`
+	Domain          types.String `tfsdk:"domain"`
+	Name            types.String `tfsdk:"name"`
+	NetworkTopology types.String `tfsdk:"network_topology"`
+	RouteBased      types.Bool   `tfsdk:"route_based"`
+	Ikev1           types.Bool   `tfsdk:"ikev1"`
+	Ikev2           types.Bool   `tfsdk:"ikev2"`
+}
+
+//template:end types
+
+//template:begin getPath
+func (data VPNS2S) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/ftds2svpns"
+}
+
+//template:end getPath
+
+//template:begin toBody
+func (data VPNS2S) toBody(ctx context.Context, state VPNS2S) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	body, _ = sjson.Set(body, "type", "FTDS2SVpn")
+	if !data.NetworkTopology.IsNull() {
+		body, _ = sjson.Set(body, "topologyType", data.NetworkTopology.ValueString())
+	}
+	if !data.RouteBased.IsNull() {
+		body, _ = sjson.Set(body, "routeBased", data.RouteBased.ValueBool())
+	}
+	if !data.Ikev1.IsNull() {
+		body, _ = sjson.Set(body, "ikeV1Enabled", data.Ikev1.ValueBool())
+	}
+	if !data.Ikev2.IsNull() {
+		body, _ = sjson.Set(body, "ikeV2Enabled", data.Ikev2.ValueBool())
+	}
+	return body
+}
+
+// toInitialBody returns the body of the first request of a two-phase create, which only contains the mandatory attributes
+func (data VPNS2S) toInitialBody(ctx context.Context) string {
+	full := gjson.Parse(data.toBody(ctx, VPNS2S{}))
+	body := ""
+	for _, path := range []string{"name", "type", "topologyType", "routeBased"} {
+		if value := full.Get(path); value.Exists() {
+			body, _ = sjson.SetRaw(body, path, value.Raw)
+		}
+	}
+	return body
+}
+
+//template:end toBody
+
+//template:begin fromBody
+func (data *VPNS2S) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("topologyType"); value.Exists() {
+		data.NetworkTopology = types.StringValue(value.String())
+	} else {
+		data.NetworkTopology = types.StringNull()
+	}
+	if value := res.Get("routeBased"); value.Exists() {
+		data.RouteBased = types.BoolValue(value.Bool())
+	} else {
+		data.RouteBased = types.BoolNull()
+	}
+	if value := res.Get("ikeV1Enabled"); value.Exists() {
+		data.Ikev1 = types.BoolValue(value.Bool())
+	} else {
+		data.Ikev1 = types.BoolValue(false)
+	}
+	if value := res.Get("ikeV2Enabled"); value.Exists() {
+		data.Ikev2 = types.BoolValue(value.Bool())
+	} else {
+		data.Ikev2 = types.BoolValue(false)
+	}
+}
+
+//template:end fromBody
+
+//template:begin updateFromBody
+func (data *VPNS2S) updateFromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("topologyType"); value.Exists() && !data.NetworkTopology.IsNull() {
+		data.NetworkTopology = types.StringValue(value.String())
+	} else {
+		data.NetworkTopology = types.StringNull()
+	}
+	if value := res.Get("routeBased"); value.Exists() && !data.RouteBased.IsNull() {
+		data.RouteBased = types.BoolValue(value.Bool())
+	} else {
+		data.RouteBased = types.BoolNull()
+	}
+	if value := res.Get("ikeV1Enabled"); value.Exists() && !data.Ikev1.IsNull() {
+		data.Ikev1 = types.BoolValue(value.Bool())
+	} else if data.Ikev1.ValueBool() != false {
+		data.Ikev1 = types.BoolNull()
+	}
+	if value := res.Get("ikeV2Enabled"); value.Exists() && !data.Ikev2.IsNull() {
+		data.Ikev2 = types.BoolValue(value.Bool())
+	} else if data.Ikev2.ValueBool() != false {
+		data.Ikev2 = types.BoolNull()
+	}
+}
+
+//template:end updateFromBody
+
+//template:begin isNull
+func (data *VPNS2S) isNull(ctx context.Context, res gjson.Result) bool {
+	if !data.Name.IsNull() {
+		return false
+	}
+	if !data.NetworkTopology.IsNull() {
+		return false
+	}
+	if !data.RouteBased.IsNull() {
+		return false
+	}
+	if !data.Ikev1.IsNull() {
+		return false
+	}
+	if !data.Ikev2.IsNull() {
+		return false
+	}
+	return true
+}
+
+//template:end isNull
@@ -27,15 +27,20 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 )
 
+var _ provider.ProviderWithFunctions = &FmcProvider{}
+
 // FmcProvider defines the provider implementation.
 type FmcProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -46,17 +51,26 @@ type FmcProvider struct {
 
 // FmcProviderModel describes the provider data model.
 type FmcProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	URL      types.String `tfsdk:"url"`
-	Insecure types.Bool   `tfsdk:"insecure"`
-	Retries  types.Int64  `tfsdk:"retries"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	URL               types.String `tfsdk:"url"`
+	Insecure          types.Bool   `tfsdk:"insecure"`
+	Retries           types.Int64  `tfsdk:"retries"`
+	ForceDelete       types.Bool   `tfsdk:"force_delete"`
+	LogLevel          types.String `tfsdk:"log_level"`
+	ChangeComment     types.String `tfsdk:"change_comment"`
+	PreChangeSnapshot types.Bool   `tfsdk:"pre_change_snapshot"`
 }
 
 // FmcProviderData describes the data maintained by the provider.
 type FmcProviderData struct {
-	Client      *fmc.Client
-	UpdateMutex *sync.Mutex
+	Client            *fmc.Client
+	DomainClients     *helpers.DomainClients
+	UpdateMutex       *sync.Mutex
+	ForceDelete       bool
+	ChangeComment     string
+	PreChangeSnapshot bool
+	Logger            helpers.Logger
 }
 
 // Metadata returns the provider type name.
@@ -92,6 +106,25 @@ func (p *FmcProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 					int64validator.Between(0, 9),
 				},
 			},
+			"force_delete": schema.BoolAttribute{
+				MarkdownDescription: "Delete child objects (e.g. categories of an access control policy) before deleting an object. Child objects are deleted even if not managed by Terraform. This can also be set as the FMC_FORCE_DELETE environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"log_level": schema.StringAttribute{
+				MarkdownDescription: "Verbosity of the log output of resources and data sources: `error` only logs errors and warnings, `summary` additionally logs a summary of each operation and `trace` additionally logs the details of each request, the payloads are not logged as they may contain secrets. This can also be set as the FMC_LOG_LEVEL environment variable. Defaults to `summary`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helpers.LogLevels...),
+				},
+			},
+			"pre_change_snapshot": schema.BoolAttribute{
+				MarkdownDescription: "Export a snapshot of critical objects (e.g. access control policies) with the configuration export of FMC before updating or deleting them, the ID of the export task is logged and identifies the export package to restore the object from. This can also be set as the FMC_PRE_CHANGE_SNAPSHOT environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"change_comment": schema.StringAttribute{
+				MarkdownDescription: "Comment added to the comment history of rules (e.g. access rules) when creating or updating them, e.g. a change ticket to correlate the changes in audit trails. FMC records the comment together with the user and time of the change. This can also be set as the FMC_CHANGE_COMMENT environment variable, e.g. to use a different comment per apply.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -225,6 +258,92 @@ func (p *FmcProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		retries = config.Retries.ValueInt64()
 	}
 
+	var forceDelete bool
+	if config.ForceDelete.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as force_delete",
+		)
+		return
+	}
+
+	if config.ForceDelete.IsNull() {
+		forceDeleteStr := os.Getenv("FMC_FORCE_DELETE")
+		if forceDeleteStr == "" {
+			forceDelete = false
+		} else {
+			forceDelete, _ = strconv.ParseBool(forceDeleteStr)
+		}
+	} else {
+		forceDelete = config.ForceDelete.ValueBool()
+	}
+
+	var preChangeSnapshot bool
+	if config.PreChangeSnapshot.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as pre_change_snapshot",
+		)
+		return
+	}
+
+	if config.PreChangeSnapshot.IsNull() {
+		preChangeSnapshotStr := os.Getenv("FMC_PRE_CHANGE_SNAPSHOT")
+		if preChangeSnapshotStr == "" {
+			preChangeSnapshot = false
+		} else {
+			preChangeSnapshot, _ = strconv.ParseBool(preChangeSnapshotStr)
+		}
+	} else {
+		preChangeSnapshot = config.PreChangeSnapshot.ValueBool()
+	}
+
+	var logLevel string
+	if config.LogLevel.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as log_level",
+		)
+		return
+	}
+
+	if config.LogLevel.IsNull() {
+		logLevel = os.Getenv("FMC_LOG_LEVEL")
+		if logLevel == "" {
+			logLevel = "summary"
+		}
+	} else {
+		logLevel = config.LogLevel.ValueString()
+	}
+
+	level, err := helpers.ParseLogLevel(logLevel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid log level",
+			err.Error(),
+		)
+		return
+	}
+
+	var changeComment string
+	if config.ChangeComment.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as change_comment",
+		)
+		return
+	}
+
+	if config.ChangeComment.IsNull() {
+		changeComment = os.Getenv("FMC_CHANGE_COMMENT")
+	} else {
+		changeComment = config.ChangeComment.ValueString()
+	}
+
 	// Create a new FMC client and set it to the provider client
 	c, err := fmc.NewClient(url, username, password, fmc.Insecure(insecure), fmc.MaxRetries(int(retries)))
 	if err != nil {
@@ -234,8 +353,10 @@ func (p *FmcProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 		return
 	}
+	// HTML error pages of an overloaded FMC or a proxy are turned into readable error messages
+	c.HttpClient.Transport = helpers.NonJSONErrorTransport(c.HttpClient.Transport)
 
-	data := FmcProviderData{Client: &c, UpdateMutex: &sync.Mutex{}}
+	data := FmcProviderData{Client: &c, DomainClients: helpers.NewDomainClients(), UpdateMutex: &sync.Mutex{}, ForceDelete: forceDelete, ChangeComment: changeComment, PreChangeSnapshot: preChangeSnapshot, Logger: helpers.Logger{Level: level}}
 	resp.DataSourceData = &data
 	resp.ResourceData = &data
 }
@@ -244,17 +365,63 @@ func (p *FmcProvider) Resources(ctx context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		NewAccessControlPolicyResource,
 		NewAccessControlPolicyCategoryResource,
+		NewAccessRuleResource,
+		NewCertificateEnrollmentResource,
+		NewDevicePhysicalInterfaceResource,
+		NewHealthPolicyResource,
 		NewHostResource,
+		NewICMPv4ObjectResource,
+		NewIKEv2PolicyResource,
 		NewNetworkResource,
+		NewNetworkBulkResource,
+		NewNetworkGroupResource,
+		NewPrefilterPolicyResource,
+		NewPrefilterRuleResource,
+		NewScheduledTaskResource,
+		NewTimeRangeResource,
+		NewVariableSetResource,
+		NewVPNS2SResource,
+		// Resources which are not generated from a definition
+		NewBulkImportResource,
 	}
 }
 
 func (p *FmcProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAccessControlPolicyDataSource,
+		NewAccessControlPolicyDiffDataSource,
 		NewAccessControlPolicyCategoryDataSource,
+		NewAccessRuleDataSource,
+		NewCertificateEnrollmentDataSource,
+		NewDevicePhysicalInterfaceDataSource,
+		NewDevicesDataSource,
+		NewHealthPolicyDataSource,
 		NewHostDataSource,
+		NewICMPv4ObjectDataSource,
+		NewIKEv2PolicyDataSource,
 		NewNetworkDataSource,
+		NewNetworkDriftDataSource,
+		NewNetworkGroupDataSource,
+		NewPendingChangesDataSource,
+		NewPrefilterPolicyDataSource,
+		NewPrefilterRuleDataSource,
+		NewScheduledTaskDataSource,
+		NewTimeRangeDataSource,
+		NewVariableSetDataSource,
+		NewVPNS2SDataSource,
+		NewHostOverrideDataSource,
+		NewNetworkOverrideDataSource,
+		NewAccessControlPolicyCategoryPathDataSource,
+		NewNetworkUsageDataSource,
+		NewAccessControlPolicyCategoryCountDataSource,
+		NewNetworkCountDataSource,
+	}
+}
+
+func (p *FmcProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIsValidCIDRFunction,
+		NewIPInRangeFunction,
 	}
 }
 
@@ -0,0 +1,61 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFmcProviderNonJSONError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+			w.Header().Set("X-auth-access-token", "token")
+			w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, "<html><body><h1>Access Denied</h1><p>Blocked by proxy policy.</p></body></html>")
+	}))
+	t.Cleanup(server.Close)
+
+	config := fmt.Sprintf(`provider "fmc" {`+"\n"+
+		`	url = "%s"`+"\n"+
+		`	username = "admin"`+"\n"+
+		`	password = "password"`+"\n"+
+		`}`+"\n"+
+		`data "fmc_network" "test" {`+"\n"+
+		`	id = "NETWORK-1"`+"\n"+
+		`}`+"\n", server.URL)
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`FMC returned a non-JSON error, status 403: Access Denied Blocked by proxy\s+policy\.`),
+			},
+		},
+	})
+}
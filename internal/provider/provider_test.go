@@ -18,11 +18,23 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -47,3 +59,140 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("FMC_URL env variable must be set for acceptance tests")
 	}
 }
+
+// testAccPreCheckMinimumVersion additionally skips the test if the FMC is older
+// than the minimum version of the resource or data source under test.
+func testAccPreCheckMinimumVersion(t *testing.T, minimumVersion string) {
+	testAccPreCheck(t)
+	version, err := helpers.ServerVersion(testAccClient())
+	if err != nil {
+		t.Fatalf("failed to check minimum version %s: %s", minimumVersion, err)
+	}
+	ok, err := helpers.VersionAtLeast(version, minimumVersion)
+	if err != nil {
+		t.Fatalf("failed to check minimum version %s: %s", minimumVersion, err)
+	}
+	if !ok {
+		t.Skipf("FMC version %s is older than the minimum version %s", version, minimumVersion)
+	}
+}
+
+// testAccClient returns an FMC client, which can be used to make changes
+// out-of-band during acceptance testing.
+func testAccClient() *fmc.Client {
+	client, _ := fmc.NewClient(os.Getenv("FMC_URL"), os.Getenv("FMC_USERNAME"), os.Getenv("FMC_PASSWORD"), fmc.Insecure(true))
+	return &client
+}
+
+// testAccCheckDisappears is a test check deleting an object out-of-band via the
+// API, the path of the object is built from the attributes of the resource in
+// the state.
+func testAccCheckDisappears(resourceAddress string, path func(attributes map[string]string) string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceAddress]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceAddress)
+		}
+		res, err := testAccClient().Delete(path(rs.Primary.Attributes))
+		if err != nil {
+			return fmt.Errorf("failed to delete %s out-of-band: %s, %s", resourceAddress, err, res.String())
+		}
+		return nil
+	}
+}
+
+// testAccCheckDestroyed is a destroy check asserting that the objects of the
+// given resources no longer exist, the path of each object is built from the
+// attributes of the resource in the state before the destroy.
+func testAccCheckDestroyed(paths map[string]func(attributes map[string]string) string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		for resourceAddress, path := range paths {
+			rs, ok := s.RootModule().Resources[resourceAddress]
+			if !ok {
+				return fmt.Errorf("resource %s not found in state", resourceAddress)
+			}
+			res, err := testAccClient().Get(path(rs.Primary.Attributes))
+			if err == nil {
+				return fmt.Errorf("object of %s still exists after destroy", resourceAddress)
+			}
+			if !fmcerrors.IsNotFound(err, res) {
+				return fmt.Errorf("failed to retrieve object of %s: %s, %s", resourceAddress, err, res.String())
+			}
+		}
+		return nil
+	}
+}
+
+// expectPlannedValue is a plan check asserting that an attribute value is
+// already known at plan time and matches the expected value.
+type expectPlannedValue struct {
+	resourceAddress string
+	attribute       string
+	value           string
+}
+
+func (e expectPlannedValue) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	for _, rc := range req.Plan.ResourceChanges {
+		if rc.Address != e.resourceAddress {
+			continue
+		}
+		after, ok := rc.Change.After.(map[string]any)
+		if !ok {
+			resp.Error = fmt.Errorf("%s - no planned values found", e.resourceAddress)
+			return
+		}
+		value, ok := after[e.attribute]
+		if !ok || value == nil {
+			resp.Error = fmt.Errorf("%s - attribute '%s' is not known at plan time", e.resourceAddress, e.attribute)
+			return
+		}
+		if fmt.Sprint(value) != e.value {
+			resp.Error = fmt.Errorf("%s - attribute '%s' planned value '%v', expected '%s'", e.resourceAddress, e.attribute, value, e.value)
+		}
+		return
+	}
+	resp.Error = fmt.Errorf("%s - resource not found in plan", e.resourceAddress)
+}
+
+func testAccExpectPlannedValue(resourceAddress, attribute, value string) plancheck.PlanCheck {
+	return expectPlannedValue{resourceAddress, attribute, value}
+}
+
+// testMockClient returns an FMC client talking to a mock server, which
+// responds to GET requests with the JSON body registered for the request
+// path and with 404 for any other path.
+func testMockClient(t *testing.T, responses map[string]string) *fmc.Client {
+	return testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	})
+}
+
+// testMockHandlerClient returns an FMC client talking to a mock server, which
+// responds to all requests with the given handler.
+func testMockHandlerClient(t *testing.T, handler http.HandlerFunc) *fmc.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create mock client: %s", err)
+	}
+	// Skip authentication against the mock server
+	client.AuthToken = "token"
+	client.LastRefresh = time.Now()
+	client.DomainUUID = "e276abec-e0f2-11e3-8169-6d9ed49b625f"
+	return &client
+}
+
+// testResourceSchema returns the schema of a resource.
+func testResourceSchema(r resource.Resource) schema.Schema {
+	resp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
@@ -0,0 +1,64 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFmcPreCheckMinimumVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		skipped bool
+	}{
+		{"7.2.0 (build 82)", true},
+		{"7.4.0 (build 112)", false},
+		{"7.6.0 (build 49)", false},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/fmc_platform/v1/auth/generatetoken":
+				w.Header().Set("X-auth-access-token", "token")
+				w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+				w.WriteHeader(http.StatusNoContent)
+			case "/api/fmc_platform/v1/info/serverversion":
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"items": [{"serverVersion": "%s", "type": "ServerVersion"}]}`, tt.version)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		t.Setenv("FMC_URL", server.URL)
+		t.Setenv("FMC_USERNAME", "admin")
+		t.Setenv("FMC_PASSWORD", "password")
+
+		var skipped bool
+		t.Run(tt.version, func(t *testing.T) {
+			defer func() { skipped = t.Skipped() }()
+			testAccPreCheckMinimumVersion(t, "7.4")
+		})
+		server.Close()
+		if skipped != tt.skipped {
+			t.Errorf("version %s: expected skipped %v, got %v", tt.version, tt.skipped, skipped)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestDeprecatedResource(t *testing.T) {
+	var requests []string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "76d24097-41c4-4558-a4d0-a8c07ac08470"}`)
+	})
+
+	ctx := context.Background()
+	r := helpers.NewDeprecatedResource(&NetworkResource{}, "network_object", "network")
+
+	metadataResp := resource.MetadataResponse{}
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "fmc"}, &metadataResp)
+	if metadataResp.TypeName != "fmc_network_object" {
+		t.Errorf("expected type name fmc_network_object, got: %s", metadataResp.TypeName)
+	}
+
+	schema := testResourceSchema(r)
+	if schema.DeprecationMessage == "" {
+		t.Errorf("expected deprecation message in schema")
+	}
+
+	validateResp := resource.ValidateConfigResponse{}
+	r.(resource.ResourceWithValidateConfig).ValidateConfig(ctx, resource.ValidateConfigRequest{}, &validateResp)
+	if validateResp.Diagnostics.WarningsCount() != 1 || validateResp.Diagnostics.HasError() {
+		t.Errorf("expected a single deprecation warning, got: %v", validateResp.Diagnostics)
+	}
+
+	configureResp := resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: &FmcProviderData{Client: client}}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error configuring resource: %v", configureResp.Diagnostics)
+	}
+
+	plan := Network{
+		Id:          types.StringUnknown(),
+		Domain:      types.StringNull(),
+		Name:        types.StringValue("NET1"),
+		Description: types.StringNull(),
+		Prefix:      types.StringValue("10.1.1.0/24"),
+		Overridable: types.BoolNull(),
+	}
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schema}}
+	if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+		t.Fatalf("failed to set plan: %v", diags)
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating resource: %v", resp.Diagnostics)
+	}
+	var id types.String
+	resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	if len(requests) != 1 || requests[0] != http.MethodPost || id.ValueString() != "76d24097-41c4-4558-a4d0-a8c07ac08470" {
+		t.Errorf("expected object to be created through the deprecated resource, got requests %v and id %s", requests, id)
+	}
+}
@@ -23,20 +23,14 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
 	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
 )
 
 //template:end imports
@@ -52,86 +46,27 @@ func NewAccessControlPolicyResource() resource.Resource {
 }
 
 type AccessControlPolicyResource struct {
-	client *fmc.Client
+	client            *fmc.Client
+	clients           *helpers.DomainClients
+	logger            helpers.Logger
+	forceDelete       bool
+	preChangeSnapshot bool
 }
 
 func (r *AccessControlPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_access_control_policy"
 }
 
-func (r *AccessControlPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: helpers.NewAttributeDescription("This resource can manage an Access Control Policy.").String,
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The id of the object",
-				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"domain": schema.StringAttribute{
-				MarkdownDescription: "The name of the FMC domain",
-				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("The name of the access control policy.").String,
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("Description").String,
-				Optional:            true,
-			},
-			"default_action": schema.StringAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("Specifies the action to take when the conditions defined by the rule are met.").AddStringEnumDescription("BLOCK", "TRUST", "PERMIT", "NETWORK_DISCOVERY", "INHERIT_FROM_PARENT").String,
-				Required:            true,
-				Validators: []validator.String{
-					stringvalidator.OneOf("BLOCK", "TRUST", "PERMIT", "NETWORK_DISCOVERY", "INHERIT_FROM_PARENT"),
-				},
-			},
-			"default_action_id": schema.StringAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("Default action ID.").String,
-				Computed:            true,
-			},
-			"default_action_log_begin": schema.BoolAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("Indicating whether the device will log events at the beginning of the connection.").AddDefaultValueDescription("false").String,
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
-			},
-			"default_action_log_end": schema.BoolAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("Indicating whether the device will log events at the end of the connection.").AddDefaultValueDescription("false").String,
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
-			},
-			"default_action_send_events_to_fmc": schema.BoolAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("Indicating whether the device will send events to the Firepower Management Center event viewer.").AddDefaultValueDescription("false").String,
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
-			},
-			"default_action_send_syslog": schema.BoolAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("Indicating whether the device will send events to a syslog server.").AddDefaultValueDescription("false").String,
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
-			},
-		},
-	}
-}
-
 func (r *AccessControlPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	r.logger = req.ProviderData.(*FmcProviderData).Logger
+	r.forceDelete = req.ProviderData.(*FmcProviderData).ForceDelete
+	r.preChangeSnapshot = req.ProviderData.(*FmcProviderData).PreChangeSnapshot
 }
 
 //template:end model
@@ -148,29 +83,42 @@ func (r *AccessControlPolicyResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
 
 	// Create object
 	body := plan.toBody(ctx, AccessControlPolicy{})
-	res, err := r.client.Post(plan.getPath(), body, reqMods...)
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Post(plan.getPath(), body, reqMods...)
+	})
+	if err == nil {
+		// FMC reports failed asynchronous and bulk operations in the body of a successful response
+		err = fmcerrors.EmbeddedError(res)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %s, %s", err, res.String()))
 		return
 	}
 	plan.Id = types.StringValue(res.Get("id").String())
-	res, err = r.client.Get(plan.getPath()+"/"+plan.Id.ValueString(), reqMods...)
+	res, err = client.Get(plan.getPath()+"/"+plan.Id.ValueString(), reqMods...)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
 		return
 	}
+	res, err = plan.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
 	plan.updateFromBody(ctx, res)
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -190,21 +138,30 @@ func (r *AccessControlPolicyResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
 
-	res, err := r.client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
-	if err != nil && strings.Contains(err.Error(), "StatusCode 404") {
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
 		resp.State.RemoveResource(ctx)
 		return
 	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
 		return
 	}
+	res, err = state.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", state.Id.ValueString(), len(res.Raw)))
 
 	// If every attribute is set to null we are dealing with an import operation and therefore reading all attributes
 	if state.isNull(ctx, res) {
@@ -213,7 +170,7 @@ func (r *AccessControlPolicyResource) Read(ctx context.Context, req resource.Rea
 		state.updateFromBody(ctx, res)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -239,27 +196,48 @@ func (r *AccessControlPolicyResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	if r.preChangeSnapshot {
+		snapshotId, err := helpers.Snapshot(ctx, client, state.Id.ValueString(), reqMods...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create snapshot before update, got error: %s", err))
+			return
+		}
+		r.logger.Summary(ctx, fmt.Sprintf("%s: Created snapshot %s before update", state.Id.ValueString(), snapshotId))
+	}
 
 	body := plan.toBody(ctx, state)
-	res, err := r.client.Put(plan.getPath()+"/"+plan.Id.ValueString(), body, reqMods...)
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath()+"/"+plan.Id.ValueString(), body, reqMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
 		return
 	}
-	res, err = r.client.Get(plan.getPath()+"/"+plan.Id.ValueString(), reqMods...)
+	res, err = client.Get(plan.getPath()+"/"+plan.Id.ValueString(), reqMods...)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
 		return
 	}
+	res, err = plan.readEndpoints(ctx, client, res, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
 	plan.updateFromBody(ctx, res)
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -279,19 +257,70 @@ func (r *AccessControlPolicyResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
-	res, err := r.client.Delete(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
-	if err != nil {
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+
+	if r.preChangeSnapshot {
+		snapshotId, err := helpers.Snapshot(ctx, client, state.Id.ValueString(), reqMods...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create snapshot before delete, got error: %s", err))
+			return
+		}
+		r.logger.Summary(ctx, fmt.Sprintf("%s: Created snapshot %s before delete", state.Id.ValueString(), snapshotId))
+	}
+
+	if r.forceDelete {
+		// Child objects need to be deleted first, otherwise FMC refuses to delete the object
+		for _, childPath := range []string{"/categories"} {
+			childPath = state.getPath() + "/" + state.Id.ValueString() + childPath
+			var childIds []string
+			offset := 0
+			limit := 1000
+			for {
+				queryString := fmt.Sprintf("?limit=%d&offset=%d", limit, offset)
+				res, err := client.Get(childPath+queryString, reqMods...)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve child objects (GET), got error: %s, %s", err, res.String()))
+					return
+				}
+				res.Get("items").ForEach(func(k, v gjson.Result) bool {
+					childIds = append(childIds, v.Get("id").String())
+					return true
+				})
+				if !res.Get("paging.next.0").Exists() {
+					break
+				}
+				offset += limit
+			}
+			for _, childId := range childIds {
+				r.logger.Warning(ctx, fmt.Sprintf("%s: Force delete of child object %s", state.Id.ValueString(), childPath+"/"+childId))
+				res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+					return client.Delete(childPath+"/"+childId, reqMods...)
+				})
+				if err != nil && !fmcerrors.IsNotFound(err, res) {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete child object (DELETE), got error: %s, %s", err, res.String()))
+					return
+				}
+			}
+		}
+	}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Delete(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil && !fmcerrors.IsNotFound(err, res) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
 		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
 
 	resp.State.RemoveResource(ctx)
 }
@@ -300,7 +329,30 @@ func (r *AccessControlPolicyResource) Delete(ctx context.Context, req resource.D
 
 //template:begin import
 func (r *AccessControlPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object is retrieved right away, so a missing object is reported with the import identifier instead
+	// of failing the refresh after the import
+	var state AccessControlPolicy
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.clients.Client(r.client, "")
+	reqMods := [](func(*fmc.Req)){}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
+		resp.Diagnostics.AddError("Object Not Found", fmt.Sprintf("Object with id %s not found, it can not be imported", state.Id.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+	}
 }
 
 //template:end import
@@ -31,8 +31,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
 	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
 )
 
@@ -49,7 +49,9 @@ func NewAccessControlPolicyCategoryResource() resource.Resource {
 }
 
 type AccessControlPolicyCategoryResource struct {
-	client *fmc.Client
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
 }
 
 func (r *AccessControlPolicyCategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -78,8 +80,10 @@ func (r *AccessControlPolicyCategoryResource) Schema(ctx context.Context, req re
 			},
 			"access_control_policy_id": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("The ID of the access control policy.").String,
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -87,6 +91,17 @@ func (r *AccessControlPolicyCategoryResource) Schema(ctx context.Context, req re
 				MarkdownDescription: helpers.NewAttributeDescription("The name of the category.").String,
 				Required:            true,
 			},
+			"access_control_policy_name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the parent object, which is looked up if `access_control_policy_id` is not configured.").String,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create_access_control_policy": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Create the parent object named `access_control_policy_name` if it does not exist. A parent created this way is deleted together with this object, a pre-existing parent is never deleted.").String,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -97,6 +112,8 @@ func (r *AccessControlPolicyCategoryResource) Configure(_ context.Context, req r
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	r.logger = req.ProviderData.(*FmcProviderData).Logger
 }
 
 //template:end model
@@ -113,26 +130,63 @@ func (r *AccessControlPolicyCategoryResource) Create(ctx context.Context, req re
 	}
 
 	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	// Look up the parent by name, or create it if requested
+	parentOwned := false
+	if plan.AccessControlPolicyId.ValueString() == "" {
+		if plan.AccessControlPolicyName.ValueString() == "" {
+			resp.Diagnostics.AddError("Client Error", "Either access_control_policy_id or access_control_policy_name must be configured")
+			return
+		}
+		parentId, owned, err := helpers.EnsureParent(client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies", plan.AccessControlPolicyName.ValueString(), `{"type": "AccessPolicy", "defaultAction": {"action": "BLOCK"}}`, plan.CreateAccessControlPolicy.ValueBool(), reqMods...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve parent object, got error: %s", err))
+			return
+		}
+		plan.AccessControlPolicyId = types.StringValue(parentId)
+		parentOwned = owned
+	}
 
 	// Create object
 	body := plan.toBody(ctx, AccessControlPolicyCategory{})
-	res, err := r.client.Post(plan.getPath(), body, reqMods...)
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Post(plan.getPath(), body, reqMods...)
+	})
+	if err == nil {
+		// FMC reports failed asynchronous and bulk operations in the body of a successful response
+		err = fmcerrors.EmbeddedError(res)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %s, %s", err, res.String()))
+		// Roll back the parent created for this object, which would otherwise not be managed by Terraform
+		if parentOwned {
+			if res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+				return client.Delete("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/"+plan.AccessControlPolicyId.ValueString(), reqMods...)
+			}); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to roll back parent object %s (DELETE), got error: %s, %s", plan.AccessControlPolicyId.ValueString(), err, res.String()))
+			}
+		}
 		return
 	}
 	plan.Id = types.StringValue(res.Get("id").String())
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if parentOwned {
+		// Track the ownership of the parent, a pre-existing parent must not be deleted with this object
+		diags = resp.Private.SetKey(ctx, "parent_owned", []byte("true"))
+		resp.Diagnostics.Append(diags...)
+	}
 }
 
 //template:end create
@@ -149,21 +203,25 @@ func (r *AccessControlPolicyCategoryResource) Read(ctx context.Context, req reso
 	}
 
 	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
 
-	res, err := r.client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
-	if err != nil && strings.Contains(err.Error(), "StatusCode 404") {
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
 		resp.State.RemoveResource(ctx)
 		return
 	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
 		return
 	}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", state.Id.ValueString(), len(res.Raw)))
 
 	// If every attribute is set to null we are dealing with an import operation and therefore reading all attributes
 	if state.isNull(ctx, res) {
@@ -172,7 +230,7 @@ func (r *AccessControlPolicyCategoryResource) Read(ctx context.Context, req reso
 		state.updateFromBody(ctx, res)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -198,21 +256,28 @@ func (r *AccessControlPolicyCategoryResource) Update(ctx context.Context, req re
 	}
 
 	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
 
 	body := plan.toBody(ctx, state)
-	res, err := r.client.Put(plan.getPath()+"/"+plan.Id.ValueString(), body, reqMods...)
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath()+"/"+plan.Id.ValueString(), body, reqMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
 		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -232,19 +297,36 @@ func (r *AccessControlPolicyCategoryResource) Delete(ctx context.Context, req re
 	}
 
 	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
 		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
-	res, err := r.client.Delete(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
-	if err != nil {
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Delete(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil && !fmcerrors.IsNotFound(err, res) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
 		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+	// Only a parent created for this object is deleted with it
+	if owned, _ := req.Private.GetKey(ctx, "parent_owned"); string(owned) == "true" {
+		res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+			return client.Delete("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/"+state.AccessControlPolicyId.ValueString(), reqMods...)
+		})
+		if err != nil && !fmcerrors.IsNotFound(err, res) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete parent object (DELETE), got error: %s, %s", err, res.String()))
+			return
+		}
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
 
 	resp.State.RemoveResource(ctx)
 }
@@ -253,7 +335,51 @@ func (r *AccessControlPolicyCategoryResource) Delete(ctx context.Context, req re
 
 //template:begin import
 func (r *AccessControlPolicyCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The REST endpoint depends on the IDs of parent objects and on endpoint parameters, which are part of the
+	// import identifier
+	parameters := []string{"access_control_policy_id"}
+	idParts := strings.Split(req.ID, ",")
+
+	valid := len(idParts) == len(parameters)+1
+	for _, part := range idParts {
+		if part == "" {
+			valid = false
+		}
+	}
+	if !valid {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <access_control_policy_id>,<id>. Got: %q", req.ID),
+		)
+		return
+	}
+	for i, parameter := range parameters {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parameter), idParts[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[len(parameters)])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object is retrieved right away, so a missing object is reported with the import identifier instead
+	// of failing the refresh after the import
+	var state AccessControlPolicyCategory
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.clients.Client(r.client, "")
+	reqMods := [](func(*fmc.Req)){}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
+		resp.Diagnostics.AddError("Object Not Found", fmt.Sprintf("Object with id %s not found, it can not be imported", state.Id.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+	}
 }
 
 //template:end import
@@ -0,0 +1,143 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/tidwall/gjson"
+)
+
+// testParentServer is a mock FMC holding access control policies and their categories.
+type testParentServer struct {
+	mu       sync.Mutex
+	policies map[string]string
+	deleted  []string
+	next     int
+}
+
+func (s *testParentServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+		w.Header().Set("X-auth-access-token", "token")
+		w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	policiesPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/policy/accesspolicies"
+	if !strings.HasPrefix(r.URL.Path, policiesPath) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, policiesPath), "/"), "/")
+	w.Header().Set("Content-Type", "application/json")
+	body, _ := io.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodGet && segments[0] == "":
+		var items []string
+		for id, name := range s.policies {
+			items = append(items, fmt.Sprintf(`{"id": "%s", "name": "%s"}`, id, name))
+		}
+		fmt.Fprintf(w, `{"items": [%s]}`, strings.Join(items, ","))
+	case r.Method == http.MethodPost && segments[0] == "":
+		s.next++
+		id := fmt.Sprintf("POLICY-%d", s.next)
+		s.policies[id] = gjson.GetBytes(body, "name").String()
+		fmt.Fprintf(w, `{"id": "%s", "name": "%s"}`, id, s.policies[id])
+	case r.Method == http.MethodDelete && len(segments) == 1:
+		delete(s.policies, segments[0])
+		s.deleted = append(s.deleted, segments[0])
+		fmt.Fprint(w, `{}`)
+	case r.Method == http.MethodPost && len(segments) == 2 && segments[1] == "categories":
+		fmt.Fprintf(w, `{"id": "CATEGORY-1", "name": "%s"}`, gjson.GetBytes(body, "name").String())
+	case r.Method == http.MethodGet && len(segments) == 3:
+		fmt.Fprint(w, `{"id": "CATEGORY-1", "name": "Category1"}`)
+	case r.Method == http.MethodDelete && len(segments) == 3:
+		fmt.Fprint(w, `{}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestFmcAccessControlPolicyCategoryCreateParent(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies map[string]string
+		deleted  string
+	}{
+		{
+			name:     "owned parent",
+			policies: map[string]string{},
+			deleted:  "POLICY-1",
+		},
+		{
+			name:     "pre-existing parent",
+			policies: map[string]string{"EXISTING-1": "POLICY1"},
+			deleted:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fmcServer := &testParentServer{policies: tt.policies}
+			server := httptest.NewServer(fmcServer)
+			t.Cleanup(server.Close)
+
+			config := fmt.Sprintf(`provider "fmc" {`+"\n"+
+				`	url = "%s"`+"\n"+
+				`	username = "admin"`+"\n"+
+				`	password = "password"`+"\n"+
+				`}`+"\n"+
+				`resource "fmc_access_control_policy_category" "test" {`+"\n"+
+				`	access_control_policy_name = "POLICY1"`+"\n"+
+				`	create_access_control_policy = true`+"\n"+
+				`	name = "Category1"`+"\n"+
+				`}`+"\n", server.URL)
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttrWith("fmc_access_control_policy_category.test", "access_control_policy_id", func(id string) error {
+								if fmcServer.policies[id] != "POLICY1" {
+									return fmt.Errorf("expected the ID of policy POLICY1, got: %s", id)
+								}
+								return nil
+							}),
+						),
+					},
+				},
+				CheckDestroy: func(*terraform.State) error {
+					if strings.Join(fmcServer.deleted, ",") != tt.deleted {
+						return fmt.Errorf("expected deleted parents '%s', got: %v", tt.deleted, fmcServer.deleted)
+					}
+					return nil
+				},
+			})
+		})
+	}
+}
@@ -21,10 +21,14 @@ package provider
 
 //template:begin imports
 import (
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 //template:end imports
@@ -35,14 +39,41 @@ func TestAccFmcAccessControlPolicyCategory(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_control_policy_category.test", "name", "Category1"))
 
 	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
 			Config: testAccFmcAccessControlPolicyCategoryPrerequisitesConfig + testAccFmcAccessControlPolicyCategoryConfig_minimum(),
 		})
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_access_control_policy_category.test", plancheck.ResourceActionNoop))
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcAccessControlPolicyCategoryPrerequisitesConfig + testAccFmcAccessControlPolicyCategoryConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_access_control_policy_category.test",
+		ImportState:  true,
+		ImportStateIdFunc: func(s *terraform.State) (string, error) {
+			attributes := s.RootModule().Resources["fmc_access_control_policy_category.test"].Primary.Attributes
+			return strings.Join([]string{attributes["access_control_policy_id"], attributes["id"]}, ","), nil
+		},
+	})
+	// Delete the object out-of-band, the next plan must recreate it
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcAccessControlPolicyCategoryPrerequisitesConfig + testAccFmcAccessControlPolicyCategoryConfig_all(),
+		Check: testAccCheckDisappears("fmc_access_control_policy_category.test", func(attributes map[string]string) string {
+			return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/categories", attributes["access_control_policy_id"]) + "/" + attributes["id"]
+		}),
+		ExpectNonEmptyPlan: true,
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcAccessControlPolicyCategoryPrerequisitesConfig + testAccFmcAccessControlPolicyCategoryConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{plancheck.ExpectResourceAction("fmc_access_control_policy_category.test", plancheck.ResourceActionCreate)},
+		},
 	})
 
 	resource.Test(t, resource.TestCase{
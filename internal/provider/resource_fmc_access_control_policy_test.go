@@ -21,10 +21,16 @@ package provider
 
 //template:begin imports
 import (
+	"context"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 //template:end imports
@@ -41,14 +47,20 @@ func TestAccFmcAccessControlPolicy(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_control_policy.test", "default_action_send_syslog", "true"))
 
 	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
 			Config: testAccFmcAccessControlPolicyConfig_minimum(),
 		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_access_control_policy.test", plancheck.ResourceActionUpdate))
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcAccessControlPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_access_control_policy.test",
@@ -93,3 +105,81 @@ func testAccFmcAccessControlPolicyConfig_all() string {
 }
 
 //template:end testAccConfigAll
+
+func TestAccFmcAccessControlPolicyForceDelete(t *testing.T) {
+	var policyPath string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmcAccessControlPolicyConfig_forceDelete(),
+				Check: func(s *terraform.State) error {
+					// Add a child object, which is not managed by Terraform
+					policyPath = AccessControlPolicy{}.getPath() + "/" + s.RootModule().Resources["fmc_access_control_policy.test"].Primary.ID
+					_, err := testAccClient().Post(policyPath+"/categories", `{"name":"CATEGORY1"}`)
+					return err
+				},
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			client := testAccClient()
+			if _, err := client.Get(policyPath); err == nil || !strings.Contains(err.Error(), "StatusCode 404") {
+				return fmt.Errorf("access control policy still exists: %s", policyPath)
+			}
+			return nil
+		},
+	})
+}
+
+func testAccFmcAccessControlPolicyConfig_forceDelete() string {
+	config := `provider "fmc" {` + "\n"
+	config += `	force_delete = true` + "\n"
+	config += `}` + "\n"
+	config += testAccFmcAccessControlPolicyConfig_minimum()
+	return config
+}
+
+func TestFmcAccessControlPolicyReadEndpoints(t *testing.T) {
+	path := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/policy/accesspolicies/76d24097-41c4-4558-a4d0-a8c07ac08470"
+	object := `{"id": "76d24097-41c4-4558-a4d0-a8c07ac08470", "name": "POLICY1", "defaultAction": {"id": "76d24097-41c4-4558-a4d0-a8c07ac08471", "action": "BLOCK"}}`
+	client := testMockClient(t, map[string]string{
+		path:                          object,
+		path + "/inheritancesettings": `{"items": [{"baseHierarchyObject": {"id": "76d24097-41c4-4558-a4d0-a8c07ac08472", "type": "AccessPolicy"}}]}`,
+	})
+
+	state := AccessControlPolicy{Id: types.StringValue("76d24097-41c4-4558-a4d0-a8c07ac08470")}
+	res, err := client.Get(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res, err = state.readEndpoints(context.Background(), client, res)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	state.fromBody(context.Background(), res)
+
+	if state.Name.ValueString() != "POLICY1" || state.DefaultAction.ValueString() != "BLOCK" {
+		t.Errorf("expected attributes of main endpoint to be read, got name %s and default action %s", state.Name, state.DefaultAction)
+	}
+	if state.BasePolicyId.ValueString() != "76d24097-41c4-4558-a4d0-a8c07ac08472" {
+		t.Errorf("expected base policy id to be read from secondary endpoint, got %s", state.BasePolicyId)
+	}
+
+	// Errors on the secondary endpoint are ignored
+	client = testMockClient(t, map[string]string{path: object})
+	state = AccessControlPolicy{Id: types.StringValue("76d24097-41c4-4558-a4d0-a8c07ac08470"), Name: types.StringValue("POLICY"), BasePolicyId: types.StringValue("76d24097-41c4-4558-a4d0-a8c07ac08472")}
+	res, _ = client.Get(path)
+	res, err = state.readEndpoints(context.Background(), client, res)
+	if err != nil {
+		t.Fatalf("expected error of secondary endpoint to be ignored, got: %s", err)
+	}
+	state.updateFromBody(context.Background(), res)
+
+	if state.Name.ValueString() != "POLICY1" {
+		t.Errorf("expected name to be read, got %s", state.Name)
+	}
+	if !state.BasePolicyId.IsNull() {
+		t.Errorf("expected base policy id to be null, got %s", state.BasePolicyId)
+	}
+}
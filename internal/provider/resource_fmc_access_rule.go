@@ -0,0 +1,445 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/sjson"
+)
+
+//template:end imports
+
+//template:begin model
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AccessRuleResource{}
+var _ resource.ResourceWithImportState = &AccessRuleResource{}
+
+func NewAccessRuleResource() resource.Resource {
+	return &AccessRuleResource{}
+}
+
+type AccessRuleResource struct {
+	client        *fmc.Client
+	clients       *helpers.DomainClients
+	logger        helpers.Logger
+	changeComment string
+}
+
+func (r *AccessRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_rule"
+}
+
+func (r *AccessRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource can manage a rule of an access control policy. The rules of a policy are evaluated in order of their section and category, a new rule is appended to the given category or section.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the FMC domain",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_control_policy_id": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The ID of the access control policy.").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the access rule.").String,
+				Required:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The action of the rule.").AddStringEnumDescription("ALLOW", "TRUST", "BLOCK", "MONITOR", "BLOCK_RESET", "BLOCK_INTERACTIVE", "BLOCK_RESET_INTERACTIVE").String,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ALLOW", "TRUST", "BLOCK", "MONITOR", "BLOCK_RESET", "BLOCK_INTERACTIVE", "BLOCK_RESET_INTERACTIVE"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Indicating whether the rule is enabled.").AddDefaultValueDescription("true").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the category the rule is placed in, changing the value moves the rule to the end of the new category.").String,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"section": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The section the rule is placed in if no category is given, changing the value moves the rule to the end of the new section.").AddStringEnumDescription("mandatory", "default").String,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("mandatory", "default"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_begin": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Indicating whether the device will log events at the beginning of the connection.").AddDefaultValueDescription("false").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"log_end": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Indicating whether the device will log events at the end of the connection.").AddDefaultValueDescription("false").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"send_events_to_fmc": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Indicating whether the device will send events to the Firepower Management Center event viewer.").AddDefaultValueDescription("false").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"source_network_objects": schema.ListNestedAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("List of source network objects.").String,
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("The ID of the network object.").String,
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("The type of the network object.").String,
+							Required:            true,
+						},
+					},
+				},
+			},
+			"destination_network_objects": schema.ListNestedAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("List of destination network objects.").String,
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("The ID of the network object.").String,
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("The type of the network object.").String,
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AccessRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	r.logger = req.ProviderData.(*FmcProviderData).Logger
+	r.changeComment = req.ProviderData.(*FmcProviderData).ChangeComment
+}
+
+//template:end model
+
+//template:begin create
+func (r *AccessRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccessRule
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	// Create object
+	body := plan.toBody(ctx, AccessRule{})
+	if r.changeComment != "" {
+		// FMC adds the comment to the comment history of the object
+		body, _ = sjson.Set(body, "newComments.-1", r.changeComment)
+	}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Post(plan.getPath(), body, append(reqMods, plan.setQueryParameters)...)
+	})
+	if err == nil {
+		// FMC reports failed asynchronous and bulk operations in the body of a successful response
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %s, %s", err, res.String()))
+		return
+	}
+	plan.Id = types.StringValue(res.Get("id").String())
+	res, err = client.Get(plan.getPath()+"/"+plan.Id.ValueString(), reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+	plan.updateFromBody(ctx, res)
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+//template:end create
+
+//template:begin read
+func (r *AccessRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccessRule
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", state.Id.ValueString(), len(res.Raw)))
+
+	// If every attribute is set to null we are dealing with an import operation and therefore reading all attributes
+	if state.isNull(ctx, res) {
+		state.fromBody(ctx, res)
+	} else {
+		state.updateFromBody(ctx, res)
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+//template:end read
+
+//template:begin update
+func (r *AccessRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state AccessRule
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read state
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	body := plan.toBody(ctx, state)
+	if r.changeComment != "" {
+		// FMC adds the comment to the comment history of the object
+		body, _ = sjson.Set(body, "newComments.-1", r.changeComment)
+	}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	putMods := reqMods
+	if !plan.Category.Equal(state.Category) || !plan.Section.Equal(state.Section) {
+		// The object is placed into its new category or section
+		putMods = append(putMods, plan.setPlacementParameters)
+	}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath()+"/"+plan.Id.ValueString(), body, putMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		return
+	}
+	res, err = client.Get(plan.getPath()+"/"+plan.Id.ValueString(), reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+	plan.updateFromBody(ctx, res)
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+//template:end update
+
+//template:begin delete
+func (r *AccessRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccessRule
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Delete(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil && !fmcerrors.IsNotFound(err, res) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
+		return
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+//template:end delete
+
+//template:begin import
+func (r *AccessRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The REST endpoint depends on the IDs of parent objects and on endpoint parameters, which are part of the
+	// import identifier
+	parameters := []string{"access_control_policy_id"}
+	idParts := strings.Split(req.ID, ",")
+
+	valid := len(idParts) == len(parameters)+1
+	for _, part := range idParts {
+		if part == "" {
+			valid = false
+		}
+	}
+	if !valid {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <access_control_policy_id>,<id>. Got: %q", req.ID),
+		)
+		return
+	}
+	for i, parameter := range parameters {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parameter), idParts[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[len(parameters)])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object is retrieved right away, so a missing object is reported with the import identifier instead
+	// of failing the refresh after the import
+	var state AccessRule
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.clients.Client(r.client, "")
+	reqMods := [](func(*fmc.Req)){}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
+		resp.Diagnostics.AddError("Object Not Found", fmt.Sprintf("Object with id %s not found, it can not be imported", state.Id.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+	}
+}
+
+//template:end import
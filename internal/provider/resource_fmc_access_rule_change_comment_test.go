@@ -0,0 +1,84 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+func TestFmcAccessRuleChangeComment(t *testing.T) {
+	id := "0050568A-4E02-1ed3-0000-004294969198"
+	object := ""
+	var body gjson.Result
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost || r.Method == http.MethodPut {
+			b, _ := io.ReadAll(r.Body)
+			body = gjson.ParseBytes(b)
+			object, _ = sjson.Delete(body.Raw, "newComments")
+			object, _ = sjson.Set(object, "id", id)
+		}
+		fmt.Fprint(w, object)
+	})
+
+	ctx := context.Background()
+	plan := AccessRule{
+		Id:                    types.StringUnknown(),
+		Domain:                types.StringNull(),
+		AccessControlPolicyId: types.StringValue("76d24097-41c4-4558-a4d0-a8c07ac08470"),
+		Name:                  types.StringValue("RULE1"),
+		Action:                types.StringValue("ALLOW"),
+		Enabled:               types.BoolValue(true),
+		Category:              types.StringNull(),
+		Section:               types.StringNull(),
+		LogBegin:              types.BoolValue(false),
+		LogEnd:                types.BoolValue(false),
+		SendEventsToFmc:       types.BoolValue(false),
+	}
+	tests := []struct {
+		comment  string
+		comments string
+	}{
+		{"CHG0001 allow web servers", `["CHG0001 allow web servers"]`},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		r := &AccessRuleResource{client: client, changeComment: tt.comment}
+		s := testResourceSchema(r)
+		createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+		createReq.Plan.Set(ctx, &plan)
+		createResp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+		r.Create(ctx, createReq, &createResp)
+		if createResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+		}
+		if body.Get("newComments").Raw != tt.comments {
+			t.Errorf("expected comments %s in create request, got: %s", tt.comments, body.Get("newComments").Raw)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// The bulk import is not generated from a definition, as it is a one-off job instead of a managed object:
+// the content is uploaded once, the import task is polled until it finishes and its results are kept in
+// the state. Destroying the resource does not delete the imported objects.
+
+const bulkImportEndpoint = "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/bulkimport"
+
+// bulkImportTimeout is the maximum time waited for an import task to finish
+var bulkImportTimeout = 30 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &BulkImportResource{}
+
+func NewBulkImportResource() resource.Resource {
+	return &BulkImportResource{}
+}
+
+type BulkImportResource struct {
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
+}
+
+type BulkImport struct {
+	Id           types.String `tfsdk:"id"`
+	Domain       types.String `tfsdk:"domain"`
+	FilePath     types.String `tfsdk:"file_path"`
+	Content      types.String `tfsdk:"content"`
+	Status       types.String `tfsdk:"status"`
+	Message      types.String `tfsdk:"message"`
+	CreatedCount types.Int64  `tfsdk:"created_count"`
+	FailedCount  types.Int64  `tfsdk:"failed_count"`
+}
+
+func (r *BulkImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bulk_import"
+}
+
+func (r *BulkImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource imports objects in bulk from CSV content and waits for the import task to finish. Changing the content imports it again, destroying the resource does not delete the imported objects.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the import task",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the FMC domain",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Path of a local file with the objects to import. Changes of the file content are not detected, use `content` with the `file()` function to import the file again on changes.").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Objects to import in CSV format.").String,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Final status of the import task.").String,
+				Computed:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Message of the import task.").String,
+				Computed:            true,
+			},
+			"created_count": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Number of objects created by the import.").String,
+				Computed:            true,
+			},
+			"failed_count": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Number of objects which failed to import.").String,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BulkImportResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	r.logger = req.ProviderData.(*FmcProviderData).Logger
+}
+
+// fromTask sets the computed attributes from the final status of the import task, every sub task
+// reports the result of importing a single object
+func (data *BulkImport) fromTask(res gjson.Result) {
+	data.Status = types.StringValue(res.Get("status").String())
+	data.Message = types.StringValue(res.Get("message").String())
+	var created, failed int64
+	res.Get("subTasks").ForEach(func(_, v gjson.Result) bool {
+		if helpers.TaskSucceeded(v.Get("status").String()) {
+			created++
+		} else if helpers.TaskFailed(v.Get("status").String()) {
+			failed++
+		}
+		return true
+	})
+	data.CreatedCount = types.Int64Value(created)
+	data.FailedCount = types.Int64Value(failed)
+}
+
+func (r *BulkImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BulkImport
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, "Beginning bulk import")
+
+	content := plan.Content.ValueString()
+	if !plan.FilePath.IsNull() {
+		file, err := os.ReadFile(plan.FilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Invalid File", fmt.Sprintf("Failed to read file, got error: %s", err))
+			return
+		}
+		content = string(file)
+	}
+	if strings.TrimSpace(content) == "" {
+		resp.Diagnostics.AddError("Client Error", "Nothing to import, the content is empty")
+		return
+	}
+
+	body, _ := sjson.Set("", "type", "BulkObjectImport")
+	body, _ = sjson.Set(body, "format", "CSV")
+	body, _ = sjson.Set(body, "content", content)
+	res, err := client.Post(bulkImportEndpoint, body, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to start import (POST), got error: %s, %s", err, res.String()))
+		return
+	}
+	taskId := res.Get("metadata.task.id").String()
+	if taskId == "" {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to start import (POST), no task returned: %s", res.String()))
+		return
+	}
+	plan.Id = types.StringValue(taskId)
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Waiting for import task", taskId))
+
+	waitCtx, cancel := context.WithTimeout(ctx, bulkImportTimeout)
+	defer cancel()
+	res, err = helpers.WaitForTask(waitCtx, client, taskId, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to import objects, got error: %s", err))
+		return
+	}
+	plan.fromTask(res)
+	if helpers.TaskFailed(plan.Status.ValueString()) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Import task %s failed: %s", taskId, plan.Message.ValueString()))
+		return
+	}
+	if plan.FailedCount.ValueInt64() > 0 {
+		resp.Diagnostics.AddWarning("Incomplete Import", fmt.Sprintf("%d objects failed to import: %s", plan.FailedCount.ValueInt64(), plan.Message.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Bulk import finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// The results of a finished import do not change, there is nothing to refresh
+func (r *BulkImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// All configurable attributes require a replacement, only the unchanged computed attributes remain
+func (r *BulkImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BulkImport
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// The imported objects are kept, the resource is only removed from the state
+func (r *BulkImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
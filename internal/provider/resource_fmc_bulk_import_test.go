@@ -0,0 +1,142 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+)
+
+func TestFmcBulkImport(t *testing.T) {
+	interval := helpers.TaskPollInterval
+	helpers.TaskPollInterval = time.Millisecond
+	t.Cleanup(func() { helpers.TaskPollInterval = interval })
+
+	csv := "name,type,value\nNET1,Network,10.1.1.0/24\nNET2,Network,10.1.2.0/24\n"
+	file := filepath.Join(t.TempDir(), "objects.csv")
+	if err := os.WriteFile(file, []byte(csv), 0644); err != nil {
+		t.Fatalf("failed to write file: %s", err)
+	}
+
+	tests := []struct {
+		name     string
+		plan     BulkImport
+		final    string
+		created  int64
+		failed   int64
+		warnings int
+		err      bool
+	}{
+		{
+			name:    "content",
+			plan:    BulkImport{Content: types.StringValue(csv), FilePath: types.StringNull()},
+			final:   `{"id": "TASK-1", "status": "Success", "message": "Import completed", "subTasks": [{"status": "SUCCESS"}, {"status": "SUCCESS"}]}`,
+			created: 2,
+		},
+		{
+			name:     "file with failed objects",
+			plan:     BulkImport{Content: types.StringNull(), FilePath: types.StringValue(file)},
+			final:    `{"id": "TASK-1", "status": "Success", "message": "Object NET2 already exists", "subTasks": [{"status": "SUCCESS"}, {"status": "FAILED"}]}`,
+			created:  1,
+			failed:   1,
+			warnings: 1,
+		},
+		{
+			name:  "failed task",
+			plan:  BulkImport{Content: types.StringValue(csv), FilePath: types.StringNull()},
+			final: `{"id": "TASK-1", "status": "Failed", "message": "Invalid CSV header"}`,
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uploaded gjson.Result
+			polls := 0
+			client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/bulkimport":
+					body, _ := io.ReadAll(r.Body)
+					uploaded = gjson.ParseBytes(body)
+					w.WriteHeader(http.StatusAccepted)
+					fmt.Fprint(w, `{"type": "BulkObjectImport", "metadata": {"task": {"id": "TASK-1", "type": "TaskStatus"}}}`)
+				case r.Method == http.MethodGet && r.URL.Path == "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/job/taskstatuses/TASK-1":
+					polls++
+					if polls < 3 {
+						fmt.Fprint(w, `{"id": "TASK-1", "status": "Running"}`)
+						return
+					}
+					fmt.Fprint(w, tt.final)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			ctx := context.Background()
+			r := &BulkImportResource{client: client}
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			plan := tt.plan
+			plan.Id = types.StringUnknown()
+			plan.Domain = types.StringNull()
+			plan.Status = types.StringUnknown()
+			plan.Message = types.StringUnknown()
+			plan.CreatedCount = types.Int64Unknown()
+			plan.FailedCount = types.Int64Unknown()
+			req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+			if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+				t.Fatalf("failed to set plan: %v", diags)
+			}
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() != tt.err {
+				t.Fatalf("expected error %v, got: %v", tt.err, resp.Diagnostics)
+			}
+			if uploaded.Get("content").String() != csv || uploaded.Get("format").String() != "CSV" {
+				t.Errorf("unexpected import request: %s", uploaded.Raw)
+			}
+			if polls != 3 {
+				t.Errorf("expected the task to be polled until it finished, got %d polls", polls)
+			}
+			if tt.err {
+				return
+			}
+			if resp.Diagnostics.WarningsCount() != tt.warnings {
+				t.Errorf("expected %d warnings, got: %v", tt.warnings, resp.Diagnostics)
+			}
+			var state BulkImport
+			resp.State.Get(ctx, &state)
+			if state.Id.ValueString() != "TASK-1" || state.Status.ValueString() != "Success" || state.CreatedCount.ValueInt64() != tt.created || state.FailedCount.ValueInt64() != tt.failed {
+				t.Errorf("unexpected state: id %s, status %s, created %d, failed %d", state.Id, state.Status, state.CreatedCount.ValueInt64(), state.FailedCount.ValueInt64())
+			}
+		})
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/fmcerrors"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+//template:end imports
+
+//template:begin model
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CertificateEnrollmentResource{}
+var _ resource.ResourceWithImportState = &CertificateEnrollmentResource{}
+var _ resource.ResourceWithConfigValidators = &CertificateEnrollmentResource{}
+
+func NewCertificateEnrollmentResource() resource.Resource {
+	return &CertificateEnrollmentResource{}
+}
+
+type CertificateEnrollmentResource struct {
+	client  *fmc.Client
+	clients *helpers.DomainClients
+	logger  helpers.Logger
+}
+
+func (r *CertificateEnrollmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_enrollment"
+}
+
+func (r *CertificateEnrollmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource can manage a Certificate Enrollment.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the FMC domain",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the certificate enrollment object.").String,
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Description").String,
+				Optional:            true,
+			},
+			"enrollment_type": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The enrollment type, which determines the attributes that can be configured.").AddStringEnumDescription("SCEP", "MANUAL").String,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("SCEP", "MANUAL"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scep_enrollment_url": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("URL of the SCEP server.").AddDiscriminatorDescription("enrollment_type", "SCEP").String,
+				Optional:            true,
+			},
+			"scep_retry_period": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Number of minutes between enrollment attempts.").AddIntegerRangeDescription(1, 60).AddDiscriminatorDescription("enrollment_type", "SCEP").String,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"scep_retry_count": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Number of retries if the enrollment fails, `0` retries indefinitely.").AddIntegerRangeDescription(0, 100).AddDiscriminatorDescription("enrollment_type", "SCEP").String,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"manual_ca_certificate": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("CA certificate in PEM format.").AddDiscriminatorDescription("enrollment_type", "MANUAL").String,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *CertificateEnrollmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		helpers.DiscriminatorValidator("enrollment_type", map[string][]string{
+			"scep_enrollment_url":   {"SCEP"},
+			"scep_retry_period":     {"SCEP"},
+			"scep_retry_count":      {"SCEP"},
+			"manual_ca_certificate": {"MANUAL"},
+		}),
+	}
+}
+
+func (r *CertificateEnrollmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.clients = req.ProviderData.(*FmcProviderData).DomainClients
+	r.logger = req.ProviderData.(*FmcProviderData).Logger.WithRedaction("://[^/:@]*:([^/@]*)@")
+}
+
+//template:end model
+
+//template:begin create
+func (r *CertificateEnrollmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CertificateEnrollment
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){fmc.NoLogPayload}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	// Create object
+	body := plan.toBody(ctx, CertificateEnrollment{})
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Post(plan.getPath(), body, reqMods...)
+	})
+	if err == nil {
+		// FMC reports failed asynchronous and bulk operations in the body of a successful response
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %s, %s", err, res.String()))
+		return
+	}
+	plan.Id = types.StringValue(res.Get("id").String())
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+//template:end create
+
+//template:begin read
+func (r *CertificateEnrollmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CertificateEnrollment
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){fmc.NoLogPayload}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Response body of %d bytes", state.Id.ValueString(), len(res.Raw)))
+
+	// If every attribute is set to null we are dealing with an import operation and therefore reading all attributes
+	if state.isNull(ctx, res) {
+		state.fromBody(ctx, res)
+	} else {
+		state.updateFromBody(ctx, res)
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+//template:end read
+
+//template:begin update
+func (r *CertificateEnrollmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CertificateEnrollment
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read state
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, plan.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){fmc.NoLogPayload}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	body := plan.toBody(ctx, state)
+	r.logger.Trace(ctx, fmt.Sprintf("%s: Request body of %d bytes", plan.Id.ValueString(), len(body)))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Put(plan.getPath()+"/"+plan.Id.ValueString(), body, reqMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		return
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+//template:end update
+
+//template:begin delete
+func (r *CertificateEnrollmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CertificateEnrollment
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	client := r.clients.Client(r.client, state.Domain.ValueString())
+	reqMods := [](func(*fmc.Req)){fmc.NoLogPayload}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Delete(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if err == nil {
+		err = fmcerrors.EmbeddedError(res)
+	}
+	if err != nil && !fmcerrors.IsNotFound(err, res) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
+		return
+	}
+
+	r.logger.Summary(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+//template:end delete
+
+//template:begin import
+func (r *CertificateEnrollmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object is retrieved right away, so a missing object is reported with the import identifier instead
+	// of failing the refresh after the import
+	var state CertificateEnrollment
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.clients.Client(r.client, "")
+	reqMods := [](func(*fmc.Req)){fmc.NoLogPayload}
+	res, err := fmcerrors.Retry(client, func() (fmc.Res, error) {
+		return client.Get(state.getPath()+"/"+state.Id.ValueString(), reqMods...)
+	})
+	if fmcerrors.IsNotFound(err, res) {
+		resp.Diagnostics.AddError("Object Not Found", fmt.Sprintf("Object with id %s not found, it can not be imported", state.Id.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+	}
+}
+
+//template:end import
@@ -0,0 +1,110 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+)
+
+func TestFmcCertificateEnrollmentSubtypes(t *testing.T) {
+	var body gjson.Result
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = gjson.ParseBytes(b)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "005056bb-0b24-0ed3-0000-399431958200"}`)
+	})
+
+	ctx := context.Background()
+	r := &CertificateEnrollmentResource{client: client}
+	s := testResourceSchema(r)
+	scep := CertificateEnrollment{
+		Id:                  types.StringUnknown(),
+		Domain:              types.StringNull(),
+		Name:                types.StringValue("ENROLLMENT1"),
+		Description:         types.StringNull(),
+		EnrollmentType:      types.StringValue("SCEP"),
+		ScepEnrollmentUrl:   types.StringValue("http://10.1.1.10/certsrv/mscep/mscep.dll"),
+		ScepRetryPeriod:     types.Int64Value(1),
+		ScepRetryCount:      types.Int64Value(10),
+		ManualCaCertificate: types.StringNull(),
+	}
+	manual := scep
+	manual.Name = types.StringValue("ENROLLMENT2")
+	manual.EnrollmentType = types.StringValue("MANUAL")
+	manual.ScepEnrollmentUrl = types.StringNull()
+	manual.ScepRetryPeriod = types.Int64Null()
+	manual.ScepRetryCount = types.Int64Null()
+	manual.ManualCaCertificate = types.StringValue("-----BEGIN CERTIFICATE-----")
+	invalid := manual
+	invalid.ScepEnrollmentUrl = types.StringValue("http://10.1.1.10/certsrv/mscep/mscep.dll")
+
+	tests := []struct {
+		name    string
+		plan    CertificateEnrollment
+		invalid bool
+		check   func(body gjson.Result) bool
+	}{
+		{"scep", scep, false, func(body gjson.Result) bool {
+			return body.Get("enrollmentType").String() == "SCEP" && body.Get("scep.retryCount").Int() == 10 && !body.Get("manual").Exists()
+		}},
+		{"manual", manual, false, func(body gjson.Result) bool {
+			return body.Get("enrollmentType").String() == "MANUAL" && body.Get("manual.caCertificate").Exists() && !body.Get("scep").Exists()
+		}},
+		{"invalid", invalid, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+			if diags := req.Plan.Set(ctx, &tt.plan); diags.HasError() {
+				t.Fatalf("failed to set plan: %v", diags)
+			}
+
+			// Attributes of other subtypes are rejected when validating the configuration
+			validateResp := resource.ValidateConfigResponse{}
+			for _, v := range r.ConfigValidators(ctx) {
+				v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: req.Plan.Raw}}, &validateResp)
+			}
+			if validateResp.Diagnostics.HasError() != tt.invalid {
+				t.Fatalf("expected validation error %v, got: %v", tt.invalid, validateResp.Diagnostics)
+			}
+			if tt.invalid {
+				return
+			}
+
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+			r.Create(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !tt.check(body) {
+				t.Errorf("unexpected request body: %s", body.Raw)
+			}
+		})
+	}
+}
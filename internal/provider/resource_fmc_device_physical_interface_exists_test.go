@@ -0,0 +1,85 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFmcDevicePhysicalInterfaceExists(t *testing.T) {
+	available := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+			w.Header().Set("X-auth-access-token", "token")
+			w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.URL.Path != "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/devices/devicerecords/DEVICE-1/physicalinterfaces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !available {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"items": [{"id": "INTF-1", "name": "GigabitEthernet0/0"}, {"id": "INTF-2", "name": "GigabitEthernet0/1"}]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	config := func(name string) string {
+		return fmt.Sprintf(`provider "fmc" {`+"\n"+
+			`	url = "%s"`+"\n"+
+			`	username = "admin"`+"\n"+
+			`	password = "password"`+"\n"+
+			`	retries = 0`+"\n"+
+			`}`+"\n"+
+			`resource "fmc_device_physical_interface" "test" {`+"\n"+
+			`	device_id = "DEVICE-1"`+"\n"+
+			`	name = "%s"`+"\n"+
+			`}`+"\n", server.URL, name)
+	}
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("GigabitEthernet0/9"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`No object with name 'GigabitEthernet0/9' found`),
+			},
+			{
+				Config:             config("GigabitEthernet0/1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig:          func() { available = false },
+				Config:             config("GigabitEthernet0/9"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/tidwall/gjson"
+)
+
+//template:end imports
+
+//template:begin testAcc
+func TestAccFmcDevicePhysicalInterface(t *testing.T) {
+	if os.Getenv("TF_VAR_device_id") == "" {
+		t.Skip("skipping test, set environment variable TF_VAR_device_id")
+	}
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_physical_interface.test", "name", "GigabitEthernet0/1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_physical_interface.test", "logical_name", "outside"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_physical_interface.test", "description", "My interface"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_physical_interface.test", "enabled", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_physical_interface.test", "mode", "NONE"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_physical_interface.test", "mtu", "9000"))
+
+	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDevicePhysicalInterfacePrerequisitesConfig + testAccFmcDevicePhysicalInterfaceConfig_minimum(),
+		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_device_physical_interface.test", plancheck.ResourceActionUpdate))
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDevicePhysicalInterfacePrerequisitesConfig + testAccFmcDevicePhysicalInterfaceConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_device_physical_interface.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+//template:end testAcc
+
+//template:begin testPrerequisites
+const testAccFmcDevicePhysicalInterfacePrerequisitesConfig = `
+variable "device_id" { default = null } // tests will set $TF_VAR_device_id
+
+`
+
+//template:end testPrerequisites
+
+//template:begin testAccConfigMinimal
+func testAccFmcDevicePhysicalInterfaceConfig_minimum() string {
+	config := `resource "fmc_device_physical_interface" "test" {` + "\n"
+	config += `	device_id = var.device_id` + "\n"
+	config += `	name = "GigabitEthernet0/1"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigMinimal
+
+//template:begin testAccConfigAll
+func testAccFmcDevicePhysicalInterfaceConfig_all() string {
+	config := `resource "fmc_device_physical_interface" "test" {` + "\n"
+	config += `	device_id = var.device_id` + "\n"
+	config += `	name = "GigabitEthernet0/1"` + "\n"
+	config += `	logical_name = "outside"` + "\n"
+	config += `	description = "My interface"` + "\n"
+	config += `	enabled = true` + "\n"
+	config += `	mode = "NONE"` + "\n"
+	config += `	mtu = 9000` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigAll
+
+// Physical interfaces are addressed by device and name, the lookup is tested
+// against a recorded response of the list of interfaces of a device.
+const testDevicePhysicalInterfacesResponse = `{
+  "items": [
+    {"id": "00505686-9a51-0ed3-0000-000000000001", "type": "PhysicalInterface", "name": "GigabitEthernet0/0", "ifname": "inside"},
+    {"id": "00505686-9a51-0ed3-0000-000000000002", "type": "PhysicalInterface", "name": "GigabitEthernet0/1", "ifname": "outside"}
+  ],
+  "paging": {"offset": 0, "limit": 1000, "count": 2, "pages": 1}
+}`
+
+func TestFmcDevicePhysicalInterfaceNaturalKey(t *testing.T) {
+	data := DevicePhysicalInterface{
+		DeviceId: types.StringValue("76d24097-41c4-4558-a4d0-a8c07ac08470"),
+		Name:     types.StringValue("GigabitEthernet0/1"),
+	}
+
+	if data.naturalKey() != "76d24097-41c4-4558-a4d0-a8c07ac08470,GigabitEthernet0/1" {
+		t.Errorf("unexpected natural key: %s", data.naturalKey())
+	}
+	if data.getPath() != "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords/76d24097-41c4-4558-a4d0-a8c07ac08470/physicalinterfaces" {
+		t.Errorf("unexpected path: %s", data.getPath())
+	}
+
+	obj := data.findByNaturalKey(gjson.Parse(testDevicePhysicalInterfacesResponse))
+	if obj.Get("id").String() != "00505686-9a51-0ed3-0000-000000000002" {
+		t.Errorf("expected interface GigabitEthernet0/1 to be found, got: %s", obj.String())
+	}
+	data.fromBody(context.Background(), obj)
+	if data.LogicalName.ValueString() != "outside" {
+		t.Errorf("unexpected logical name: %s", data.LogicalName.ValueString())
+	}
+
+	data.Name = types.StringValue("GigabitEthernet0/2")
+	if obj := data.findByNaturalKey(gjson.Parse(testDevicePhysicalInterfacesResponse)); obj.Exists() {
+		t.Errorf("expected no interface to be found, got: %s", obj.String())
+	}
+}
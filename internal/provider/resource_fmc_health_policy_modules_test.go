@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+)
+
+func TestFmcHealthPolicyModules(t *testing.T) {
+	var body gjson.Result
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = gjson.ParseBytes(b)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "005056bb-0b24-0ed3-0000-399431958100"}`)
+	})
+
+	ctx := context.Background()
+	r := &HealthPolicyResource{client: client}
+	s := testResourceSchema(r)
+	plan := HealthPolicy{
+		Id:          types.StringUnknown(),
+		Domain:      types.StringNull(),
+		Name:        types.StringValue("HEALTH_POLICY1"),
+		Description: types.StringNull(),
+		Modules: []HealthPolicyModules{
+			{Name: types.StringValue("CPU"), Enabled: types.BoolValue(true), WarningThreshold: types.Int64Value(80), CriticalThreshold: types.Int64Value(90)},
+			{Name: types.StringValue("Interface Status"), Enabled: types.BoolValue(false), WarningThreshold: types.Int64Value(70), CriticalThreshold: types.Int64Value(70), Settings: []HealthPolicyModulesSettings{
+				{Name: types.StringValue("interfaces"), Value: types.StringValue("GigabitEthernet0/0")},
+				{Name: types.StringValue("ignoreDisabled"), Value: types.StringValue("true")},
+			}},
+		},
+	}
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+		t.Fatalf("failed to set plan: %v", diags)
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	modules := body.Get("healthModules").Array()
+	if len(modules) != 2 {
+		t.Fatalf("expected two health modules, got: %s", body.Raw)
+	}
+	if modules[0].Get("name").String() != "CPU" || modules[0].Get("alertConfig.criticalThreshold").Int() != 90 {
+		t.Errorf("unexpected first module: %s", modules[0].Raw)
+	}
+	if modules[0].Get("moduleAttributes").Exists() {
+		t.Errorf("expected no settings of the first module, got: %s", modules[0].Raw)
+	}
+	if modules[1].Get("name").String() != "Interface Status" || modules[1].Get("enabled").Bool() || modules[1].Get("alertConfig.warningThreshold").Int() != 70 {
+		t.Errorf("unexpected second module: %s", modules[1].Raw)
+	}
+	if settings := modules[1].Get("moduleAttributes").Array(); len(settings) != 2 || settings[0].Get("name").String() != "interfaces" || settings[1].Get("value").String() != "true" {
+		t.Errorf("unexpected settings of the second module: %s", modules[1].Get("moduleAttributes").Raw)
+	}
+
+	// The critical threshold of a module must be at least its warning threshold
+	critical := s.Attributes["modules"].(schema.ListNestedAttribute).NestedObject.Attributes["critical_threshold"].(schema.Int64Attribute)
+	config := tfsdk.Config{Schema: s, Raw: req.Plan.Raw}
+	for i, tt := range []struct {
+		value int64
+		err   bool
+	}{{90, false}, {60, true}} {
+		attributePath := path.Root("modules").AtListIndex(i).AtName("critical_threshold")
+		validateReq := validator.Int64Request{Path: attributePath, PathExpression: attributePath.Expression(), ConfigValue: types.Int64Value(tt.value), Config: config}
+		validateResp := validator.Int64Response{}
+		for _, v := range critical.Validators {
+			v.ValidateInt64(ctx, validateReq, &validateResp)
+		}
+		if validateResp.Diagnostics.HasError() != tt.err {
+			t.Errorf("module %d: expected validation error %v for critical threshold %d, got: %v", i, tt.err, tt.value, validateResp.Diagnostics)
+		}
+	}
+
+	// The name of a setting must not be empty
+	settingName := s.Attributes["modules"].(schema.ListNestedAttribute).NestedObject.Attributes["settings"].(schema.ListNestedAttribute).NestedObject.Attributes["name"].(schema.StringAttribute)
+	for _, tt := range []struct {
+		value string
+		err   bool
+	}{{"interfaces", false}, {"", true}} {
+		attributePath := path.Root("modules").AtListIndex(1).AtName("settings").AtListIndex(0).AtName("name")
+		validateReq := validator.StringRequest{Path: attributePath, PathExpression: attributePath.Expression(), ConfigValue: types.StringValue(tt.value), Config: config}
+		validateResp := validator.StringResponse{}
+		for _, v := range settingName.Validators {
+			v.ValidateString(ctx, validateReq, &validateResp)
+		}
+		if validateResp.Diagnostics.HasError() != tt.err {
+			t.Errorf("expected validation error %v for setting name '%s', got: %v", tt.err, tt.value, validateResp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+//template:end imports
+
+//template:begin testAcc
+func TestAccFmcHealthPolicy(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "name", "HEALTH_POLICY1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "description", "My health policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "modules.0.name", "CPU"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "modules.0.enabled", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "modules.0.warning_threshold", "80"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "modules.0.critical_threshold", "90"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "modules.0.settings.0.name", "interfaces"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "modules.0.settings.0.value", "GigabitEthernet0/0"))
+
+	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcHealthPolicyConfig_minimum(),
+		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_health_policy.test", plancheck.ResourceActionUpdate))
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcHealthPolicyConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_health_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+//template:end testAcc
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccConfigMinimal
+func testAccFmcHealthPolicyConfig_minimum() string {
+	config := `resource "fmc_health_policy" "test" {` + "\n"
+	config += `	name = "HEALTH_POLICY1"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigMinimal
+
+//template:begin testAccConfigAll
+func testAccFmcHealthPolicyConfig_all() string {
+	config := `resource "fmc_health_policy" "test" {` + "\n"
+	config += `	name = "HEALTH_POLICY1"` + "\n"
+	config += `	description = "My health policy"` + "\n"
+	config += `	modules = [{` + "\n"
+	config += `	  name = "CPU"` + "\n"
+	config += `	  enabled = true` + "\n"
+	config += `	  warning_threshold = 80` + "\n"
+	config += `	  critical_threshold = 90` + "\n"
+	config += `	  settings = [{` + "\n"
+	config += `		name = "interfaces"` + "\n"
+	config += `		value = "GigabitEthernet0/0"` + "\n"
+	config += `	}]` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigAll
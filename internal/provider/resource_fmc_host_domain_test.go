@@ -0,0 +1,115 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+)
+
+func TestFmcHostDomainTokens(t *testing.T) {
+	domains := map[string]string{
+		"Global/DomainA": "11111111-e0f2-11e3-8169-6d9ed49b625f",
+		"Global/DomainB": "22222222-e0f2-11e3-8169-6d9ed49b625f",
+	}
+	var mu sync.Mutex
+	logins := 0
+	// Tokens used for the requests of every domain UUID
+	tokens := make(map[string]map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+			logins++
+			w.Header().Set("X-auth-access-token", fmt.Sprintf("token-%d", logins))
+			w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+			w.Header().Set("DOMAINS", fmt.Sprintf(`[{"name": "Global/DomainA", "uuid": "%s"}, {"name": "Global/DomainB", "uuid": "%s"}]`, domains["Global/DomainA"], domains["Global/DomainB"]))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		segments := strings.Split(r.URL.Path, "/")
+		if len(segments) < 6 || segments[5] == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		uuid := segments[5]
+		if tokens[uuid] == nil {
+			tokens[uuid] = make(map[string]bool)
+		}
+		tokens[uuid][r.Header.Get("X-auth-access-token")] = true
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "HOST-%s", "name": "HOST1", "value": "10.1.1.1", "type": "Host"}`, uuid[:8])
+	}))
+	t.Cleanup(server.Close)
+
+	base, err := fmc.NewClient(server.URL, "admin", "password", fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+	ctx := context.Background()
+	r := &HostResource{client: &base, clients: helpers.NewDomainClients()}
+	schema := testResourceSchema(r)
+
+	for _, domain := range []string{"Global/DomainA", "Global/DomainB", "Global/DomainA"} {
+		plan := Host{
+			Id:          types.StringUnknown(),
+			Domain:      types.StringValue(domain),
+			Name:        types.StringValue("HOST1"),
+			Description: types.StringNull(),
+			Ip:          types.StringValue("10.1.1.1"),
+			Type:        types.StringUnknown(),
+			Overridable: types.BoolNull(),
+		}
+		req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schema}}
+		if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+			t.Fatalf("failed to set plan: %v", diags)
+		}
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: schema}}
+		r.Create(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error in domain %s: %v", domain, resp.Diagnostics)
+		}
+	}
+
+	if logins != 2 {
+		t.Errorf("expected one login per domain, got %d logins", logins)
+	}
+	if _, ok := tokens["e276abec-e0f2-11e3-8169-6d9ed49b625f"]; ok {
+		t.Errorf("expected no requests to the global domain, got: %v", tokens)
+	}
+	a, b := tokens[domains["Global/DomainA"]], tokens[domains["Global/DomainB"]]
+	if len(a) != 1 || len(b) != 1 {
+		t.Fatalf("expected a single cached token per domain, got: %v", tokens)
+	}
+	for token := range a {
+		if b[token] {
+			t.Errorf("expected different tokens per domain, got %s for both", token)
+		}
+	}
+}
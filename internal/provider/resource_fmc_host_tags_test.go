@@ -0,0 +1,103 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+func TestFmcHostTags(t *testing.T) {
+	id := "0050568a-3d4f-0ed3-0000-004294967401"
+	object := ""
+	var body gjson.Result
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost || r.Method == http.MethodPut {
+			b, _ := io.ReadAll(r.Body)
+			body = gjson.ParseBytes(b)
+			object, _ = sjson.Set(body.Raw, "id", id)
+		}
+		fmt.Fprint(w, object)
+	})
+
+	ctx := context.Background()
+	r := &HostResource{client: client}
+	s := testResourceSchema(r)
+	tag := func(name, value string) HostTags {
+		return HostTags{Name: types.StringValue(name), Value: types.StringValue(value)}
+	}
+	plan := Host{
+		Id:          types.StringUnknown(),
+		Domain:      types.StringNull(),
+		Name:        types.StringValue("HOST1"),
+		Description: types.StringNull(),
+		Ip:          types.StringValue("10.1.1.1"),
+		Type:        types.StringUnknown(),
+		Overridable: types.BoolNull(),
+		Tags:        []HostTags{tag("environment", "production"), tag("owner", "netops")},
+	}
+
+	// Create
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	if body.Get("tags").Raw != `[{"name":"environment","value":"production"},{"name":"owner","value":"netops"}]` {
+		t.Errorf("unexpected tags in create request: %s", body.Get("tags").Raw)
+	}
+
+	// FMC returns the tags in its own order, one of them has been changed out-of-band
+	object, _ = sjson.SetRaw(object, "tags", `[{"name":"owner","value":"secops"},{"name":"environment","value":"production"}]`)
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	var state Host
+	readResp.State.Get(ctx, &state)
+	if len(state.Tags) != 2 || state.Tags[0] != tag("environment", "production") || state.Tags[1] != tag("owner", "secops") {
+		t.Errorf("expected tags matched by name, got: %+v", state.Tags)
+	}
+
+	// Update
+	plan = state
+	plan.Tags = []HostTags{tag("environment", "staging")}
+	updateReq := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: s}, State: readResp.State}
+	updateReq.Plan.Set(ctx, &plan)
+	updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: s}}
+	r.Update(ctx, updateReq, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	if body.Get("tags").Raw != `[{"name":"environment","value":"staging"}]` {
+		t.Errorf("unexpected tags in update request: %s", body.Get("tags").Raw)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 //template:end imports
@@ -35,17 +36,27 @@ func TestAccFmcHost(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "name", "HOST1"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "description", "My host object"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "ip", "10.1.1.1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "type", "Host"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "overridable", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "tags.0.name", "environment"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "tags.0.value", "production"))
 
 	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
 			Config: testAccFmcHostConfig_minimum(),
 		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_host.test", plancheck.ResourceActionUpdate))
 	}
+	planChecks = append(planChecks, testAccExpectPlannedValue("fmc_host.test", "type", "Host"))
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcHostConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_host.test",
@@ -82,6 +93,10 @@ func testAccFmcHostConfig_all() string {
 	config += `	description = "My host object"` + "\n"
 	config += `	ip = "10.1.1.1"` + "\n"
 	config += `	overridable = true` + "\n"
+	config += `	tags = [{` + "\n"
+	config += `	  name = "environment"` + "\n"
+	config += `	  value = "production"` + "\n"
+	config += `	}]` + "\n"
 	config += `}` + "\n"
 	return config
 }
@@ -0,0 +1,118 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/tidwall/gjson"
+)
+
+//template:end imports
+
+//template:begin testAcc
+func TestAccFmcICMPv4Object(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4_object.test", "name", "ICMP1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4_object.test", "description", "My ICMPv4 object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4_object.test", "icmp_type", "DESTINATION_UNREACHABLE"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4_object.test", "code", "0"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4_object.test", "overridable", "true"))
+
+	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcICMPv4ObjectConfig_minimum(),
+		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_icmpv4_object.test", plancheck.ResourceActionUpdate))
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcICMPv4ObjectConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_icmpv4_object.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+//template:end testAcc
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccConfigMinimal
+func testAccFmcICMPv4ObjectConfig_minimum() string {
+	config := `resource "fmc_icmpv4_object" "test" {` + "\n"
+	config += `	name = "ICMP1"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigMinimal
+
+//template:begin testAccConfigAll
+func testAccFmcICMPv4ObjectConfig_all() string {
+	config := `resource "fmc_icmpv4_object" "test" {` + "\n"
+	config += `	name = "ICMP1"` + "\n"
+	config += `	description = "My ICMPv4 object"` + "\n"
+	config += `	icmp_type = "DESTINATION_UNREACHABLE"` + "\n"
+	config += `	code = 0` + "\n"
+	config += `	overridable = true` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigAll
+
+func TestFmcICMPv4ObjectIcmpTypeMapping(t *testing.T) {
+	data := ICMPv4Object{
+		Name:     types.StringValue("ICMP1"),
+		IcmpType: types.StringValue("DESTINATION_UNREACHABLE"),
+	}
+
+	body := gjson.Parse(data.toBody(context.Background(), ICMPv4Object{}))
+	if value := body.Get("icmpType"); value.Type != gjson.Number || value.Int() != 3 {
+		t.Errorf("expected icmpType to be sent as integer 3, got: %s", value.Raw)
+	}
+
+	var res ICMPv4Object
+	res.fromBody(context.Background(), body)
+	if res.IcmpType.ValueString() != "DESTINATION_UNREACHABLE" {
+		t.Errorf("expected icmpType to be read as DESTINATION_UNREACHABLE, got: %s", res.IcmpType.ValueString())
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// testReorderServer is a mock FMC holding a single IKEv2 policy, which returns the algorithm lists in
+// reverse order
+type testReorderServer struct {
+	mu     sync.Mutex
+	policy string
+}
+
+func (s *testReorderServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+		w.Header().Set("X-auth-access-token", "token")
+		w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	policiesPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/ikev2policies"
+	w.Header().Set("Content-Type", "application/json")
+	body, _ := io.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == policiesPath:
+		s.policy, _ = sjson.Set(string(body), "id", "POLICY-1")
+		fmt.Fprint(w, s.policy)
+	case r.Method == http.MethodPut && r.URL.Path == policiesPath+"/POLICY-1":
+		s.policy = string(body)
+		fmt.Fprint(w, s.policy)
+	case r.Method == http.MethodGet && r.URL.Path == policiesPath+"/POLICY-1" && s.policy != "":
+		res := s.policy
+		for _, key := range []string{"encryptionAlgorithms", "integrityAlgorithms", "prfIntegrityAlgorithms"} {
+			var values []string
+			for _, v := range gjson.Get(s.policy, key).Array() {
+				values = append([]string{v.Raw}, values...)
+			}
+			res, _ = sjson.SetRaw(res, key, "["+strings.Join(values, ",")+"]")
+		}
+		fmt.Fprint(w, res)
+	case r.Method == http.MethodDelete && r.URL.Path == policiesPath+"/POLICY-1":
+		s.policy = ""
+		fmt.Fprint(w, `{}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestFmcIKEv2PolicyPreserveOrder(t *testing.T) {
+	server := httptest.NewServer(&testReorderServer{})
+	t.Cleanup(server.Close)
+
+	config := func(algorithms string) string {
+		return fmt.Sprintf(`provider "fmc" {`+"\n"+
+			`	url = "%s"`+"\n"+
+			`	username = "admin"`+"\n"+
+			`	password = "password"`+"\n"+
+			`}`+"\n"+
+			`resource "fmc_ikev2_policy" "test" {`+"\n"+
+			`	name = "IKEV2_POLICY1"`+"\n"+
+			`	encryption_algorithms = [%s]`+"\n"+
+			`}`+"\n", server.URL, algorithms)
+	}
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`"AES-256", "AES", "DES"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "encryption_algorithms.0", "AES-256"),
+					resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "encryption_algorithms.1", "AES"),
+					resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "encryption_algorithms.2", "DES"),
+				),
+			},
+			{
+				// The order is a preference of the algorithms, reordering them is planned as update
+				Config:             config(`"DES", "AES-256", "AES"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config(`"DES", "AES-256", "AES"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "encryption_algorithms.0", "DES"),
+					resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "encryption_algorithms.1", "AES-256"),
+					resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "encryption_algorithms.2", "AES"),
+				),
+			},
+			{
+				Config:             config(`"AES", "DES"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/netascode/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/tidwall/gjson"
+)
+
+//template:end imports
+
+//template:begin testAcc
+func TestAccFmcIKEv2Policy(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "name", "IKEV2_POLICY1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "description", "My IKEv2 policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "priority", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "lifetime", "86400"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "encryption_algorithms.0", "AES-GCM-256"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "integrity_algorithms.0", "SHA512"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "prf_integrity_algorithms.0", "SHA512"))
+
+	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcIKEv2PolicyConfig_minimum(),
+		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_ikev2_policy.test", plancheck.ResourceActionUpdate))
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2PolicyConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_ikev2_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+//template:end testAcc
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccConfigMinimal
+func testAccFmcIKEv2PolicyConfig_minimum() string {
+	config := `resource "fmc_ikev2_policy" "test" {` + "\n"
+	config += `	name = "IKEV2_POLICY1"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigMinimal
+
+//template:begin testAccConfigAll
+func testAccFmcIKEv2PolicyConfig_all() string {
+	config := `resource "fmc_ikev2_policy" "test" {` + "\n"
+	config += `	name = "IKEV2_POLICY1"` + "\n"
+	config += `	description = "My IKEv2 policy"` + "\n"
+	config += `	priority = 10` + "\n"
+	config += `	lifetime = 86400` + "\n"
+	config += `	encryption_algorithms = ["AES-GCM-256"]` + "\n"
+	config += `	integrity_algorithms = ["SHA512"]` + "\n"
+	config += `	prf_integrity_algorithms = ["SHA512"]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigAll
+
+func TestFmcIKEv2PolicyDefaultList(t *testing.T) {
+	ctx := context.Background()
+
+	// The default is applied to the plan if the attribute is omitted in the configuration
+	attribute := testResourceSchema(NewIKEv2PolicyResource()).Attributes["encryption_algorithms"]
+	defaultResp := defaults.ListResponse{}
+	attribute.(interface{ ListDefaultValue() defaults.List }).ListDefaultValue().DefaultList(ctx, defaults.ListRequest{}, &defaultResp)
+	if !defaultResp.PlanValue.Equal(helpers.StringListValue("AES-256")) {
+		t.Errorf("expected default encryption algorithms [AES-256], got %s", defaultResp.PlanValue)
+	}
+
+	// The default is also used when importing an object without the attribute
+	var data IKEv2Policy
+	data.fromBody(ctx, gjson.Parse(`{"name": "IKEV2_POLICY1", "integrityAlgorithms": ["SHA512"]}`))
+	if !data.EncryptionAlgorithms.Equal(helpers.StringListValue("AES-256")) {
+		t.Errorf("expected default encryption algorithms [AES-256], got %s", data.EncryptionAlgorithms)
+	}
+	if !data.IntegrityAlgorithms.Equal(helpers.StringListValue("SHA512")) {
+		t.Errorf("expected integrity algorithms [SHA512], got %s", data.IntegrityAlgorithms)
+	}
+}
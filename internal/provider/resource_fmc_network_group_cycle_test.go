@@ -0,0 +1,128 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFmcNetworkGroupCycle(t *testing.T) {
+	groupsPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/networkgroups/"
+	groups := map[string]string{
+		"GROUP-1": `{"id": "GROUP-1", "name": "NETGRP1", "type": "NetworkGroup", "objects": [{"id": "NETWORK-1", "name": "NET1", "type": "Network"}]}`,
+		"GROUP-2": `{"id": "GROUP-2", "name": "NETGRP2", "type": "NetworkGroup", "objects": [{"id": "GROUP-1", "name": "NETGRP1", "type": "NetworkGroup"}]}`,
+	}
+	var requests []string
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, strings.TrimPrefix(r.URL.Path, groupsPath))
+		w.Header().Set("Content-Type", "application/json")
+		if group, ok := groups[strings.TrimPrefix(r.URL.Path, groupsPath)]; ok && r.Method == http.MethodGet {
+			fmt.Fprint(w, group)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"messages": [{"description": "Not found"}]}}`)
+	})
+	ctx := context.Background()
+	r := &NetworkGroupResource{client: client}
+	schema := testResourceSchema(r)
+
+	tests := []struct {
+		name     string
+		id       types.String
+		member   string
+		typ      types.String
+		expected string
+	}{
+		{"self-referential member", types.StringValue("GROUP-1"), "GROUP-1", types.StringUnknown(), "The group can not be a member of itself"},
+		{"member containing the group", types.StringValue("GROUP-1"), "GROUP-2", types.StringUnknown(), "The group can not contain NETGRP2, which already contains this group: NETGRP2 -> this group"},
+		{"member without cycle", types.StringValue("GROUP-1"), "NETWORK-1", types.StringUnknown(), ""},
+		{"member of another type", types.StringValue("GROUP-1"), "NETWORK-1", types.StringValue("Network"), ""},
+		{"new group", types.StringUnknown(), "GROUP-2", types.StringUnknown(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = nil
+			data := NetworkGroup{
+				Id:          tt.id,
+				Domain:      types.StringNull(),
+				Name:        types.StringValue("NETGRP1"),
+				Description: types.StringNull(),
+				Overridable: types.BoolNull(),
+				Objects:     []NetworkGroupObjects{{Id: types.StringValue(tt.member), Name: types.StringUnknown(), Type: tt.typ}},
+			}
+			plan := tfsdk.Plan{Schema: schema}
+			plan.Set(ctx, &data)
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schema}}, &resp)
+			if !tt.typ.IsUnknown() && len(requests) > 0 {
+				t.Errorf("expected member of type %s not to be retrieved, got: %v", tt.typ.ValueString(), requests)
+			}
+			if tt.expected == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Detail() != tt.expected {
+				t.Errorf("expected error '%s', got: %v", tt.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestFmcNetworkGroupCycleLookupFailed(t *testing.T) {
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": {"messages": [{"description": "Bad request"}]}}`)
+	})
+	ctx := context.Background()
+	r := &NetworkGroupResource{client: client}
+	schema := testResourceSchema(r)
+	data := NetworkGroup{
+		Id:          types.StringValue("GROUP-1"),
+		Domain:      types.StringNull(),
+		Name:        types.StringValue("NETGRP1"),
+		Description: types.StringNull(),
+		Overridable: types.BoolNull(),
+		Objects:     []NetworkGroupObjects{{Id: types.StringValue("GROUP-2"), Name: types.StringUnknown(), Type: types.StringValue("NetworkGroup")}},
+	}
+	plan := tfsdk.Plan{Schema: schema}
+	plan.Set(ctx, &data)
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schema}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	found := false
+	for _, warning := range resp.Diagnostics.Warnings() {
+		found = found || warning.Summary() == "Members Not Checked"
+	}
+	if !found {
+		t.Errorf("expected warning for the skipped cycle check, got: %v", resp.Diagnostics)
+	}
+}
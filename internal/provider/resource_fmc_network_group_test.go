@@ -0,0 +1,171 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/tidwall/gjson"
+)
+
+//template:end imports
+
+//template:begin testAcc
+func TestAccFmcNetworkGroup(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "name", "NETGRP1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "description", "My network group"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "overridable", "true"))
+
+	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcNetworkGroupPrerequisitesConfig + testAccFmcNetworkGroupConfig_minimum(),
+		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_network_group.test", plancheck.ResourceActionUpdate))
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcNetworkGroupPrerequisitesConfig + testAccFmcNetworkGroupConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_network_group.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+// Create the object together with its related objects, check the relationship after reading the objects
+// back and that destroying them in dependency order removes all of them
+func TestAccFmcNetworkGroupRelated(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmcNetworkGroupPrerequisitesConfig + testAccFmcNetworkGroupConfig_all(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fmc_network_group.test", "objects.0.id", "fmc_network.test", "id"),
+					resource.TestCheckResourceAttrPair("fmc_network_group.test", "objects.0.name", "fmc_network.test", "name"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckDestroyed(map[string]func(attributes map[string]string) string{
+			"fmc_network_group.test": func(attributes map[string]string) string {
+				return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networkgroups/" + attributes["id"]
+			},
+			"fmc_network.test": func(attributes map[string]string) string {
+				return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks/" + attributes["id"]
+			},
+		}),
+	})
+}
+
+//template:end testAcc
+
+//template:begin testPrerequisites
+const testAccFmcNetworkGroupPrerequisitesConfig = `
+resource "fmc_network" "test" {
+  name   = "NET1"
+  prefix = "10.1.2.0/24"
+}
+
+`
+
+//template:end testPrerequisites
+
+//template:begin testAccConfigMinimal
+func testAccFmcNetworkGroupConfig_minimum() string {
+	config := `resource "fmc_network_group" "test" {` + "\n"
+	config += `	name = "NETGRP1"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigMinimal
+
+//template:begin testAccConfigAll
+func testAccFmcNetworkGroupConfig_all() string {
+	config := `resource "fmc_network_group" "test" {` + "\n"
+	config += `	name = "NETGRP1"` + "\n"
+	config += `	description = "My network group"` + "\n"
+	config += `	overridable = true` + "\n"
+	config += `	objects = [{` + "\n"
+	config += `	  id = fmc_network.test.id` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigAll
+
+func TestFmcNetworkGroupObjectsMetadata(t *testing.T) {
+	plan := NetworkGroup{
+		Name: types.StringValue("NETGRP1"),
+		Objects: []NetworkGroupObjects{
+			{Id: types.StringValue("76d24097-41c4-4558-a4d0-a8c07ac08470"), Name: types.StringNull(), Type: types.StringNull()},
+			{Id: types.StringValue("0050568a-3d4f-0ed3-0000-004294967346"), Name: types.StringNull(), Type: types.StringNull()},
+		},
+	}
+
+	body := gjson.Parse(plan.toBody(context.Background(), NetworkGroup{}))
+	if value := body.Get("objects.0"); value.Get("name").Exists() || value.Get("type").Exists() {
+		t.Errorf("expected metadata not to be sent, got: %s", value.Raw)
+	}
+
+	// FMC returns the objects in a different order and adds metadata to each element
+	res := gjson.Parse(`{
+	  "name": "NETGRP1",
+	  "objects": [
+	    {"id": "0050568a-3d4f-0ed3-0000-004294967346", "name": "NET2", "type": "Network"},
+	    {"id": "76d24097-41c4-4558-a4d0-a8c07ac08470", "name": "NET1", "type": "Network"}
+	  ]
+	}`)
+	plan.updateFromBody(context.Background(), res)
+
+	if len(plan.Objects) != 2 {
+		t.Fatalf("expected 2 objects, got %d", len(plan.Objects))
+	}
+	expected := []string{"NET1", "NET2"}
+	for i, object := range plan.Objects {
+		if object.Name.ValueString() != expected[i] || object.Type.ValueString() != "Network" {
+			t.Errorf("unexpected metadata of object %d: %s, %s", i, object.Name.ValueString(), object.Type.ValueString())
+		}
+	}
+	if plan.Objects[0].Id.ValueString() != "76d24097-41c4-4558-a4d0-a8c07ac08470" {
+		t.Errorf("expected configured order of objects to be preserved, got: %s", plan.Objects[0].Id.ValueString())
+	}
+}
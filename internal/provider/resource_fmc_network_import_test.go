@@ -0,0 +1,67 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFmcNetworkImportNotFound(t *testing.T) {
+	networkPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/networks/"
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != networkPath+"76d24097-41c4-4558-a4d0-a8c07ac08470" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"category": "FRAMEWORK", "messages": [{"description": "UUID not found"}], "severity": "ERROR"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "76d24097-41c4-4558-a4d0-a8c07ac08470", "name": "NET1", "value": "10.1.2.0/24"}`)
+	})
+
+	ctx := context.Background()
+	r := &NetworkResource{client: client}
+	schema := testResourceSchema(r)
+	tests := []struct {
+		id  string
+		err string
+	}{
+		{"76d24097-41c4-4558-a4d0-a8c07ac08470", ""},
+		{"bogus", "Object with id bogus not found"},
+	}
+	for _, tt := range tests {
+		resp := resource.ImportStateResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, &resp)
+		if tt.err == "" {
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error importing '%s': %v", tt.id, resp.Diagnostics)
+			}
+			continue
+		}
+		if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.err) {
+			t.Errorf("expected error '%s' importing '%s', got: %v", tt.err, tt.id, resp.Diagnostics)
+		}
+	}
+}
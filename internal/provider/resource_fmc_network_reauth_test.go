@@ -0,0 +1,87 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFmcNetworkReauthenticate(t *testing.T) {
+	logins := 0
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+			logins++
+			w.Header().Set("X-auth-access-token", fmt.Sprintf("token%d", logins))
+			w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("X-auth-access-token") != fmt.Sprintf("token%d", logins) {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": {"category": "FRAMEWORK", "messages": [{"description": "Access token invalid."}], "severity": "ERROR"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": "76d24097-41c4-4558-a4d0-a8c07ac08470", "name": "NET1", "value": "10.1.2.0/24"}`)
+	})
+
+	ctx := context.Background()
+	r := &NetworkResource{client: client}
+	s := testResourceSchema(r)
+	plan := Network{
+		Id:          types.StringUnknown(),
+		Domain:      types.StringNull(),
+		Name:        types.StringValue("NET1"),
+		Description: types.StringNull(),
+		Prefix:      types.StringValue("10.1.2.0/24"),
+		Overridable: types.BoolNull(),
+	}
+
+	// The access token of the mock client is expired, the create request is repeated after logging in again
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating: %v", createResp.Diagnostics)
+	}
+	if logins != 1 {
+		t.Errorf("expected 1 login after create, got: %d", logins)
+	}
+
+	client.AuthToken = "expired"
+	plan.Id = types.StringValue("76d24097-41c4-4558-a4d0-a8c07ac08470")
+	plan.Description = types.StringValue("My network")
+	updateReq := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: s}, State: createResp.State}
+	updateReq.Plan.Set(ctx, &plan)
+	updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: s}}
+	r.Update(ctx, updateReq, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error updating: %v", updateResp.Diagnostics)
+	}
+	if logins != 2 {
+		t.Errorf("expected 2 logins after update, got: %d", logins)
+	}
+}
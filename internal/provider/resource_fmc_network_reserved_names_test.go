@@ -0,0 +1,54 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFmcNetworkReservedNames(t *testing.T) {
+	config := func(name string) string {
+		return `provider "fmc" {` + "\n" +
+			`	url = "https://127.0.0.1:1"` + "\n" +
+			`	username = "admin"` + "\n" +
+			`	password = "password"` + "\n" +
+			`}` + "\n" +
+			`resource "fmc_network" "test" {` + "\n" +
+			`	name = "` + name + `"` + "\n" +
+			`	prefix = "10.1.1.0/24"` + "\n" +
+			`}` + "\n"
+	}
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("any"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute name must not be a name reserved by FMC, got: "any"`),
+			},
+			{
+				Config:             config("NET1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
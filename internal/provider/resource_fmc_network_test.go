@@ -21,10 +21,14 @@ package provider
 
 //template:begin imports
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/tidwall/gjson"
 )
 
 //template:end imports
@@ -38,19 +42,39 @@ func TestAccFmcNetwork(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "overridable", "true"))
 
 	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
 			Config: testAccFmcNetworkConfig_minimum(),
 		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_network.test", plancheck.ResourceActionUpdate))
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcNetworkConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_network.test",
 		ImportState:  true,
 	})
+	// Delete the object out-of-band, the next plan must recreate it
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcNetworkConfig_all(),
+		Check: testAccCheckDisappears("fmc_network.test", func(attributes map[string]string) string {
+			return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks/" + attributes["id"]
+		}),
+		ExpectNonEmptyPlan: true,
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcNetworkConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{plancheck.ExpectResourceAction("fmc_network.test", plancheck.ResourceActionCreate)},
+		},
+	})
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -87,3 +111,23 @@ func testAccFmcNetworkConfig_all() string {
 }
 
 //template:end testAccConfigAll
+
+func TestFmcNetworkClearDescription(t *testing.T) {
+	state := Network{
+		Name:        types.StringValue("NET1"),
+		Description: types.StringValue("My network object"),
+		Prefix:      types.StringValue("10.1.2.0/24"),
+	}
+	plan := state
+	plan.Description = types.StringNull()
+
+	body := gjson.Parse(plan.toBody(context.Background(), state))
+	if value := body.Get("description"); !value.Exists() || value.Type != gjson.Null {
+		t.Errorf("expected description to be sent as explicit null when cleared, got: %s", body.Raw)
+	}
+
+	body = gjson.Parse(plan.toBody(context.Background(), Network{}))
+	if body.Get("description").Exists() {
+		t.Errorf("expected description to be omitted when never configured, got: %s", body.Raw)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// testPrefilterServer is a mock FMC holding the ordered rules of a single prefilter policy.
+type testPrefilterServer struct {
+	mu    sync.Mutex
+	rules []string
+	next  int
+}
+
+func (s *testPrefilterServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+		w.Header().Set("X-auth-access-token", "token")
+		w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	rulesPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/policy/prefilterpolicies/POLICY-1/prefilterrules"
+	if !strings.HasPrefix(r.URL.Path, rulesPath) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, rulesPath), "/")
+	index := -1
+	for i, rule := range s.rules {
+		if gjson.Get(rule, "id").String() == id {
+			index = i
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	body, _ := io.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodPost && id == "":
+		s.next++
+		rule, _ := sjson.Set(string(body), "id", fmt.Sprintf("RULE-%d", s.next))
+		position := len(s.rules)
+		if before, err := strconv.Atoi(r.URL.Query().Get("insertBefore")); err == nil && before >= 1 && before <= len(s.rules) {
+			position = before - 1
+		}
+		s.rules = append(s.rules[:position], append([]string{rule}, s.rules[position:]...)...)
+		fmt.Fprint(w, rule)
+	case r.Method == http.MethodGet && index >= 0:
+		rule, _ := sjson.Set(s.rules[index], "metadata.ruleIndex", index+1)
+		fmt.Fprint(w, rule)
+	case r.Method == http.MethodPut && index >= 0:
+		s.rules[index] = string(body)
+		fmt.Fprint(w, s.rules[index])
+	case r.Method == http.MethodDelete && index >= 0:
+		s.rules = append(s.rules[:index], s.rules[index+1:]...)
+		fmt.Fprint(w, `{}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// order returns the names of the rules in the order they are evaluated
+func (s *testPrefilterServer) order() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var names []string
+	for _, rule := range s.rules {
+		names = append(names, gjson.Get(rule, "name").String()+":"+gjson.Get(rule, "action").String())
+	}
+	return strings.Join(names, ",")
+}
+
+func TestFmcPrefilterRuleOrder(t *testing.T) {
+	fmcServer := &testPrefilterServer{}
+	server := httptest.NewServer(fmcServer)
+	t.Cleanup(server.Close)
+
+	config := func(action string) string {
+		return fmt.Sprintf(`provider "fmc" {`+"\n"+
+			`	url = "%s"`+"\n"+
+			`	username = "admin"`+"\n"+
+			`	password = "password"`+"\n"+
+			`}`+"\n"+
+			`resource "fmc_prefilter_rule" "first" {`+"\n"+
+			`	prefilter_policy_id = "POLICY-1"`+"\n"+
+			`	name = "RULE1"`+"\n"+
+			`	action = "%s"`+"\n"+
+			`}`+"\n"+
+			`resource "fmc_prefilter_rule" "second" {`+"\n"+
+			`	prefilter_policy_id = "POLICY-1"`+"\n"+
+			`	name = "RULE2"`+"\n"+
+			`	action = "ANALYZE"`+"\n"+
+			`	insert_before = 1`+"\n"+
+			`	depends_on = [fmc_prefilter_rule.first]`+"\n"+
+			`}`+"\n", server.URL, action)
+	}
+	checkOrder := func(expected string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if order := fmcServer.order(); order != expected {
+				return fmt.Errorf("expected rules %s, got: %s", expected, order)
+			}
+			return nil
+		}
+	}
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("FASTPATH"),
+				Check: resource.ComposeTestCheckFunc(
+					checkOrder("RULE2:ANALYZE,RULE1:FASTPATH"),
+					resource.TestCheckResourceAttr("fmc_prefilter_rule.first", "id", "RULE-1"),
+					resource.TestCheckResourceAttr("fmc_prefilter_rule.second", "insert_before", "1"),
+				),
+			},
+			{
+				Config: config("BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					checkOrder("RULE2:ANALYZE,RULE1:BLOCK"),
+					resource.TestCheckResourceAttr("fmc_prefilter_rule.first", "id", "RULE-1"),
+				),
+			},
+		},
+		CheckDestroy: checkOrder(""),
+	})
+}
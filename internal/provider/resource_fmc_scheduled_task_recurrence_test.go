@@ -0,0 +1,128 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+func TestFmcScheduledTaskRecurrence(t *testing.T) {
+	id := "005056bb-0b24-0ed3-0000-399431958300"
+	var body gjson.Result
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			b, _ := io.ReadAll(r.Body)
+			body = gjson.ParseBytes(b)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id": "%s"}`, id)
+			return
+		}
+		res, _ := sjson.Set(body.Raw, "id", id)
+		fmt.Fprint(w, res)
+	})
+
+	ctx := context.Background()
+	r := &ScheduledTaskResource{client: client}
+	s := testResourceSchema(r)
+	weekly := ScheduledTask{
+		Id:                   types.StringUnknown(),
+		Domain:               types.StringNull(),
+		Name:                 types.StringValue("BACKUP1"),
+		Description:          types.StringNull(),
+		JobType:              types.StringValue("BACKUP"),
+		RecurrenceFrequency:  types.StringValue("WEEKLY"),
+		RecurrenceInterval:   types.Int64Value(1),
+		RecurrenceStartTime:  types.StringValue("02:30"),
+		RecurrenceWeekdays:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("MON"), types.StringValue("THU")}),
+		RecurrenceDayOfMonth: types.Int64Null(),
+	}
+	invalid := weekly
+	invalid.RecurrenceDayOfMonth = types.Int64Value(15)
+	noWeekdays := weekly
+	noWeekdays.RecurrenceWeekdays = types.ListNull(types.StringType)
+	noDayOfMonth := weekly
+	noDayOfMonth.RecurrenceFrequency = types.StringValue("MONTHLY")
+	noDayOfMonth.RecurrenceWeekdays = types.ListNull(types.StringType)
+	daily := noWeekdays
+	daily.RecurrenceFrequency = types.StringValue("DAILY")
+
+	tests := []struct {
+		name    string
+		plan    ScheduledTask
+		invalid string
+	}{
+		{"weekly", weekly, ""},
+		{"day of month with weekly frequency", invalid, `Attribute "recurrence_day_of_month" can only be configured if "recurrence_frequency" is one of: MONTHLY, got: "WEEKLY"`},
+		{"weekly without weekdays", noWeekdays, `Attribute "recurrence_weekdays" must be configured if "recurrence_frequency" is "WEEKLY"`},
+		{"monthly without day of month", noDayOfMonth, `Attribute "recurrence_day_of_month" must be configured if "recurrence_frequency" is "MONTHLY"`},
+		{"daily", daily, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+			if diags := req.Plan.Set(ctx, &tt.plan); diags.HasError() {
+				t.Fatalf("failed to set plan: %v", diags)
+			}
+
+			// Recurrence attributes of other frequencies and missing ones of the frequency are rejected when
+			// validating the configuration
+			validateResp := resource.ValidateConfigResponse{}
+			for _, v := range r.ConfigValidators(ctx) {
+				v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: req.Plan.Raw}}, &validateResp)
+			}
+			if tt.invalid != "" {
+				if validateResp.Diagnostics.ErrorsCount() != 1 || validateResp.Diagnostics.Errors()[0].Detail() != tt.invalid {
+					t.Errorf("expected validation error '%s', got: %v", tt.invalid, validateResp.Diagnostics)
+				}
+				return
+			}
+			if validateResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected validation error: %v", validateResp.Diagnostics)
+			}
+			if tt.plan.RecurrenceFrequency.ValueString() != "WEEKLY" {
+				return
+			}
+
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+			r.Create(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if body.Get("recurrence.frequency").String() != "WEEKLY" || body.Get("recurrence.days").Raw != `["MON","THU"]` || body.Get("recurrence.dayOfMonth").Exists() {
+				t.Errorf("unexpected request body: %s", body.Raw)
+			}
+			var state ScheduledTask
+			resp.State.Get(ctx, &state)
+			if state.Id.ValueString() != id || state.GetRecurrenceFrequency() != "WEEKLY" || len(state.RecurrenceWeekdays.Elements()) != 2 {
+				t.Errorf("unexpected state: %+v", state)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/tidwall/gjson"
+)
+
+//template:end imports
+
+//template:begin testAcc
+func TestAccFmcScheduledTask(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_scheduled_task.test", "name", "BACKUP1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_scheduled_task.test", "description", "My scheduled task"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_scheduled_task.test", "job_type", "BACKUP"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_scheduled_task.test", "recurrence_frequency", "WEEKLY"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_scheduled_task.test", "recurrence_interval", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_scheduled_task.test", "recurrence_start_time", "02:30"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_scheduled_task.test", "recurrence_weekdays.0", "MON"))
+
+	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcScheduledTaskConfig_minimum(),
+		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_scheduled_task.test", plancheck.ResourceActionUpdate))
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcScheduledTaskConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_scheduled_task.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+//template:end testAcc
+
+//template:begin testPrerequisites
+//template:end testPrerequisites
+
+//template:begin testAccConfigMinimal
+func testAccFmcScheduledTaskConfig_minimum() string {
+	config := `resource "fmc_scheduled_task" "test" {` + "\n"
+	config += `	name = "BACKUP1"` + "\n"
+	config += `	job_type = "BACKUP"` + "\n"
+	config += `	recurrence_frequency = "WEEKLY"` + "\n"
+	config += `	recurrence_start_time = "02:30"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigMinimal
+
+//template:begin testAccConfigAll
+func testAccFmcScheduledTaskConfig_all() string {
+	config := `resource "fmc_scheduled_task" "test" {` + "\n"
+	config += `	name = "BACKUP1"` + "\n"
+	config += `	description = "My scheduled task"` + "\n"
+	config += `	job_type = "BACKUP"` + "\n"
+	config += `	recurrence_frequency = "WEEKLY"` + "\n"
+	config += `	recurrence_interval = 1` + "\n"
+	config += `	recurrence_start_time = "02:30"` + "\n"
+	config += `	recurrence_weekdays = ["MON"]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigAll
+
+func TestFmcScheduledTaskWeekdaysOrder(t *testing.T) {
+	state := ScheduledTask{
+		RecurrenceWeekdays: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("FRI"), types.StringValue("MON")}),
+	}
+	// FMC returns the weekdays in its own order
+	res := gjson.Parse(`{"recurrence": {"days": ["MON", "WED", "FRI"]}}`)
+	state.updateFromBody(context.Background(), res)
+
+	var values []string
+	state.RecurrenceWeekdays.ElementsAs(context.Background(), &values, false)
+	if strings.Join(values, ",") != "FRI,MON,WED" {
+		t.Errorf("expected weekdays in state order with additional values appended, got %v", values)
+	}
+}
+
+func TestFmcScheduledTaskGetters(t *testing.T) {
+	data := ScheduledTask{
+		Name:                 types.StringValue("Task1"),
+		RecurrenceDayOfMonth: types.Int64Null(),
+		RecurrenceWeekdays:   types.ListNull(types.StringType),
+	}
+	if v := data.GetName(); v != "Task1" {
+		t.Errorf("expected name Task1, got %s", v)
+	}
+	if v := data.GetRecurrenceDayOfMonth(); v != 0 {
+		t.Errorf("expected zero value for null day of month, got %d", v)
+	}
+	if v := data.GetRecurrenceWeekdays(); v != nil {
+		t.Errorf("expected nil for null weekdays, got %v", v)
+	}
+	data.RecurrenceWeekdays = types.ListUnknown(types.StringType)
+	if v := data.GetRecurrenceWeekdays(); v != nil {
+		t.Errorf("expected nil for unknown weekdays, got %v", v)
+	}
+}
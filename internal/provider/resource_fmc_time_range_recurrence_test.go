@@ -0,0 +1,122 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/tidwall/sjson"
+)
+
+// testTimeRangeServer is a mock FMC holding a single time range object.
+type testTimeRangeServer struct {
+	mu   sync.Mutex
+	body string
+}
+
+func (s *testTimeRangeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.URL.Path == "/api/fmc_platform/v1/auth/generatetoken" {
+		w.Header().Set("X-auth-access-token", "token")
+		w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	timeRangesPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/timeranges"
+	if !strings.HasPrefix(r.URL.Path, timeRangesPath) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	body, _ := io.ReadAll(r.Body)
+	switch r.Method {
+	case http.MethodPost, http.MethodPut:
+		s.body, _ = sjson.Set(string(body), "id", "TIMERANGE-1")
+		fmt.Fprint(w, s.body)
+	case http.MethodGet:
+		fmt.Fprint(w, s.body)
+	case http.MethodDelete:
+		fmt.Fprint(w, `{}`)
+	}
+}
+
+func TestFmcTimeRangeRecurrence(t *testing.T) {
+	fmcServer := &testTimeRangeServer{}
+	server := httptest.NewServer(fmcServer)
+	t.Cleanup(server.Close)
+
+	config := func(startTime, endTime string) string {
+		return fmt.Sprintf(`provider "fmc" {`+"\n"+
+			`	url = "%s"`+"\n"+
+			`	username = "admin"`+"\n"+
+			`	password = "password"`+"\n"+
+			`}`+"\n"+
+			`resource "fmc_time_range" "test" {`+"\n"+
+			`	name = "TR1"`+"\n"+
+			`	start_date_time = "2025-01-01T08:00"`+"\n"+
+			`	end_date_time = "2025-12-31T17:00"`+"\n"+
+			`	recurrences = [{`+"\n"+
+			`		recurrence_type = "DAILY_INTERVAL"`+"\n"+
+			`		daily_start_time = "%s"`+"\n"+
+			`		daily_end_time = "%s"`+"\n"+
+			`		daily_days = ["MON", "WED", "FRI"]`+"\n"+
+			`	}, {`+"\n"+
+			`		recurrence_type = "RANGE"`+"\n"+
+			`		range_start_day = "FRI"`+"\n"+
+			`		range_start_time = "18:00"`+"\n"+
+			`		range_end_day = "MON"`+"\n"+
+			`		range_end_time = "06:00"`+"\n"+
+			`	}]`+"\n"+
+			`}`+"\n", server.URL, startTime, endTime)
+	}
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("17:00", "08:00"),
+				ExpectError: regexp.MustCompile(`must be later than`),
+			},
+			{
+				Config: config("08:00", "17:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fmc_time_range.test", "id", "TIMERANGE-1"),
+					resource.TestCheckResourceAttr("fmc_time_range.test", "recurrences.0.daily_days.#", "3"),
+					resource.TestCheckResourceAttr("fmc_time_range.test", "recurrences.1.range_end_day", "MON"),
+					func(*terraform.State) error {
+						for _, s := range []string{`"effectiveEndDateTime":"2025-12-31T17:00"`, `"recurrenceType":"DAILY_INTERVAL"`, `"days":["MON","WED","FRI"]`, `"rangeStartDay":"FRI"`} {
+							if !strings.Contains(fmcServer.body, s) {
+								return fmt.Errorf("expected '%s' in the created object, got: %s", s, fmcServer.body)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "gen/generator.go"; DO NOT EDIT.
+
+package provider
+
+//template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/tidwall/gjson"
+)
+
+//template:end imports
+
+//template:begin testAcc
+func TestAccFmcVariableSet(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_variable_set.test", "name", "VARSET1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_variable_set.test", "description", "My variable set"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_variable_set.test", "variables.0.name", "HOME_NET"))
+
+	var steps []resource.TestStep
+	var planChecks []plancheck.PlanCheck
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcVariableSetPrerequisitesConfig + testAccFmcVariableSetConfig_minimum(),
+		})
+		// Attributes which do not require replacement must be updated in place
+		planChecks = append(planChecks, plancheck.ExpectResourceAction("fmc_variable_set.test", plancheck.ResourceActionUpdate))
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVariableSetPrerequisitesConfig + testAccFmcVariableSetConfig_all(),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: planChecks,
+		},
+		Check: resource.ComposeTestCheckFunc(checks...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_variable_set.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+//template:end testAcc
+
+//template:begin testPrerequisites
+const testAccFmcVariableSetPrerequisitesConfig = `
+resource "fmc_network" "test" {
+  name   = "NET1"
+  prefix = "10.1.2.0/24"
+}
+
+`
+
+//template:end testPrerequisites
+
+//template:begin testAccConfigMinimal
+func testAccFmcVariableSetConfig_minimum() string {
+	config := `resource "fmc_variable_set" "test" {` + "\n"
+	config += `	name = "VARSET1"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigMinimal
+
+//template:begin testAccConfigAll
+func testAccFmcVariableSetConfig_all() string {
+	config := `resource "fmc_variable_set" "test" {` + "\n"
+	config += `	name = "VARSET1"` + "\n"
+	config += `	description = "My variable set"` + "\n"
+	config += `	variables = [{` + "\n"
+	config += `	  name = "HOME_NET"` + "\n"
+	config += `	  network_id = fmc_network.test.id` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+//template:end testAccConfigAll
+
+func TestAccFmcVariableSetMultipleVariables(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmcVariableSetPrerequisitesConfig + testAccFmcVariableSetConfig_multiple(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fmc_variable_set.test", "variables.#", "2"),
+					resource.TestCheckResourceAttr("fmc_variable_set.test", "variables.0.name", "HOME_NET"),
+					resource.TestCheckResourceAttrPair("fmc_variable_set.test", "variables.0.network_id", "fmc_network.test", "id"),
+					resource.TestCheckResourceAttr("fmc_variable_set.test", "variables.1.name", "EXTERNAL_NET"),
+					resource.TestCheckResourceAttrPair("fmc_variable_set.test", "variables.1.network_id", "fmc_network.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFmcVariableSetConfig_multiple() string {
+	config := `resource "fmc_variable_set" "test" {` + "\n"
+	config += `	name = "VARSET1"` + "\n"
+	config += `	variables = [{` + "\n"
+	config += `	  name = "HOME_NET"` + "\n"
+	config += `	  network_id = fmc_network.test.id` + "\n"
+	config += `	}, {` + "\n"
+	config += `	  name = "EXTERNAL_NET"` + "\n"
+	config += `	  network_id = fmc_network.test.id` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+func TestFmcVariableSetResolveReferences(t *testing.T) {
+	networksPath := "/api/fmc_config/v1/domain/e276abec-e0f2-11e3-8169-6d9ed49b625f/object/networks"
+	requests := 0
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != networksPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"items": [{"id": "NET-1", "name": "NET1"}, {"id": "NET-2", "name": "NET2"}], "paging": {"offset": 0, "limit": 1000, "count": 2, "pages": 1}}`)
+	})
+
+	plan := VariableSet{
+		Variables: []VariableSetVariables{
+			{Name: types.StringValue("HOME_NET"), NetworkId: types.StringUnknown(), NetworkName: types.StringValue("NET1")},
+			{Name: types.StringValue("EXTERNAL_NET"), NetworkId: types.StringUnknown(), NetworkName: types.StringValue("NET2")},
+			{Name: types.StringValue("DNS_SERVERS"), NetworkId: types.StringValue("NET-3"), NetworkName: types.StringNull()},
+		},
+	}
+	if err := plan.resolveReferences(context.Background(), client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected references of the same type to be resolved with a single request, got %d requests", requests)
+	}
+	for i, id := range []string{"NET-1", "NET-2", "NET-3"} {
+		if plan.Variables[i].NetworkId.ValueString() != id {
+			t.Errorf("expected network_id '%s' for variable %d, got '%s'", id, i, plan.Variables[i].NetworkId.ValueString())
+		}
+	}
+	if body := gjson.Parse(plan.toBody(context.Background(), VariableSet{})); body.Get("variables.0.value.name").Exists() {
+		t.Errorf("expected network_name not to be sent, got: %s", body.Raw)
+	}
+
+	plan.Variables = []VariableSetVariables{{Name: types.StringValue("HOME_NET"), NetworkId: types.StringUnknown(), NetworkName: types.StringNull()}}
+	if err := plan.resolveReferences(context.Background(), client); err == nil {
+		t.Error("expected error if neither network_id nor network_name is configured")
+	}
+}
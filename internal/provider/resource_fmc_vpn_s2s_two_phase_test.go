@@ -0,0 +1,152 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/tidwall/gjson"
+)
+
+func TestFmcVPNS2STwoPhaseCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		requests []string
+		err      bool
+	}{
+		{"success", http.StatusOK, []string{"POST", "PUT"}, false},
+		{"rollback", http.StatusBadRequest, []string{"POST", "PUT", "DELETE"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			bodies := make(map[string]gjson.Result)
+			client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				requests = append(requests, r.Method)
+				bodies[r.Method] = gjson.ParseBytes(body)
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodPost:
+					w.WriteHeader(http.StatusCreated)
+					fmt.Fprint(w, `{"id": "005056bb-0b24-0ed3-0000-399431958027"}`)
+				case http.MethodPut:
+					w.WriteHeader(tt.status)
+					fmt.Fprint(w, `{}`)
+				default:
+					fmt.Fprint(w, `{}`)
+				}
+			})
+
+			ctx := context.Background()
+			r := &VPNS2SResource{client: client}
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			plan := VPNS2S{
+				Id:              types.StringUnknown(),
+				Domain:          types.StringNull(),
+				Name:            types.StringValue("MyVPN1"),
+				NetworkTopology: types.StringValue("POINT_TO_POINT"),
+				RouteBased:      types.BoolValue(false),
+				Ikev1:           types.BoolValue(false),
+				Ikev2:           types.BoolValue(true),
+			}
+			req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+			if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+				t.Fatalf("failed to set plan: %v", diags)
+			}
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, req, &resp)
+
+			if strings.Join(requests, ",") != strings.Join(tt.requests, ",") {
+				t.Errorf("expected requests %v, got %v", tt.requests, requests)
+			}
+			if resp.Diagnostics.HasError() != tt.err {
+				t.Errorf("expected error %v, got: %v", tt.err, resp.Diagnostics)
+			}
+			if value := bodies["POST"]; value.Get("name").String() != "MyVPN1" || value.Get("topologyType").String() != "POINT_TO_POINT" || value.Get("ikeV2Enabled").Exists() {
+				t.Errorf("expected only mandatory attributes in first request, got: %s", value.Raw)
+			}
+			if value := bodies["PUT"]; value.Get("id").String() != "005056bb-0b24-0ed3-0000-399431958027" || !value.Get("ikeV2Enabled").Bool() {
+				t.Errorf("expected full configuration in second request, got: %s", value.Raw)
+			}
+		})
+	}
+}
+
+func TestFmcVPNS2SIgnoreWarnings(t *testing.T) {
+	warning := "Topology MyVPN1 has no endpoints and will not be deployed"
+	client := testMockHandlerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost {
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		if r.URL.Query().Get("ignoreWarnings") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error": {"category": "VALIDATION", "messages": [{"description": "%s"}], "severity": "WARNING"}}`, warning)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id": "005056bb-0b24-0ed3-0000-399431958027", "error": {"category": "VALIDATION", "messages": [{"description": "%s"}], "severity": "WARNING"}}`, warning)
+	})
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	r := &VPNS2SResource{client: client}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := VPNS2S{
+		Id:              types.StringUnknown(),
+		Domain:          types.StringNull(),
+		Name:            types.StringValue("MyVPN1"),
+		NetworkTopology: types.StringValue("POINT_TO_POINT"),
+	}
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+		t.Fatalf("failed to set plan: %v", diags)
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Detail() != warning {
+		t.Errorf("expected warning diagnostic '%s', got: %v", warning, resp.Diagnostics)
+	}
+	if !strings.Contains(output.String(), warning) {
+		t.Errorf("expected warning to be logged, got: %s", output.String())
+	}
+	var state VPNS2S
+	resp.State.Get(ctx, &state)
+	if state.Id.ValueString() != "005056bb-0b24-0ed3-0000-399431958027" {
+		t.Errorf("expected object to be created, got id: %s", state.Id.ValueString())
+	}
+}